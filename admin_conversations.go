@@ -0,0 +1,385 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BulkConversationsProgress reports the progress of a bulk conversations action.
+type BulkConversationsProgress struct {
+	Completed int
+	Failed    int
+	Total     int
+}
+
+// BulkConversationsOption options for the admin bulk conversations actions.
+type BulkConversationsOption func(*bulkConversationsConfig)
+
+// BulkConversationsOptionChunkSize maximum number of channels submitted per request, defaults to 10.
+func BulkConversationsOptionChunkSize(n int) BulkConversationsOption {
+	return func(c *bulkConversationsConfig) {
+		c.chunk = n
+	}
+}
+
+// BulkConversationsOptionProgress invoked after each chunk of channels has been submitted.
+func BulkConversationsOptionProgress(fn func(BulkConversationsProgress)) BulkConversationsOption {
+	return func(c *bulkConversationsConfig) {
+		c.progress = fn
+	}
+}
+
+type bulkConversationsConfig struct {
+	chunk    int
+	progress func(BulkConversationsProgress)
+}
+
+// BulkConversationsResult the outcome of a bulk conversations action. slack performs
+// the action asynchronously, the channels which were accepted are reported by the
+// corresponding channel events once processed.
+type BulkConversationsResult struct {
+	// ActionIDs the identifiers of the bulk actions slack accepted.
+	ActionIDs []string
+	// Failures the channels which were rejected by their error.
+	Failures map[string]error
+}
+
+// Err returns a BulkConversationsError when any channel failed, nil otherwise.
+func (t BulkConversationsResult) Err() error {
+	if len(t.Failures) == 0 {
+		return nil
+	}
+
+	return BulkConversationsError{Failures: t.Failures}
+}
+
+// BulkConversationsError aggregates the errors of the channels rejected by a bulk action.
+type BulkConversationsError struct {
+	Failures map[string]error
+}
+
+func (t BulkConversationsError) Error() string {
+	ids := make([]string, 0, len(t.Failures))
+	for id := range t.Failures {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	msgs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", id, t.Failures[id]))
+	}
+
+	return fmt.Sprintf("bulk action failed for %d channel(s): %s", len(ids), strings.Join(msgs, ", "))
+}
+
+type bulkConversationsResponse struct {
+	BulkActionID string `json:"bulk_action_id"`
+	NotAdded     []struct {
+		ChannelID string   `json:"channel_id"`
+		Errors    []string `json:"errors"`
+	} `json:"not_added"`
+	SlackResponse
+}
+
+// AdminBulkArchiveConversations archives the channels, see AdminBulkArchiveConversationsContext.
+func (api *Client) AdminBulkArchiveConversations(channelIDs []string, options ...BulkConversationsOption) (BulkConversationsResult, error) {
+	return api.AdminBulkArchiveConversationsContext(context.Background(), channelIDs, options...)
+}
+
+// AdminBulkArchiveConversationsContext archives the channels using admin.conversations.bulkArchive
+// with a custom context. see adminBulkConversations for details.
+func (api *Client) AdminBulkArchiveConversationsContext(ctx context.Context, channelIDs []string, options ...BulkConversationsOption) (BulkConversationsResult, error) {
+	return api.adminBulkConversations(ctx, "admin.conversations.bulkArchive", url.Values{}, channelIDs, options...)
+}
+
+// AdminBulkDeleteConversations deletes the channels, see AdminBulkDeleteConversationsContext.
+func (api *Client) AdminBulkDeleteConversations(channelIDs []string, options ...BulkConversationsOption) (BulkConversationsResult, error) {
+	return api.AdminBulkDeleteConversationsContext(context.Background(), channelIDs, options...)
+}
+
+// AdminBulkDeleteConversationsContext deletes the channels using admin.conversations.bulkDelete
+// with a custom context. see adminBulkConversations for details.
+func (api *Client) AdminBulkDeleteConversationsContext(ctx context.Context, channelIDs []string, options ...BulkConversationsOption) (BulkConversationsResult, error) {
+	return api.adminBulkConversations(ctx, "admin.conversations.bulkDelete", url.Values{}, channelIDs, options...)
+}
+
+// AdminBulkMoveConversations moves the channels to another workspace, see AdminBulkMoveConversationsContext.
+func (api *Client) AdminBulkMoveConversations(targetTeamID string, channelIDs []string, options ...BulkConversationsOption) (BulkConversationsResult, error) {
+	return api.AdminBulkMoveConversationsContext(context.Background(), targetTeamID, channelIDs, options...)
+}
+
+// AdminBulkMoveConversationsContext moves the channels to the workspace using admin.conversations.bulkMove
+// with a custom context. see adminBulkConversations for details.
+func (api *Client) AdminBulkMoveConversationsContext(ctx context.Context, targetTeamID string, channelIDs []string, options ...BulkConversationsOption) (BulkConversationsResult, error) {
+	return api.adminBulkConversations(ctx, "admin.conversations.bulkMove", url.Values{"target_team_id": {targetTeamID}}, channelIDs, options...)
+}
+
+// adminBulkConversations submits the channels in chunks of 10 by default. channels rejected
+// by slack are recorded in the result's Failures rather than stopping the action, see BulkConversationsResult.Err.
+// the returned error is only set when the action was interrupted, e.g. the context was cancelled.
+func (api *Client) adminBulkConversations(ctx context.Context, method string, values url.Values, channelIDs []string, options ...BulkConversationsOption) (result BulkConversationsResult, err error) {
+	config := bulkConversationsConfig{
+		chunk: 10,
+	}
+
+	for _, opt := range options {
+		opt(&config)
+	}
+
+	if config.chunk < 1 {
+		config.chunk = 1
+	}
+
+	result.Failures = make(map[string]error)
+	progress := BulkConversationsProgress{Total: len(channelIDs)}
+
+	for offset := 0; offset < len(channelIDs); {
+		end := offset + config.chunk
+		if end > len(channelIDs) {
+			end = len(channelIDs)
+		}
+		chunk := channelIDs[offset:end]
+
+		params := url.Values{
+			"token":       {api.token},
+			"channel_ids": {strings.Join(chunk, ",")},
+		}
+		for k, v := range values {
+			params[k] = v
+		}
+
+		response := &bulkConversationsResponse{}
+		err = api.retryRateLimited(ctx, func() error {
+			return api.postMethod(ctx, method, params, response)
+		})
+		if err == nil {
+			err = response.Err()
+		}
+
+		failed := len(result.Failures)
+		switch {
+		case len(response.NotAdded) > 0:
+			for _, rejected := range response.NotAdded {
+				result.Failures[rejected.ChannelID] = errors.New(strings.Join(rejected.Errors, ", "))
+			}
+		case err != nil:
+			for _, id := range chunk {
+				result.Failures[id] = err
+			}
+		}
+
+		if response.BulkActionID != "" {
+			result.ActionIDs = append(result.ActionIDs, response.BulkActionID)
+		}
+
+		failed = len(result.Failures) - failed
+		progress.Failed += failed
+		progress.Completed += len(chunk) - failed
+		if config.progress != nil {
+			config.progress(progress)
+		}
+
+		offset = end
+
+		if err = ctx.Err(); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// AdminConversation a conversation within an enterprise organization as returned by admin.conversations.search.
+type AdminConversation struct {
+	ID                         string   `json:"id"`
+	Name                       string   `json:"name"`
+	Purpose                    string   `json:"purpose"`
+	MemberCount                int      `json:"member_count"`
+	Created                    JSONTime `json:"created"`
+	CreatorID                  string   `json:"creator_id"`
+	IsPrivate                  bool     `json:"is_private"`
+	IsArchived                 bool     `json:"is_archived"`
+	IsGeneral                  bool     `json:"is_general"`
+	IsExtShared                bool     `json:"is_ext_shared"`
+	IsGlobalShared             bool     `json:"is_global_shared"`
+	IsOrgDefault               bool     `json:"is_org_default"`
+	IsOrgMandatory             bool     `json:"is_org_mandatory"`
+	IsOrgShared                bool     `json:"is_org_shared"`
+	IsFrozen                   bool     `json:"is_frozen"`
+	LastActivityTimestamp      int64    `json:"last_activity_ts"`
+	ConnectedTeamIDs           []string `json:"connected_team_ids"`
+	InternalTeamIDs            []string `json:"internal_team_ids"`
+	OriginalConnectedHostID    string   `json:"original_connected_host_id"`
+	OriginalConnectedChannelID string   `json:"original_connected_channel_id"`
+}
+
+// AdminSearchConversationsParameters parameters for admin.conversations.search.
+type AdminSearchConversationsParameters struct {
+	Query              string
+	SearchChannelTypes []string
+	Sort               string
+	SortDir            string
+	TeamIDs            []string
+	Limit              int
+	Cursor             string
+}
+
+type adminConversationsResponse struct {
+	Conversations []AdminConversation `json:"conversations"`
+	NextCursor    string              `json:"next_cursor"`
+	SlackResponse
+}
+
+// AdminSearchConversations searches the conversations of the organization, see AdminSearchConversationsContext.
+func (api *Client) AdminSearchConversations(params AdminSearchConversationsParameters) ([]AdminConversation, string, error) {
+	return api.AdminSearchConversationsContext(context.Background(), params)
+}
+
+// AdminSearchConversationsContext searches the conversations of the organization using
+// admin.conversations.search with a custom context, returns the cursor of the next page.
+func (api *Client) AdminSearchConversationsContext(ctx context.Context, params AdminSearchConversationsParameters) ([]AdminConversation, string, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+
+	if params.Query != "" {
+		values.Add("query", params.Query)
+	}
+
+	if len(params.SearchChannelTypes) > 0 {
+		values.Add("search_channel_types", strings.Join(params.SearchChannelTypes, ","))
+	}
+
+	if params.Sort != "" {
+		values.Add("sort", params.Sort)
+	}
+
+	if params.SortDir != "" {
+		values.Add("sort_dir", params.SortDir)
+	}
+
+	if len(params.TeamIDs) > 0 {
+		values.Add("team_ids", strings.Join(params.TeamIDs, ","))
+	}
+
+	if params.Limit > 0 {
+		values.Add("limit", strconv.Itoa(params.Limit))
+	}
+
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+
+	response := &adminConversationsResponse{}
+	if err := api.postMethod(ctx, "admin.conversations.search", values, response); err != nil {
+		return nil, "", err
+	}
+
+	return response.Conversations, response.NextCursor, response.Err()
+}
+
+// OrgConversationsOption options for iterating the conversations of an organization.
+type OrgConversationsOption func(*orgConversationsConfig)
+
+// OrgConversationsOptionInterval minimum delay between requests, defaults to 3 seconds
+// which keeps within slack's tier 2 rate limits.
+func OrgConversationsOptionInterval(d time.Duration) OrgConversationsOption {
+	return func(c *orgConversationsConfig) {
+		c.interval = d
+	}
+}
+
+// OrgConversationsOptionCheckpoint invoked with the cursor of the next page once every
+// conversation of the current page has been processed. persisting the cursor allows a
+// scan to be resumed by providing it as the Cursor parameter. the cursor is empty once
+// every conversation has been processed. returning an error stops the iteration.
+func OrgConversationsOptionCheckpoint(fn func(cursor string) error) OrgConversationsOption {
+	return func(c *orgConversationsConfig) {
+		c.checkpoint = fn
+	}
+}
+
+type orgConversationsConfig struct {
+	interval   time.Duration
+	checkpoint func(cursor string) error
+}
+
+// ForEachOrgConversation invokes fn for every conversation of the organization, see ForEachOrgConversationContext.
+func (api *Client) ForEachOrgConversation(params AdminSearchConversationsParameters, fn func(AdminConversation) error, options ...OrgConversationsOption) error {
+	return api.ForEachOrgConversationContext(context.Background(), params, fn, options...)
+}
+
+// ForEachOrgConversationContext invokes fn for every conversation of the enterprise organization matching
+// the parameters with a custom context. Requests are paced, see OrgConversationsOptionInterval, suitable for
+// governance scans across the entire organization. Iteration stops at the first error
+// returned by fn, returning ErrStopIteration stops the iteration without an error.
+func (api *Client) ForEachOrgConversationContext(ctx context.Context, params AdminSearchConversationsParameters, fn func(AdminConversation) error, options ...OrgConversationsOption) error {
+	var (
+		pace          <-chan time.Time
+		conversations []AdminConversation
+		next          string
+	)
+
+	config := orgConversationsConfig{
+		interval: 3 * time.Second,
+	}
+
+	for _, opt := range options {
+		opt(&config)
+	}
+
+	if params.Limit == 0 {
+		params.Limit = 20 // per slack api documentation.
+	}
+
+	if config.interval > 0 {
+		ticker := time.NewTicker(config.interval)
+		defer ticker.Stop()
+		pace = ticker.C
+	}
+
+	for {
+		err := api.retryRateLimited(ctx, func() (err error) {
+			conversations, next, err = api.AdminSearchConversationsContext(ctx, params)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, c := range conversations {
+			if err = fn(c); err == ErrStopIteration {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+
+		if config.checkpoint != nil {
+			if err = config.checkpoint(next); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+
+		params.Cursor = next
+
+		if pace != nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-pace:
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package slack
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminBulkArchiveConversations(t *testing.T) {
+	var (
+		requests []string
+		limited  bool
+	)
+
+	http.HandleFunc("/bulk/admin.conversations.bulkArchive", func(w http.ResponseWriter, r *http.Request) {
+		if !limited {
+			limited = true
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		ids := r.FormValue("channel_ids")
+		requests = append(requests, ids)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(ids, "C3"):
+			w.Write([]byte(`{"ok":false,"error":"failed_for_some_channels","not_added":[{"channel_id":"C3","errors":["channel_not_found"]}]}`))
+		case strings.Contains(ids, "C5"):
+			w.Write([]byte(`{"ok":false,"error":"restricted_action"}`))
+		default:
+			w.Write([]byte(`{"ok":true,"bulk_action_id":"Ba1"}`))
+		}
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/bulk/"))
+
+	var reports []BulkConversationsProgress
+	result, err := api.AdminBulkArchiveConversations(
+		[]string{"C1", "C2", "C3", "C4", "C5"},
+		BulkConversationsOptionChunkSize(2),
+		BulkConversationsOptionProgress(func(p BulkConversationsProgress) {
+			reports = append(reports, p)
+		}),
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C1,C2", "C3,C4", "C5"}, requests)
+	assert.Equal(t, []string{"Ba1"}, result.ActionIDs)
+	assert.Equal(t, 2, len(result.Failures))
+	assert.Equal(t, "channel_not_found", result.Failures["C3"].Error())
+	assert.Equal(t, "restricted_action", result.Failures["C5"].Error())
+	assert.Equal(t, "bulk action failed for 2 channel(s): C3: channel_not_found, C5: restricted_action", result.Err().Error())
+	assert.Equal(t, BulkConversationsProgress{Completed: 3, Failed: 2, Total: 5}, reports[len(reports)-1])
+	assert.Equal(t, 3, len(reports))
+}
+
+func TestForEachOrgConversation(t *testing.T) {
+	http.HandleFunc("/orgscan/admin.conversations.search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "20", r.FormValue("limit"))
+		assert.Equal(t, "public,private", r.FormValue("search_channel_types"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cursor") {
+		case "":
+			w.Write([]byte(`{"ok":true,"conversations":[{"id":"C1","name":"general","is_general":true}],"next_cursor":"page2"}`))
+		case "page2":
+			w.Write([]byte(`{"ok":true,"conversations":[{"id":"C2","name":"random"},{"id":"C3","name":"secret","is_private":true}],"next_cursor":""}`))
+		}
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/orgscan/"))
+
+	var (
+		ids         []string
+		checkpoints []string
+	)
+
+	err := api.ForEachOrgConversation(
+		AdminSearchConversationsParameters{SearchChannelTypes: []string{"public", "private"}},
+		func(c AdminConversation) error {
+			ids = append(ids, c.ID)
+			return nil
+		},
+		OrgConversationsOptionInterval(0),
+		OrgConversationsOptionCheckpoint(func(cursor string) error {
+			checkpoints = append(checkpoints, cursor)
+			return nil
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C1", "C2", "C3"}, ids)
+	assert.Equal(t, []string{"page2", ""}, checkpoints)
+
+	// resuming from the checkpoint only visits the remaining conversations.
+	ids = nil
+	err = api.ForEachOrgConversation(
+		AdminSearchConversationsParameters{SearchChannelTypes: []string{"public", "private"}, Cursor: "page2"},
+		func(c AdminConversation) error {
+			ids = append(ids, c.ID)
+			return ErrStopIteration
+		},
+		OrgConversationsOptionInterval(0),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C2"}, ids)
+}
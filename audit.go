@@ -0,0 +1,75 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// AuditFunc receives every message sent by the client, useful for archiving exactly
+// what a bot sent for compliance. It is invoked synchronously after the request completes,
+// implementations should be quick and must not modify the message.
+type AuditFunc func(ctx context.Context, msg AuditedMessage)
+
+// AuditedMessage the final rendered payload of a message sent by the client,
+// after all the MsgOptions were applied.
+type AuditedMessage struct {
+	// Method the api method used e.g. chat.postMessage, chat.update, chat.delete.
+	Method string
+	// Channel the message was sent to.
+	Channel string
+	// Timestamp of the message, for new messages this is the timestamp returned by slack.
+	Timestamp string
+	// Values the form values sent, excluding the token. blocks and attachments
+	// are JSON encoded exactly as they were sent.
+	Values url.Values
+	// Err the error returned by slack, if any.
+	Err error
+}
+
+// Blocks returns the JSON encoded blocks of the message.
+func (t AuditedMessage) Blocks() string {
+	return t.Values.Get("blocks")
+}
+
+// Text returns the text of the message.
+func (t AuditedMessage) Text() string {
+	return t.Values.Get("text")
+}
+
+func newAuditedMessage(config sendConfig, response chatResponseFull, err error) AuditedMessage {
+	values := make(url.Values, len(config.values))
+	for k, v := range config.values {
+		if k == "token" {
+			continue
+		}
+		values[k] = append([]string(nil), v...)
+	}
+
+	method := strings.TrimPrefix(config.endpoint, config.apiurl)
+	if config.mode == chatResponse {
+		method = string(chatResponse)
+	}
+
+	if err == nil {
+		err = response.Err()
+	}
+
+	channel := response.Channel
+	if channel == "" {
+		channel = values.Get("channel")
+	}
+
+	ts := values.Get("ts")
+	if ts == "" {
+		ts = response.getMessageTimestamp()
+	}
+
+	return AuditedMessage{
+		Method:    method,
+		Channel:   channel,
+		Timestamp: ts,
+		Values:    values,
+		Err:       err,
+	}
+}
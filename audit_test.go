@@ -0,0 +1,60 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionAudit(t *testing.T) {
+	var audited []AuditedMessage
+
+	http.HandleFunc("/audit/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"channel":"D1","ts":"1.000"}`))
+	})
+	http.HandleFunc("/audit/chat.update", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"channel":"D1","ts":"1.000"}`))
+	})
+	http.HandleFunc("/audit/chat.delete", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":false,"error":"message_not_found"}`))
+	})
+
+	once.Do(startServer)
+	api := New(
+		"testing-token",
+		OptionAPIURL("http://"+serverAddr+"/audit/"),
+		OptionAudit(func(ctx context.Context, msg AuditedMessage) {
+			audited = append(audited, msg)
+		}),
+	)
+
+	_, _, err := api.PostMessage("U1", MsgOptionText("hello", false), MsgOptionBlocks(NewDividerBlock()))
+	assert.Nil(t, err)
+	_, _, _, err = api.UpdateMessage("D1", "1.000", MsgOptionText("updated", false))
+	assert.Nil(t, err)
+	_, _, err = api.DeleteMessage("D1", "1.000")
+	assert.NotNil(t, err)
+
+	if !assert.Len(t, audited, 3) {
+		return
+	}
+
+	assert.Equal(t, "chat.postMessage", audited[0].Method)
+	assert.Equal(t, "D1", audited[0].Channel)
+	assert.Equal(t, "1.000", audited[0].Timestamp)
+	assert.Equal(t, "hello", audited[0].Text())
+	assert.Equal(t, `[{"type":"divider"}]`, audited[0].Blocks())
+	assert.Equal(t, "", audited[0].Values.Get("token"))
+
+	assert.Equal(t, "chat.update", audited[1].Method)
+	assert.Equal(t, "updated", audited[1].Text())
+
+	assert.Equal(t, "chat.delete", audited[2].Method)
+	assert.Equal(t, "1.000", audited[2].Timestamp)
+	assert.EqualError(t, audited[2].Err, "message_not_found")
+}
@@ -35,7 +35,7 @@ func (b *backoff) Duration() (dur time.Duration) {
 	}
 
 	// calculate this duration
-	if dur = time.Duration(1 << uint(b.attempts)); dur > 0 {
+	if dur = time.Duration(1 << uint(b.attempts)); dur > 0 && dur <= b.Max/b.Initial {
 		dur = dur * b.Initial
 	} else {
 		dur = b.Max
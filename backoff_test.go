@@ -0,0 +1,22 @@
+package slack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffMax(t *testing.T) {
+	b := backoff{Initial: time.Second, Max: 5 * time.Second}
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, e := range expected {
+		if d := b.Duration(); d != e {
+			t.Errorf("attempt %d: expected %v, got %v", i, e, d)
+		}
+	}
+
+	b.attempts = 80
+	if d := b.Duration(); d != 5*time.Second {
+		t.Errorf("expected the maximum after overflowing, got %v", d)
+	}
+}
@@ -14,6 +14,10 @@ const (
 	MBTImage   MessageBlockType = "image"
 	MBTAction  MessageBlockType = "actions"
 	MBTContext MessageBlockType = "context"
+	MBTHeader  MessageBlockType = "header"
+	MBTVideo   MessageBlockType = "video"
+	MBTCall    MessageBlockType = "call"
+	MBTInput   MessageBlockType = "input"
 )
 
 // Block defines an interface all block types should implement
@@ -30,22 +34,27 @@ type Blocks struct {
 
 // BlockAction is the action callback sent when a block is interacted with
 type BlockAction struct {
-	ActionID             string            `json:"action_id"`
-	BlockID              string            `json:"block_id"`
-	Type                 actionType        `json:"type"`
-	Text                 TextBlockObject   `json:"text"`
-	Value                string            `json:"value"`
-	ActionTs             string            `json:"action_ts"`
-	SelectedOption       OptionBlockObject `json:"selected_option"`
-	SelectedUser         string            `json:"selected_user"`
-	SelectedChannel      string            `json:"selected_channel"`
-	SelectedConversation string            `json:"selected_conversation"`
-	SelectedDate         string            `json:"selected_date"`
-	InitialOption        OptionBlockObject `json:"initial_option"`
-	InitialUser          string            `json:"initial_user"`
-	InitialChannel       string            `json:"initial_channel"`
-	InitialConversation  string            `json:"initial_conversation"`
-	InitialDate          string            `json:"initial_date"`
+	ActionID              string              `json:"action_id"`
+	BlockID               string              `json:"block_id"`
+	Type                  actionType          `json:"type"`
+	Text                  TextBlockObject     `json:"text"`
+	Value                 string              `json:"value"`
+	ActionTs              string              `json:"action_ts"`
+	SelectedOption        OptionBlockObject   `json:"selected_option"`
+	SelectedUser          string              `json:"selected_user"`
+	SelectedChannel       string              `json:"selected_channel"`
+	SelectedConversation  string              `json:"selected_conversation"`
+	SelectedDate          string              `json:"selected_date"`
+	SelectedTime          string              `json:"selected_time,omitempty"`
+	SelectedOptions       []OptionBlockObject `json:"selected_options,omitempty"`
+	SelectedUsers         []string            `json:"selected_users,omitempty"`
+	SelectedChannels      []string            `json:"selected_channels,omitempty"`
+	SelectedConversations []string            `json:"selected_conversations,omitempty"`
+	InitialOption         OptionBlockObject   `json:"initial_option"`
+	InitialUser           string              `json:"initial_user"`
+	InitialChannel        string              `json:"initial_channel"`
+	InitialConversation   string              `json:"initial_conversation"`
+	InitialDate           string              `json:"initial_date"`
 }
 
 // actionType returns the type of the action
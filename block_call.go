@@ -0,0 +1,42 @@
+package slack
+
+// CallBlock defines data required to display a call, calls are posted by
+// apps integrating with the calls api and when a huddle or call is started.
+//
+// More Information: https://api.slack.com/reference/block-kit/blocks#call
+type CallBlock struct {
+	Type                   MessageBlockType `json:"type"`
+	BlockID                string           `json:"block_id,omitempty"`
+	CallID                 string           `json:"call_id"`
+	APIDecorationAvailable bool             `json:"api_decoration_available,omitempty"`
+	Call                   *CallBlockData   `json:"call,omitempty"`
+}
+
+// CallBlockData the details of the call displayed by the block, only present in
+// messages retrieved from slack.
+type CallBlockData struct {
+	V1 *CallInfo `json:"v1,omitempty"`
+}
+
+// BlockType returns the type of the block
+func (s CallBlock) BlockType() MessageBlockType {
+	return s.Type
+}
+
+// Info returns the details of the call, nil when unavailable.
+func (s CallBlock) Info() *CallInfo {
+	if s.Call == nil {
+		return nil
+	}
+
+	return s.Call.V1
+}
+
+// NewCallBlock returns an instance of a new Call Block type for a call
+// registered using the calls api.
+func NewCallBlock(callID string) *CallBlock {
+	return &CallBlock{
+		Type:   MBTCall,
+		CallID: callID,
+	}
+}
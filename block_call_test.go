@@ -0,0 +1,68 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCallBlock(t *testing.T) {
+	callBlock := NewCallBlock("R0123")
+
+	assert.Equal(t, string(callBlock.Type), "call")
+	assert.Equal(t, callBlock.CallID, "R0123")
+	assert.Nil(t, callBlock.Info())
+}
+
+func TestCallBlockMessage(t *testing.T) {
+	raw := `{
+		"type": "message",
+		"subtype": "huddle_thread",
+		"ts": "1650000000.000100",
+		"blocks": [{
+			"type": "call",
+			"call_id": "R0123",
+			"api_decoration_available": false,
+			"call": {
+				"v1": {
+					"id": "R0123",
+					"app_id": "A00",
+					"date_start": 1650000000,
+					"active_participants": [{"slack_id": "U1"}],
+					"all_participants": [{"slack_id": "U1"}, {"slack_id": "U2"}],
+					"join_url": "https://app.slack.com/free-willy/T1/R0123",
+					"channels": ["C1"],
+					"has_ended": false
+				}
+			}
+		}],
+		"room": {
+			"id": "R0123",
+			"created_by": "U1",
+			"date_start": 1650000000,
+			"date_end": 0,
+			"participants": ["U1"],
+			"participant_history": ["U1", "U2"],
+			"channels": ["C1"],
+			"has_ended": false,
+			"call_family": "huddle"
+		}
+	}`
+
+	var msg Message
+	assert.Nil(t, json.Unmarshal([]byte(raw), &msg))
+
+	calls := msg.Calls()
+	assert.Equal(t, 1, len(calls))
+	info := calls[0].Info()
+	assert.NotNil(t, info)
+	assert.True(t, info.Active())
+	assert.Equal(t, "https://app.slack.com/free-willy/T1/R0123", info.JoinURL)
+	assert.Equal(t, []CallParticipant{{SlackID: "U1"}}, info.ActiveParticipants)
+
+	assert.NotNil(t, msg.Room)
+	assert.True(t, msg.Room.Active())
+	assert.Equal(t, "huddle", msg.Room.CallFamily)
+	assert.Equal(t, []string{"U1", "U2"}, msg.Room.ParticipantHistory)
+}
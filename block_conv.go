@@ -1,10 +1,6 @@
 package slack
 
-import (
-	"encoding/json"
-
-	"github.com/pkg/errors"
-)
+import "encoding/json"
 
 type sumtype struct {
 	TypeVal string `json:"type"`
@@ -52,16 +48,24 @@ func (b *Blocks) UnmarshalJSON(data []byte) error {
 		switch blockType {
 		case "actions":
 			block = &ActionBlock{}
+		case "call":
+			block = &CallBlock{}
 		case "context":
 			block = &ContextBlock{}
 		case "divider":
 			block = &DividerBlock{}
+		case "header":
+			block = &HeaderBlock{}
 		case "image":
 			block = &ImageBlock{}
+		case "input":
+			block = &InputBlock{}
 		case "section":
 			block = &SectionBlock{}
+		case "video":
+			block = &VideoBlock{}
 		default:
-			return errors.New("unsupported block type")
+			block = &UnknownBlock{}
 		}
 
 		err = json.Unmarshal(r, block)
@@ -126,8 +130,14 @@ func (b *BlockElements) UnmarshalJSON(data []byte) error {
 			blockElement = &DatePickerBlockElement{}
 		case "static_select", "external_select", "users_select", "conversations_select", "channels_select":
 			blockElement = &SelectBlockElement{}
+		case "multi_static_select", "multi_external_select", "multi_users_select", "multi_conversations_select", "multi_channels_select":
+			blockElement = &MultiSelectBlockElement{}
+		case "plain_text_input":
+			blockElement = &PlainTextInputBlockElement{}
+		case "checkboxes":
+			blockElement = &CheckboxGroupsBlockElement{}
 		default:
-			return errors.New("unsupported block element type")
+			blockElement = &UnknownBlockElement{}
 		}
 
 		err = json.Unmarshal(r, blockElement)
@@ -295,7 +305,12 @@ func (e *ContextElements) UnmarshalJSON(data []byte) error {
 
 			e.Elements = append(e.Elements, elem.(*ImageBlockElement))
 		default:
-			return errors.New("unsupported context element type")
+			elem := &UnknownBlockElement{}
+			if err := json.Unmarshal(r, elem); err != nil {
+				return err
+			}
+
+			e.Elements = append(e.Elements, elem)
 		}
 	}
 
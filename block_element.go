@@ -1,12 +1,16 @@
 package slack
 
+import "fmt"
+
 // https://api.slack.com/reference/messaging/block-elements
 
 const (
-	METImage      MessageElementType = "image"
-	METButton     MessageElementType = "button"
-	METOverflow   MessageElementType = "overflow"
-	METDatepicker MessageElementType = "datepicker"
+	METImage          MessageElementType = "image"
+	METButton         MessageElementType = "button"
+	METOverflow       MessageElementType = "overflow"
+	METDatepicker     MessageElementType = "datepicker"
+	METPlainTextInput MessageElementType = "plain_text_input"
+	METCheckboxGroups MessageElementType = "checkboxes"
 
 	MixedElementImage MixedElementType = "mixed_image"
 	MixedElementText  MixedElementType = "mixed_text"
@@ -16,6 +20,17 @@ const (
 	OptTypeUser          string = "users_select"
 	OptTypeConversations string = "conversations_select"
 	OptTypeChannels      string = "channels_select"
+
+	MultiOptTypeStatic        string = "multi_static_select"
+	MultiOptTypeExternal      string = "multi_external_select"
+	MultiOptTypeUser          string = "multi_users_select"
+	MultiOptTypeConversations string = "multi_conversations_select"
+	MultiOptTypeChannels      string = "multi_channels_select"
+
+	// OverflowMinOptions minimum number of options of an overflow menu.
+	OverflowMinOptions = 2
+	// OverflowMaxOptions maximum number of options of an overflow menu.
+	OverflowMaxOptions = 5
 )
 
 type MessageElementType string
@@ -67,10 +82,13 @@ type BlockElements struct {
 // in it, you're looking for the image block.
 //
 // More Information: https://api.slack.com/reference/messaging/block-elements#image
+//
+// One of ImageURL or SlackFile must be provided.
 type ImageBlockElement struct {
-	Type     MessageElementType `json:"type"`
-	ImageURL string             `json:"image_url"`
-	AltText  string             `json:"alt_text"`
+	Type      MessageElementType `json:"type"`
+	ImageURL  string             `json:"image_url,omitempty"`
+	SlackFile *SlackFileObject   `json:"slack_file,omitempty"`
+	AltText   string             `json:"alt_text"`
 }
 
 // ElementType returns the type of the Element
@@ -91,6 +109,16 @@ func NewImageBlockElement(imageURL, altText string) *ImageBlockElement {
 	}
 }
 
+// NewSlackFileImageBlockElement returns a new instance of an image block element
+// displaying a file uploaded to slack.
+func NewSlackFileImageBlockElement(file *SlackFileObject, altText string) *ImageBlockElement {
+	return &ImageBlockElement{
+		Type:      METImage,
+		SlackFile: file,
+		AltText:   altText,
+	}
+}
+
 type Style string
 
 const (
@@ -124,6 +152,12 @@ func (s *ButtonBlockElement) WithStyle(style Style) {
 	s.Style = style
 }
 
+// WithConfirm requires the user to confirm the action before it is taken.
+func (s *ButtonBlockElement) WithConfirm(confirm *ConfirmationBlockObject) *ButtonBlockElement {
+	s.Confirm = confirm
+	return s
+}
+
 // NewButtonBlockElement returns an instance of a new button element to be used within a block
 func NewButtonBlockElement(actionID, value string, text *TextBlockObject) *ButtonBlockElement {
 	return &ButtonBlockElement{
@@ -157,6 +191,12 @@ func (s SelectBlockElement) ElementType() MessageElementType {
 	return MessageElementType(s.Type)
 }
 
+// WithConfirm requires the user to confirm the selection before it is taken.
+func (s *SelectBlockElement) WithConfirm(confirm *ConfirmationBlockObject) *SelectBlockElement {
+	s.Confirm = confirm
+	return s
+}
+
 // NewOptionsSelectBlockElement returns a new instance of SelectBlockElement for use with
 // the Options object only.
 func NewOptionsSelectBlockElement(optType string, placeholder *TextBlockObject, actionID string, options ...*OptionBlockObject) *SelectBlockElement {
@@ -211,6 +251,21 @@ func NewOverflowBlockElement(actionID string, options ...*OptionBlockObject) *Ov
 	}
 }
 
+// WithConfirm requires the user to confirm the selected option before it is taken.
+func (s *OverflowBlockElement) WithConfirm(confirm *ConfirmationBlockObject) *OverflowBlockElement {
+	s.Confirm = confirm
+	return s
+}
+
+// Validate ensures the overflow menu has between OverflowMinOptions and OverflowMaxOptions options.
+func (s OverflowBlockElement) Validate() error {
+	if n := len(s.Options); n < OverflowMinOptions || n > OverflowMaxOptions {
+		return fmt.Errorf("overflow %s: requires between %d and %d options, received %d", s.ActionID, OverflowMinOptions, OverflowMaxOptions, n)
+	}
+
+	return nil
+}
+
 // DatePickerBlockElement defines an element which lets users easily select a
 // date from a calendar style UI. Date picker elements can be used inside of
 // section and actions blocks.
@@ -229,6 +284,12 @@ func (s DatePickerBlockElement) ElementType() MessageElementType {
 	return s.Type
 }
 
+// WithConfirm requires the user to confirm the selected date before it is taken.
+func (s *DatePickerBlockElement) WithConfirm(confirm *ConfirmationBlockObject) *DatePickerBlockElement {
+	s.Confirm = confirm
+	return s
+}
+
 // NewDatePickerBlockElement returns an instance of a date picker element
 func NewDatePickerBlockElement(actionID string) *DatePickerBlockElement {
 	return &DatePickerBlockElement{
@@ -236,3 +297,89 @@ func NewDatePickerBlockElement(actionID string) *DatePickerBlockElement {
 		ActionID: actionID,
 	}
 }
+
+// PlainTextInputBlockElement a text input, only available in input blocks.
+//
+// More Information: https://api.slack.com/reference/block-kit/block-elements#input
+type PlainTextInputBlockElement struct {
+	Type         MessageElementType `json:"type"`
+	ActionID     string             `json:"action_id,omitempty"`
+	Placeholder  *TextBlockObject   `json:"placeholder,omitempty"`
+	InitialValue string             `json:"initial_value,omitempty"`
+	Multiline    bool               `json:"multiline,omitempty"`
+	MinLength    int                `json:"min_length,omitempty"`
+	MaxLength    int                `json:"max_length,omitempty"`
+}
+
+// ElementType returns the type of the Element
+func (s PlainTextInputBlockElement) ElementType() MessageElementType {
+	return s.Type
+}
+
+// NewPlainTextInputBlockElement returns an instance of a plain text input element
+func NewPlainTextInputBlockElement(placeholder *TextBlockObject, actionID string) *PlainTextInputBlockElement {
+	return &PlainTextInputBlockElement{
+		Type:        METPlainTextInput,
+		ActionID:    actionID,
+		Placeholder: placeholder,
+	}
+}
+
+// MultiSelectBlockElement a select menu allowing multiple options to be selected, only
+// available in input blocks.
+//
+// More Information: https://api.slack.com/reference/block-kit/block-elements#multi_select
+type MultiSelectBlockElement struct {
+	Type                 string                    `json:"type,omitempty"`
+	Placeholder          *TextBlockObject          `json:"placeholder,omitempty"`
+	ActionID             string                    `json:"action_id,omitempty"`
+	Options              []*OptionBlockObject      `json:"options,omitempty"`
+	OptionGroups         []*OptionGroupBlockObject `json:"option_groups,omitempty"`
+	InitialOptions       []*OptionBlockObject      `json:"initial_options,omitempty"`
+	InitialUsers         []string                  `json:"initial_users,omitempty"`
+	InitialConversations []string                  `json:"initial_conversations,omitempty"`
+	InitialChannels      []string                  `json:"initial_channels,omitempty"`
+	MinQueryLength       int                       `json:"min_query_length,omitempty"`
+	MaxSelectedItems     int                       `json:"max_selected_items,omitempty"`
+	Confirm              *ConfirmationBlockObject  `json:"confirm,omitempty"`
+}
+
+// ElementType returns the type of the Element
+func (s MultiSelectBlockElement) ElementType() MessageElementType {
+	return MessageElementType(s.Type)
+}
+
+// NewOptionsMultiSelectBlockElement returns a new instance of MultiSelectBlockElement
+func NewOptionsMultiSelectBlockElement(optType string, placeholder *TextBlockObject, actionID string, options ...*OptionBlockObject) *MultiSelectBlockElement {
+	return &MultiSelectBlockElement{
+		Type:        optType,
+		Placeholder: placeholder,
+		ActionID:    actionID,
+		Options:     options,
+	}
+}
+
+// CheckboxGroupsBlockElement a group of checkboxes.
+//
+// More Information: https://api.slack.com/reference/block-kit/block-elements#checkboxes
+type CheckboxGroupsBlockElement struct {
+	Type           MessageElementType       `json:"type"`
+	ActionID       string                   `json:"action_id,omitempty"`
+	Options        []*OptionBlockObject     `json:"options"`
+	InitialOptions []*OptionBlockObject     `json:"initial_options,omitempty"`
+	Confirm        *ConfirmationBlockObject `json:"confirm,omitempty"`
+}
+
+// ElementType returns the type of the Element
+func (s CheckboxGroupsBlockElement) ElementType() MessageElementType {
+	return s.Type
+}
+
+// NewCheckboxGroupsBlockElement returns an instance of a checkbox group element
+func NewCheckboxGroupsBlockElement(actionID string, options ...*OptionBlockObject) *CheckboxGroupsBlockElement {
+	return &CheckboxGroupsBlockElement{
+		Type:     METCheckboxGroups,
+		ActionID: actionID,
+		Options:  options,
+	}
+}
@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -84,3 +85,35 @@ func TestNewDatePickerBlockElement(t *testing.T) {
 	assert.Equal(t, datepickerElement.ActionID, "test")
 
 }
+
+func TestOverflowBlockElementURLOptions(t *testing.T) {
+	docs := NewURLOptionBlockObject("docs", "https://api.slack.com", NewTextBlockObject("plain_text", "Docs", false, false))
+	remove := NewOptionBlockObject("remove", NewTextBlockObject("plain_text", "Remove", false, false))
+
+	overflowElement := NewOverflowBlockElement("test", docs, remove)
+	assert.Nil(t, overflowElement.Validate())
+	assert.Equal(t, "https://api.slack.com", overflowElement.Options[0].URL)
+
+	encoded, err := json.Marshal(remove)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(encoded), "url")
+
+	assert.NotNil(t, NewOverflowBlockElement("test", docs).Validate())
+	assert.NotNil(t, NewOverflowBlockElement("test", docs, docs, docs, docs, docs, docs).Validate())
+}
+
+func TestButtonBlockElementWithConfirm(t *testing.T) {
+	confirmation := NewConfirmationBlockObject(
+		NewTextBlockObject("plain_text", "Are you sure?", false, false),
+		NewTextBlockObject("plain_text", "This cannot be undone", false, false),
+		NewTextBlockObject("plain_text", "Delete", false, false),
+		NewTextBlockObject("plain_text", "Cancel", false, false),
+	).WithStyle(StyleDanger)
+
+	btn := NewButtonBlockElement("delete", "123", NewTextBlockObject("plain_text", "Delete", false, false)).WithConfirm(confirmation)
+
+	encoded, err := json.Marshal(btn)
+	assert.Nil(t, err)
+	assert.Contains(t, string(encoded), `"confirm":{"title":{"type":"plain_text","text":"Are you sure?"}`)
+	assert.Contains(t, string(encoded), `"style":"danger"}`)
+}
@@ -0,0 +1,33 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHeaderBlock(t *testing.T) {
+
+	textInfo := NewTextBlockObject("plain_text", "Budget Performance", false, false)
+	headerBlock := NewHeaderBlock(textInfo, HeaderBlockOptionBlockID("test_block"))
+
+	assert.Equal(t, string(headerBlock.Type), "header")
+	assert.Equal(t, headerBlock.BlockID, "test_block")
+	assert.Equal(t, headerBlock.Text.Type, "plain_text")
+	assert.Contains(t, headerBlock.Text.Text, "Budget Performance")
+
+}
+
+func TestHeaderBlockUnmarshal(t *testing.T) {
+
+	var blocks Blocks
+	err := json.Unmarshal([]byte(`[{"type":"header","block_id":"h1","text":{"type":"plain_text","text":"Status"}}]`), &blocks)
+	assert.Nil(t, err)
+	assert.Equal(t, len(blocks.BlockSet), 1)
+
+	headerBlock := blocks.BlockSet[0].(*HeaderBlock)
+	assert.Equal(t, headerBlock.BlockID, "h1")
+	assert.Equal(t, headerBlock.Text.Text, "Status")
+
+}
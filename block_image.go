@@ -3,12 +3,15 @@ package slack
 // ImageBlock defines data required to display an image as a block element
 //
 // More Information: https://api.slack.com/reference/messaging/blocks#image
+//
+// One of ImageURL or SlackFile must be provided.
 type ImageBlock struct {
-	Type     MessageBlockType `json:"type"`
-	ImageURL string           `json:"image_url"`
-	AltText  string           `json:"alt_text"`
-	BlockID  string           `json:"block_id,omitempty"`
-	Title    *TextBlockObject `json:"title"`
+	Type      MessageBlockType `json:"type"`
+	ImageURL  string           `json:"image_url,omitempty"`
+	SlackFile *SlackFileObject `json:"slack_file,omitempty"`
+	AltText   string           `json:"alt_text"`
+	BlockID   string           `json:"block_id,omitempty"`
+	Title     *TextBlockObject `json:"title"`
 }
 
 // BlockType returns the type of the block
@@ -26,3 +29,15 @@ func NewImageBlock(imageURL, altText, blockID string, title *TextBlockObject) *I
 		Title:    title,
 	}
 }
+
+// NewSlackFileImageBlock returns an instance of a new Image Block type displaying
+// a file uploaded to slack.
+func NewSlackFileImageBlock(file *SlackFileObject, altText, blockID string, title *TextBlockObject) *ImageBlock {
+	return &ImageBlock{
+		Type:      MBTImage,
+		SlackFile: file,
+		AltText:   altText,
+		BlockID:   blockID,
+		Title:     title,
+	}
+}
@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -18,3 +19,21 @@ func TestNewImageBlock(t *testing.T) {
 	assert.Contains(t, imageBlock.ImageURL, "tripAgentLocationMarker.png")
 
 }
+
+func TestNewSlackFileImageBlock(t *testing.T) {
+
+	imageBlock := NewSlackFileImageBlock(NewSlackFileObjectFromID("F0123456"), "Marker", "test", nil)
+
+	assert.Equal(t, string(imageBlock.Type), "image")
+	assert.Equal(t, imageBlock.SlackFile.ID, "F0123456")
+
+	encoded, err := json.Marshal(imageBlock)
+	assert.Nil(t, err)
+	assert.Contains(t, string(encoded), `"slack_file":{"id":"F0123456"}`)
+	assert.NotContains(t, string(encoded), "image_url")
+
+	var blocks Blocks
+	assert.Nil(t, json.Unmarshal([]byte(`[`+string(encoded)+`]`), &blocks))
+	assert.Equal(t, imageBlock, blocks.BlockSet[0])
+
+}
@@ -0,0 +1,83 @@
+package slack
+
+import (
+	"encoding/json"
+)
+
+// InputBlock collects information from users in modals.
+//
+// More Information: https://api.slack.com/reference/block-kit/blocks#input
+type InputBlock struct {
+	Type     MessageBlockType `json:"type"`
+	BlockID  string           `json:"block_id,omitempty"`
+	Label    *TextBlockObject `json:"label"`
+	Element  BlockElement     `json:"element"`
+	Hint     *TextBlockObject `json:"hint,omitempty"`
+	Optional bool             `json:"optional,omitempty"`
+}
+
+// BlockType returns the type of the block
+func (s InputBlock) BlockType() MessageBlockType {
+	return s.Type
+}
+
+// InputBlockOption allows configuration of options for a new input block
+type InputBlockOption func(*InputBlock)
+
+// InputBlockOptionHint sets the hint displayed below the input.
+func InputBlockOptionHint(hint *TextBlockObject) InputBlockOption {
+	return func(block *InputBlock) {
+		block.Hint = hint
+	}
+}
+
+// InputBlockOptionOptional allows the modal to be submitted without a value for the input.
+func InputBlockOptionOptional(optional bool) InputBlockOption {
+	return func(block *InputBlock) {
+		block.Optional = optional
+	}
+}
+
+// NewInputBlock returns a new instance of an input block, the label must be a plain_text object.
+func NewInputBlock(blockID string, label *TextBlockObject, element BlockElement, options ...InputBlockOption) *InputBlock {
+	block := InputBlock{
+		Type:    MBTInput,
+		BlockID: blockID,
+		Label:   label,
+		Element: element,
+	}
+
+	for _, option := range options {
+		option(&block)
+	}
+
+	return &block
+}
+
+// UnmarshalJSON decodes the element of the input block based on its type.
+func (s *InputBlock) UnmarshalJSON(data []byte) error {
+	type alias InputBlock
+	decoded := struct {
+		*alias
+		Element json.RawMessage `json:"element"`
+	}{alias: (*alias)(s)}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	if len(decoded.Element) == 0 || string(decoded.Element) == "null" {
+		return nil
+	}
+
+	var elements BlockElements
+	if err := json.Unmarshal(append(append([]byte("["), decoded.Element...), ']'), &elements); err != nil {
+		return err
+	}
+
+	if len(elements.ElementSet) > 0 {
+		s.Element = elements.ElementSet[0]
+	}
+
+	return nil
+}
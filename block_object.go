@@ -2,6 +2,8 @@ package slack
 
 import (
 	"encoding/json"
+	"fmt"
+	"unicode/utf8"
 )
 
 // Block Objects are also known as Composition Objects
@@ -145,6 +147,52 @@ func NewTextBlockObject(elementType, text string, emoji, verbatim bool) *TextBlo
 	}
 }
 
+// Mrkdwn returns a markdown text object.
+func Mrkdwn(text string) *TextBlockObject {
+	return NewTextBlockObject(MarkdownType, text, false, false)
+}
+
+// PlainText returns a plain text object, emoji controls whether emoji are escaped into the colon format.
+func PlainText(text string, emoji bool) *TextBlockObject {
+	return NewTextBlockObject(PlainTextType, text, emoji, false)
+}
+
+// Maximum text lengths of various block elements, text exceeding these limits
+// results in an invalid_blocks error.
+const (
+	SectionTextMaxLength  = 3000
+	SectionFieldMaxLength = 2000
+	HeaderTextMaxLength   = 150
+	ContextTextMaxLength  = 2000
+	ButtonTextMaxLength   = 75
+	OptionTextMaxLength   = 75
+	PlaceholderMaxLength  = 150
+)
+
+// Ellipsis is appended to text shortened by Truncate.
+const Ellipsis = "…"
+
+// Truncate shortens the text to at most max characters, replacing the end of the
+// text with an Ellipsis when it is too long.
+func Truncate(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+
+	if max <= 0 {
+		return ""
+	}
+
+	runes := []rune(text)
+	return string(runes[:max-1]) + Ellipsis
+}
+
+// Truncate shortens the text of the object to at most max characters, see Truncate.
+func (s *TextBlockObject) Truncate(max int) *TextBlockObject {
+	s.Text = Truncate(s.Text, max)
+	return s
+}
+
 // ConfirmationBlockObject defines a dialog that provides a confirmation step to
 // any interactive element. This dialog will ask the user to confirm their action by
 // offering a confirm and deny buttons.
@@ -155,13 +203,60 @@ type ConfirmationBlockObject struct {
 	Text    *TextBlockObject `json:"text"`
 	Confirm *TextBlockObject `json:"confirm"`
 	Deny    *TextBlockObject `json:"deny"`
+	Style   Style            `json:"style,omitempty"`
 }
 
+// Maximum lengths of the fields of a ConfirmationBlockObject.
+const (
+	ConfirmationTitleMaxLength   = 100
+	ConfirmationTextMaxLength    = 300
+	ConfirmationConfirmMaxLength = 30
+	ConfirmationDenyMaxLength    = 30
+)
+
 // validateType enforces block objects for element and block parameters
 func (s ConfirmationBlockObject) validateType() MessageObjectType {
 	return motConfirmation
 }
 
+// WithStyle add styling to the confirm button, only StylePrimary and StyleDanger are supported.
+func (s *ConfirmationBlockObject) WithStyle(style Style) *ConfirmationBlockObject {
+	s.Style = style
+	return s
+}
+
+// Validate ensures all fields are present and within slack's length limits.
+func (s ConfirmationBlockObject) Validate() error {
+	fields := []struct {
+		name string
+		obj  *TextBlockObject
+		max  int
+	}{
+		{name: "title", obj: s.Title, max: ConfirmationTitleMaxLength},
+		{name: "text", obj: s.Text, max: ConfirmationTextMaxLength},
+		{name: "confirm", obj: s.Confirm, max: ConfirmationConfirmMaxLength},
+		{name: "deny", obj: s.Deny, max: ConfirmationDenyMaxLength},
+	}
+
+	for _, f := range fields {
+		if f.obj == nil || f.obj.Text == "" {
+			return fmt.Errorf("confirmation %s: is required", f.name)
+		}
+
+		if n := utf8.RuneCountInString(f.obj.Text); n > f.max {
+			return fmt.Errorf("confirmation %s: maximum length is %d, received %d", f.name, f.max, n)
+		}
+	}
+
+	switch s.Style {
+	case "", StylePrimary, StyleDanger:
+	default:
+		return fmt.Errorf("confirmation style: unsupported style %s", s.Style)
+	}
+
+	return nil
+}
+
 // NewConfirmationBlockObject returns an instance of a new Confirmation Block Object
 func NewConfirmationBlockObject(title, text, confirm, deny *TextBlockObject) *ConfirmationBlockObject {
 	return &ConfirmationBlockObject{
@@ -178,7 +273,7 @@ func NewConfirmationBlockObject(title, text, confirm, deny *TextBlockObject) *Co
 type OptionBlockObject struct {
 	Text  *TextBlockObject `json:"text"`
 	Value string           `json:"value"`
-	URL   string           `json:"url"`
+	URL   string           `json:"url,omitempty"`
 }
 
 // NewOptionBlockObject returns an instance of a new Option Block Element
@@ -189,6 +284,16 @@ func NewOptionBlockObject(value string, text *TextBlockObject) *OptionBlockObjec
 	}
 }
 
+// NewURLOptionBlockObject returns an instance of a new Option Block Element that
+// opens the provided url when selected. URL options are only supported by overflow menus.
+func NewURLOptionBlockObject(value, url string, text *TextBlockObject) *OptionBlockObject {
+	return &OptionBlockObject{
+		Text:  text,
+		Value: value,
+		URL:   url,
+	}
+}
+
 // validateType enforces block objects for element and block parameters
 func (s OptionBlockObject) validateType() MessageObjectType {
 	return motOption
@@ -214,3 +319,22 @@ func NewOptionGroupBlockElement(label *TextBlockObject, options ...*OptionBlockO
 		Options: options,
 	}
 }
+
+// SlackFileObject references an image file uploaded to slack, either by its
+// id or its url. Only one of ID or URL should be provided.
+//
+// More Information: https://api.slack.com/reference/block-kit/composition-objects#slack_file
+type SlackFileObject struct {
+	ID  string `json:"id,omitempty"`
+	URL string `json:"url,omitempty"`
+}
+
+// NewSlackFileObjectFromID references a slack file by its id.
+func NewSlackFileObjectFromID(id string) *SlackFileObject {
+	return &SlackFileObject{ID: id}
+}
+
+// NewSlackFileObjectFromURL references a slack file by its url_private or permalink.
+func NewSlackFileObjectFromURL(url string) *SlackFileObject {
+	return &SlackFileObject{URL: url}
+}
@@ -1,7 +1,9 @@
 package slack
 
 import (
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -64,3 +66,46 @@ func TestNewOptionGroupBlockElement(t *testing.T) {
 	assert.Len(t, optGroup.Options, 1, "Options should contain one element")
 
 }
+
+func TestConfirmationBlockObjectValidate(t *testing.T) {
+	confirmation := NewConfirmationBlockObject(
+		NewTextBlockObject("plain_text", "Are you sure?", false, false),
+		NewTextBlockObject("plain_text", "This cannot be undone", false, false),
+		NewTextBlockObject("plain_text", "Delete", false, false),
+		NewTextBlockObject("plain_text", "Cancel", false, false),
+	).WithStyle(StyleDanger)
+
+	assert.Nil(t, confirmation.Validate())
+	assert.Equal(t, StyleDanger, confirmation.Style)
+
+	confirmation.Confirm = NewTextBlockObject("plain_text", strings.Repeat("x", ConfirmationConfirmMaxLength+1), false, false)
+	assert.NotNil(t, confirmation.Validate())
+
+	confirmation.Confirm = NewTextBlockObject("plain_text", "Delete", false, false)
+	confirmation.Deny = nil
+	assert.NotNil(t, confirmation.Validate())
+
+	confirmation.Deny = NewTextBlockObject("plain_text", "Cancel", false, false)
+	confirmation.Style = Style("bogus")
+	assert.NotNil(t, confirmation.Validate())
+}
+
+func TestTextObjectConstructors(t *testing.T) {
+	md := Mrkdwn("*bold*")
+	assert.Equal(t, MarkdownType, md.Type)
+	assert.Equal(t, "*bold*", md.Text)
+
+	pt := PlainText("hello :wave:", true)
+	assert.Equal(t, PlainTextType, pt.Type)
+	assert.True(t, pt.Emoji)
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "short", Truncate("short", 5))
+	assert.Equal(t, "shor…", Truncate("shorter", 5))
+	assert.Equal(t, "日本…", Truncate("日本語です", 3))
+	assert.Equal(t, "", Truncate("text", 0))
+
+	btn := PlainText(strings.Repeat("x", 100), false).Truncate(ButtonTextMaxLength)
+	assert.Equal(t, ButtonTextMaxLength, utf8.RuneCountInString(btn.Text))
+}
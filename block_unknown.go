@@ -0,0 +1,81 @@
+package slack
+
+import "encoding/json"
+
+// UnknownBlock represents a block type this package doesn't support. The raw
+// JSON is preserved so the block survives decoding and re-sending a message.
+type UnknownBlock struct {
+	Type    MessageBlockType `json:"type"`
+	BlockID string           `json:"block_id,omitempty"`
+	Raw     json.RawMessage  `json:"-"`
+}
+
+// BlockType returns the type of the block
+func (s UnknownBlock) BlockType() MessageBlockType {
+	return s.Type
+}
+
+// MarshalJSON returns the raw JSON of the block.
+func (s UnknownBlock) MarshalJSON() ([]byte, error) {
+	if len(s.Raw) > 0 {
+		return s.Raw, nil
+	}
+
+	type alias UnknownBlock
+	return json.Marshal(alias(s))
+}
+
+// UnmarshalJSON records the raw JSON of the block.
+func (s *UnknownBlock) UnmarshalJSON(data []byte) error {
+	type alias UnknownBlock
+	var decoded alias
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*s = UnknownBlock(decoded)
+	s.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// UnknownBlockElement represents a block element type this package doesn't support
+// within an actions or context block. The raw JSON is preserved so the element
+// survives decoding and re-sending a message.
+type UnknownBlockElement struct {
+	Type     string          `json:"type"`
+	ActionID string          `json:"action_id,omitempty"`
+	Raw      json.RawMessage `json:"-"`
+}
+
+// ElementType returns the type of the element
+func (s UnknownBlockElement) ElementType() MessageElementType {
+	return MessageElementType(s.Type)
+}
+
+// MixedElementType returns the type of the element
+func (s UnknownBlockElement) MixedElementType() MixedElementType {
+	return MixedElementType(s.Type)
+}
+
+// MarshalJSON returns the raw JSON of the element.
+func (s UnknownBlockElement) MarshalJSON() ([]byte, error) {
+	if len(s.Raw) > 0 {
+		return s.Raw, nil
+	}
+
+	type alias UnknownBlockElement
+	return json.Marshal(alias(s))
+}
+
+// UnmarshalJSON records the raw JSON of the element.
+func (s *UnknownBlockElement) UnmarshalJSON(data []byte) error {
+	type alias UnknownBlockElement
+	var decoded alias
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*s = UnknownBlockElement(decoded)
+	s.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
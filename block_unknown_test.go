@@ -0,0 +1,60 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnknownBlockRoundTrip(t *testing.T) {
+
+	raw := `[{"type":"divider","block_id":"d1"},{"type":"rich_text","block_id":"r1","elements":[{"type":"rich_text_section","elements":[{"type":"text","text":"hello"}]}]}]`
+
+	var blocks Blocks
+	err := json.Unmarshal([]byte(raw), &blocks)
+	assert.Nil(t, err)
+	assert.Equal(t, len(blocks.BlockSet), 2)
+
+	_, ok := blocks.BlockSet[0].(*DividerBlock)
+	assert.True(t, ok)
+
+	unknown := blocks.BlockSet[1].(*UnknownBlock)
+	assert.Equal(t, unknown.BlockType(), MessageBlockType("rich_text"))
+	assert.Equal(t, unknown.BlockID, "r1")
+
+	encoded, err := json.Marshal(blocks)
+	assert.Nil(t, err)
+	assert.JSONEq(t, raw, string(encoded))
+
+}
+
+func TestUnknownBlockElementRoundTrip(t *testing.T) {
+	raw := `{"type":"message","text":"deploy","blocks":[` +
+		`{"type":"section","block_id":"s1","text":{"type":"mrkdwn","text":"deploy pending"}},` +
+		`{"type":"actions","block_id":"a1","elements":[{"type":"button","action_id":"approve","text":{"type":"plain_text","text":"Approve"}},{"type":"timepicker","action_id":"when","initial_time":"13:37"}]},` +
+		`{"type":"context","block_id":"c1","elements":[{"type":"mrkdwn","text":"requested"},{"type":"emoji","name":"rocket"}]}]}`
+
+	var msg Message
+	if !assert.Nil(t, json.Unmarshal([]byte(raw), &msg)) {
+		return
+	}
+
+	actions := msg.Blocks.BlockSet[1].(*ActionBlock)
+	unknown := actions.Elements.ElementSet[1].(*UnknownBlockElement)
+	assert.Equal(t, MessageElementType("timepicker"), unknown.ElementType())
+	assert.Equal(t, "when", unknown.ActionID)
+
+	context := msg.Blocks.BlockSet[2].(*ContextBlock)
+	assert.Equal(t, MixedElementType("emoji"), context.ContextElements.Elements[1].MixedElementType())
+
+	// edit the message and send it back.
+	msg.Blocks.BlockSet[0].(*SectionBlock).Text.Text = "deploy approved"
+
+	encoded, err := json.Marshal(msg.Blocks)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[`+
+		`{"type":"section","block_id":"s1","text":{"type":"mrkdwn","text":"deploy approved"}},`+
+		`{"type":"actions","block_id":"a1","elements":[{"type":"button","action_id":"approve","text":{"type":"plain_text","text":"Approve"}},{"type":"timepicker","action_id":"when","initial_time":"13:37"}]},`+
+		`{"type":"context","block_id":"c1","elements":[{"type":"mrkdwn","text":"requested"},{"type":"emoji","name":"rocket"}]}]`, string(encoded))
+}
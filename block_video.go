@@ -0,0 +1,81 @@
+package slack
+
+// VideoBlock defines data required to display an embedded video player.
+//
+// More Information: https://api.slack.com/reference/messaging/blocks#video
+type VideoBlock struct {
+	Type            MessageBlockType `json:"type"`
+	VideoURL        string           `json:"video_url"`
+	ThumbnailURL    string           `json:"thumbnail_url"`
+	AltText         string           `json:"alt_text"`
+	Title           *TextBlockObject `json:"title"`
+	BlockID         string           `json:"block_id,omitempty"`
+	TitleURL        string           `json:"title_url,omitempty"`
+	AuthorName      string           `json:"author_name,omitempty"`
+	ProviderName    string           `json:"provider_name,omitempty"`
+	ProviderIconURL string           `json:"provider_icon_url,omitempty"`
+	Description     *TextBlockObject `json:"description,omitempty"`
+}
+
+// BlockType returns the type of the block
+func (s VideoBlock) BlockType() MessageBlockType {
+	return s.Type
+}
+
+// VideoBlockOption allows configuration of options for a new video block
+type VideoBlockOption func(*VideoBlock)
+
+// VideoBlockOptionBlockID sets the block id of the video block.
+func VideoBlockOptionBlockID(blockID string) VideoBlockOption {
+	return func(block *VideoBlock) {
+		block.BlockID = blockID
+	}
+}
+
+// VideoBlockOptionTitleURL sets the hyperlink for the title, must correspond
+// to the non-embeddable URL of the video.
+func VideoBlockOptionTitleURL(titleURL string) VideoBlockOption {
+	return func(block *VideoBlock) {
+		block.TitleURL = titleURL
+	}
+}
+
+// VideoBlockOptionAuthorName sets the author of the video.
+func VideoBlockOptionAuthorName(name string) VideoBlockOption {
+	return func(block *VideoBlock) {
+		block.AuthorName = name
+	}
+}
+
+// VideoBlockOptionProvider sets the originating application or domain of the video.
+func VideoBlockOptionProvider(name, iconURL string) VideoBlockOption {
+	return func(block *VideoBlock) {
+		block.ProviderName = name
+		block.ProviderIconURL = iconURL
+	}
+}
+
+// VideoBlockOptionDescription sets the description of the video.
+func VideoBlockOptionDescription(description *TextBlockObject) VideoBlockOption {
+	return func(block *VideoBlock) {
+		block.Description = description
+	}
+}
+
+// NewVideoBlock returns an instance of a new Video Block type. The video url,
+// thumbnail url, alt text, and title are all required by slack.
+func NewVideoBlock(videoURL, thumbnailURL, altText string, title *TextBlockObject, options ...VideoBlockOption) *VideoBlock {
+	block := VideoBlock{
+		Type:         MBTVideo,
+		VideoURL:     videoURL,
+		ThumbnailURL: thumbnailURL,
+		AltText:      altText,
+		Title:        title,
+	}
+
+	for _, option := range options {
+		option(&block)
+	}
+
+	return &block
+}
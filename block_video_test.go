@@ -0,0 +1,32 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVideoBlock(t *testing.T) {
+
+	titleText := NewTextBlockObject("plain_text", "How to use Slack.", false, false)
+	videoBlock := NewVideoBlock(
+		"https://www.youtube.com/embed/RRxQQxiM7AA?feature=oembed&autoplay=1",
+		"https://i.ytimg.com/vi/RRxQQxiM7AA/hqdefault.jpg",
+		"How to use Slack?",
+		titleText,
+		VideoBlockOptionBlockID("test"),
+		VideoBlockOptionTitleURL("https://www.youtube.com/watch?v=RRxQQxiM7AA"),
+		VideoBlockOptionProvider("YouTube", "https://a.slack-edge.com/80588/img/unfurl_icons/youtube.png"),
+		VideoBlockOptionAuthorName("Arcado Buendia"),
+	)
+
+	assert.Equal(t, string(videoBlock.Type), "video")
+	assert.Equal(t, videoBlock.BlockID, "test")
+	assert.Equal(t, videoBlock.AltText, "How to use Slack?")
+	assert.Equal(t, videoBlock.Title.Text, "How to use Slack.")
+	assert.Equal(t, videoBlock.ProviderName, "YouTube")
+	assert.Equal(t, videoBlock.AuthorName, "Arcado Buendia")
+	assert.Contains(t, videoBlock.TitleURL, "watch?v=RRxQQxiM7AA")
+	assert.Contains(t, videoBlock.ThumbnailURL, "hqdefault.jpg")
+
+}
@@ -0,0 +1,232 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"sync"
+	"time"
+)
+
+// Response cache defaults.
+const (
+	DefaultCacheTTL   = 10 * time.Second
+	DefaultCacheStale = time.Minute
+)
+
+// DefaultCachedMethods the read only api methods cached by default.
+var DefaultCachedMethods = []string{"users.info", "conversations.info", "team.info", "emoji.list"}
+
+// CacheOption options for the response cache.
+type CacheOption func(*cacheClient)
+
+// CacheOptionTTL duration responses are fresh for, defaults to DefaultCacheTTL.
+func CacheOptionTTL(d time.Duration) CacheOption {
+	return func(t *cacheClient) {
+		t.ttl = d
+	}
+}
+
+// CacheOptionStale duration after the ttl expires that a stale response is still returned
+// while it is refreshed in the background, defaults to DefaultCacheStale.
+func CacheOptionStale(d time.Duration) CacheOption {
+	return func(t *cacheClient) {
+		t.stale = d
+	}
+}
+
+// CacheOptionMethods the api methods to cache, replaces DefaultCachedMethods. Only methods
+// which do not modify slack should be cached.
+func CacheOptionMethods(methods ...string) CacheOption {
+	return func(t *cacheClient) {
+		t.methods = make(map[string]bool, len(methods))
+		for _, m := range methods {
+			t.methods[m] = true
+		}
+	}
+}
+
+// OptionCache caches successful responses of read only methods (see DefaultCachedMethods) for a
+// short duration, cutting duplicate lookups made while handling a burst of events. Once expired,
+// stale responses are returned while being refreshed in the background.
+func OptionCache(options ...CacheOption) func(*Client) {
+	return func(c *Client) {
+		c.cache = newCacheClient(options...)
+	}
+}
+
+func newCacheClient(options ...CacheOption) *cacheClient {
+	t := &cacheClient{
+		ttl:     DefaultCacheTTL,
+		stale:   DefaultCacheStale,
+		now:     time.Now,
+		entries: make(map[string]*cacheEntry),
+	}
+
+	CacheOptionMethods(DefaultCachedMethods...)(t)
+
+	for _, opt := range options {
+		opt(t)
+	}
+
+	return t
+}
+
+type cacheEntry struct {
+	method     string
+	params     url.Values
+	status     int
+	header     http.Header
+	body       []byte
+	fetched    time.Time
+	refreshing bool
+}
+
+func (t *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", t.status, http.StatusText(t.status)),
+		StatusCode:    t.status,
+		Header:        t.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(t.body)),
+		ContentLength: int64(len(t.body)),
+		Request:       req,
+	}
+}
+
+// cacheClient caches the responses of api methods.
+type cacheClient struct {
+	httpClient
+	ttl     time.Duration
+	stale   time.Duration
+	methods map[string]bool
+	now     func() time.Time
+	m       sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+func (t *cacheClient) Do(req *http.Request) (*http.Response, error) {
+	if !t.methods[path.Base(req.URL.Path)] {
+		return t.httpClient.Do(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	// the token is part of the key, either in the body or the authorization header.
+	key := req.Method + " " + req.URL.String() + "\x00" + req.Header.Get("Authorization") + "\x00" + string(body)
+
+	t.m.Lock()
+	entry, ok := t.entries[key]
+	if ok {
+		age := t.now().Sub(entry.fetched)
+		switch {
+		case age < t.ttl:
+			t.m.Unlock()
+			return entry.response(req), nil
+		case age < t.ttl+t.stale:
+			if !entry.refreshing {
+				entry.refreshing = true
+				go t.refresh(key, entry, req.Clone(context.Background()), body)
+			}
+			t.m.Unlock()
+			return entry.response(req), nil
+		}
+	}
+	t.m.Unlock()
+
+	return t.fetch(key, req, body)
+}
+
+// refresh a stale entry, the original request may be cancelled once the stale response
+// is returned so a clone of the request with a background context is used.
+func (t *cacheClient) refresh(key string, stale *cacheEntry, req *http.Request, body []byte) {
+	defer func() {
+		t.m.Lock()
+		stale.refreshing = false
+		t.m.Unlock()
+	}()
+
+	if resp, err := t.fetch(key, req, body); err == nil {
+		resp.Body.Close()
+	}
+}
+
+// fetch the response and cache it if successful.
+func (t *cacheClient) fetch(key string, req *http.Request, body []byte) (*http.Response, error) {
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+	encoded, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	params, _ := url.ParseQuery(string(body))
+	for k, v := range req.URL.Query() {
+		params[k] = append(params[k], v...)
+	}
+
+	entry := &cacheEntry{
+		method:  path.Base(req.URL.Path),
+		params:  params,
+		status:  resp.StatusCode,
+		header:  resp.Header,
+		body:    encoded,
+		fetched: t.now(),
+	}
+
+	// only successful api responses are cached.
+	var status SlackResponse
+	if json.Unmarshal(encoded, &status) == nil && status.Ok {
+		t.store(key, entry)
+	}
+
+	return entry.response(req), nil
+}
+
+func (t *cacheClient) store(key string, entry *cacheEntry) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	for k, e := range t.entries {
+		if entry.fetched.Sub(e.fetched) >= t.ttl+t.stale {
+			delete(t.entries, k)
+		}
+	}
+
+	t.entries[key] = entry
+}
+
+// invalidate removes the cached responses of the method matching the request parameters
+// and response body.
+func (t *cacheClient) invalidate(method string, match func(params url.Values, body []byte) bool) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	for k, e := range t.entries {
+		if e.method == method && match(e.params, e.body) {
+			delete(t.entries, k)
+		}
+	}
+}
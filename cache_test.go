@@ -0,0 +1,78 @@
+package slack
+
+import (
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionCache(t *testing.T) {
+	var (
+		calls int32
+	)
+
+	http.HandleFunc("/cache/users.info", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("user") == "UMISSING" {
+			w.Write([]byte(`{"ok":false,"error":"user_not_found"}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"user":{"id":"` + r.FormValue("user") + `","name":"v` + strconv.Itoa(int(n)) + `"}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/cache/"), OptionCache(CacheOptionTTL(time.Minute), CacheOptionStale(time.Minute)))
+
+	now := time.Now()
+	api.cache.now = func() time.Time { return now }
+
+	user, err := api.GetUserInfo("U1")
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", user.Name)
+
+	user, err = api.GetUserInfo("U1")
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", user.Name)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	user, err = api.GetUserInfo("U2")
+	assert.Nil(t, err)
+	assert.Equal(t, "v2", user.Name)
+
+	// errors are not cached.
+	_, err = api.GetUserInfo("UMISSING")
+	assert.EqualError(t, err, "user_not_found")
+	_, err = api.GetUserInfo("UMISSING")
+	assert.EqualError(t, err, "user_not_found")
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+
+	// stale responses are returned while refreshing in the background.
+	now = now.Add(90 * time.Second)
+	user, err = api.GetUserInfo("U1")
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", user.Name)
+
+	for i := 0; i < 100 && atomic.LoadInt32(&calls) < 5; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	for i := 0; i < 100; i++ {
+		if user, err = api.GetUserInfo("U1"); err != nil || user.Name != "v1" {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Nil(t, err)
+	assert.Equal(t, "v5", user.Name)
+
+	// expired responses are fetched.
+	now = now.Add(5 * time.Minute)
+	user, err = api.GetUserInfo("U1")
+	assert.Nil(t, err)
+	assert.Equal(t, "v6", user.Name)
+}
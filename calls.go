@@ -0,0 +1,76 @@
+package slack
+
+// CallParticipant a participant of a call, either a slack user or an external user.
+type CallParticipant struct {
+	SlackID     string `json:"slack_id,omitempty"`
+	ExternalID  string `json:"external_id,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	AvatarURL   string `json:"avatar_url,omitempty"`
+}
+
+// CallInfo the state of a call as displayed by a call block.
+type CallInfo struct {
+	ID                 string            `json:"id"`
+	AppID              string            `json:"app_id,omitempty"`
+	AppIconURLs        map[string]string `json:"app_icon_urls,omitempty"`
+	Name               string            `json:"name,omitempty"`
+	CreatedBy          string            `json:"created_by,omitempty"`
+	DateStart          JSONTime          `json:"date_start,omitempty"`
+	DateEnd            JSONTime          `json:"date_end,omitempty"`
+	Channels           []string          `json:"channels,omitempty"`
+	ActiveParticipants []CallParticipant `json:"active_participants,omitempty"`
+	AllParticipants    []CallParticipant `json:"all_participants,omitempty"`
+	DisplayID          string            `json:"display_id,omitempty"`
+	JoinURL            string            `json:"join_url,omitempty"`
+	DesktopAppJoinURL  string            `json:"desktop_app_join_url,omitempty"`
+	IsDMCall           bool              `json:"is_dm_call,omitempty"`
+	WasRejected        bool              `json:"was_rejected,omitempty"`
+	WasMissed          bool              `json:"was_missed,omitempty"`
+	WasAccepted        bool              `json:"was_accepted,omitempty"`
+	HasEnded           bool              `json:"has_ended,omitempty"`
+}
+
+// Active reports whether the call is still in progress.
+func (t CallInfo) Active() bool {
+	return !t.HasEnded && t.DateEnd == 0
+}
+
+// Room the state of a huddle, included in messages with the huddle_thread subtype.
+type Room struct {
+	ID                 string   `json:"id"`
+	Name               string   `json:"name,omitempty"`
+	MediaServer        string   `json:"media_server,omitempty"`
+	CreatedBy          string   `json:"created_by,omitempty"`
+	DateStart          JSONTime `json:"date_start,omitempty"`
+	DateEnd            JSONTime `json:"date_end,omitempty"`
+	Participants       []string `json:"participants,omitempty"`
+	ParticipantHistory []string `json:"participant_history,omitempty"`
+	Channels           []string `json:"channels,omitempty"`
+	IsDMCall           bool     `json:"is_dm_call,omitempty"`
+	WasRejected        bool     `json:"was_rejected,omitempty"`
+	WasMissed          bool     `json:"was_missed,omitempty"`
+	WasAccepted        bool     `json:"was_accepted,omitempty"`
+	HasEnded           bool     `json:"has_ended,omitempty"`
+	AttachedFileIDs    []string `json:"attached_file_ids,omitempty"`
+	MediaBackendType   string   `json:"media_backend_type,omitempty"`
+	DisplayID          string   `json:"display_id,omitempty"`
+	ExternalUniqueID   string   `json:"external_unique_id,omitempty"`
+	AppID              string   `json:"app_id,omitempty"`
+	CallFamily         string   `json:"call_family,omitempty"`
+}
+
+// Active reports whether the huddle is still in progress.
+func (t Room) Active() bool {
+	return !t.HasEnded && t.DateEnd == 0
+}
+
+// Calls returns the call blocks of the message.
+func (t Msg) Calls() (calls []*CallBlock) {
+	for _, b := range t.Blocks.BlockSet {
+		if call, ok := b.(*CallBlock); ok {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
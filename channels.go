@@ -3,7 +3,6 @@ package slack
 import (
 	"context"
 	"net/url"
-	"strconv"
 )
 
 type channelResponseFull struct {
@@ -59,225 +58,142 @@ func GetChannelsOptionExcludeArchived() GetChannelsOption {
 }
 
 // ArchiveChannel archives the given channel
-// see https://api.slack.com/methods/channels.archive
+// see https://api.slack.com/methods/conversations.archive
 func (api *Client) ArchiveChannel(channelID string) error {
 	return api.ArchiveChannelContext(context.Background(), channelID)
 }
 
 // ArchiveChannelContext archives the given channel with a custom context
-// see https://api.slack.com/methods/channels.archive
+// see https://api.slack.com/methods/conversations.archive
 func (api *Client) ArchiveChannelContext(ctx context.Context, channelID string) (err error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channelID},
-	}
-
-	_, err = api.channelRequest(ctx, "channels.archive", values)
-	return err
+	return api.ArchiveConversationContext(ctx, channelID)
 }
 
 // UnarchiveChannel unarchives the given channel
-// see https://api.slack.com/methods/channels.unarchive
+// see https://api.slack.com/methods/conversations.unarchive
 func (api *Client) UnarchiveChannel(channelID string) error {
 	return api.UnarchiveChannelContext(context.Background(), channelID)
 }
 
 // UnarchiveChannelContext unarchives the given channel with a custom context
-// see https://api.slack.com/methods/channels.unarchive
+// see https://api.slack.com/methods/conversations.unarchive
 func (api *Client) UnarchiveChannelContext(ctx context.Context, channelID string) (err error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channelID},
-	}
-
-	_, err = api.channelRequest(ctx, "channels.unarchive", values)
-	return err
+	return api.UnArchiveConversationContext(ctx, channelID)
 }
 
 // CreateChannel creates a channel with the given name and returns a *Channel
-// see https://api.slack.com/methods/channels.create
+// see https://api.slack.com/methods/conversations.create
 func (api *Client) CreateChannel(channelName string) (*Channel, error) {
 	return api.CreateChannelContext(context.Background(), channelName)
 }
 
 // CreateChannelContext creates a channel with the given name and returns a *Channel with a custom context
-// see https://api.slack.com/methods/channels.create
+// see https://api.slack.com/methods/conversations.create
 func (api *Client) CreateChannelContext(ctx context.Context, channelName string) (*Channel, error) {
-	values := url.Values{
-		"token": {api.token},
-		"name":  {channelName},
-	}
-
-	response, err := api.channelRequest(ctx, "channels.create", values)
-	if err != nil {
-		return nil, err
-	}
-	return &response.Channel, nil
+	return api.CreateConversationContext(ctx, channelName, false)
 }
 
 // GetChannelHistory retrieves the channel history
-// see https://api.slack.com/methods/channels.history
+// see https://api.slack.com/methods/conversations.history
 func (api *Client) GetChannelHistory(channelID string, params HistoryParameters) (*History, error) {
 	return api.GetChannelHistoryContext(context.Background(), channelID, params)
 }
 
 // GetChannelHistoryContext retrieves the channel history with a custom context
-// see https://api.slack.com/methods/channels.history
+// see https://api.slack.com/methods/conversations.history
 func (api *Client) GetChannelHistoryContext(ctx context.Context, channelID string, params HistoryParameters) (*History, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channelID},
-	}
-	if params.Latest != DEFAULT_HISTORY_LATEST {
-		values.Add("latest", params.Latest)
-	}
-	if params.Oldest != DEFAULT_HISTORY_OLDEST {
-		values.Add("oldest", params.Oldest)
-	}
-	if params.Count != DEFAULT_HISTORY_COUNT {
-		values.Add("count", strconv.Itoa(params.Count))
-	}
-	if params.Inclusive != DEFAULT_HISTORY_INCLUSIVE {
-		if params.Inclusive {
-			values.Add("inclusive", "1")
-		} else {
-			values.Add("inclusive", "0")
-		}
-	}
-
-	if params.Unreads != DEFAULT_HISTORY_UNREADS {
-		if params.Unreads {
-			values.Add("unreads", "1")
-		} else {
-			values.Add("unreads", "0")
-		}
-	}
-
-	response, err := api.channelRequest(ctx, "channels.history", values)
-	if err != nil {
-		return nil, err
-	}
-	return &response.History, nil
+	return api.conversationHistory(ctx, channelID, params)
 }
 
 // GetChannelInfo retrieves the given channel
-// see https://api.slack.com/methods/channels.info
+// see https://api.slack.com/methods/conversations.info
 func (api *Client) GetChannelInfo(channelID string) (*Channel, error) {
 	return api.GetChannelInfoContext(context.Background(), channelID)
 }
 
 // GetChannelInfoContext retrieves the given channel with a custom context
-// see https://api.slack.com/methods/channels.info
+// see https://api.slack.com/methods/conversations.info
 func (api *Client) GetChannelInfoContext(ctx context.Context, channelID string) (*Channel, error) {
-	values := url.Values{
-		"token":          {api.token},
-		"channel":        {channelID},
-		"include_locale": {strconv.FormatBool(true)},
-	}
-
-	response, err := api.channelRequest(ctx, "channels.info", values)
-	if err != nil {
-		return nil, err
-	}
-	return &response.Channel, nil
+	return api.GetConversationInfoContext(ctx, channelID, true)
 }
 
 // InviteUserToChannel invites a user to a given channel and returns a *Channel
-// see https://api.slack.com/methods/channels.invite
+// see https://api.slack.com/methods/conversations.invite
 func (api *Client) InviteUserToChannel(channelID, user string) (*Channel, error) {
 	return api.InviteUserToChannelContext(context.Background(), channelID, user)
 }
 
 // InviteUserToChannelContext invites a user to a given channel and returns a *Channel with a custom context
-// see https://api.slack.com/methods/channels.invite
+// see https://api.slack.com/methods/conversations.invite
 func (api *Client) InviteUserToChannelContext(ctx context.Context, channelID, user string) (*Channel, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channelID},
-		"user":    {user},
-	}
-
-	response, err := api.channelRequest(ctx, "channels.invite", values)
-	if err != nil {
-		return nil, err
-	}
-	return &response.Channel, nil
+	return api.InviteUsersToConversationContext(ctx, channelID, user)
 }
 
 // JoinChannel joins the currently authenticated user to a channel
-// see https://api.slack.com/methods/channels.join
+// see https://api.slack.com/methods/conversations.join
 func (api *Client) JoinChannel(channelName string) (*Channel, error) {
 	return api.JoinChannelContext(context.Background(), channelName)
 }
 
-// JoinChannelContext joins the currently authenticated user to a channel with a custom context
-// see https://api.slack.com/methods/channels.join
+// JoinChannelContext joins the currently authenticated user to a channel with a custom context.
+// conversations.join requires a channel ID, when the channel isn't found the argument is
+// treated as a channel name and resolved using the client's ChannelResolver.
+// see https://api.slack.com/methods/conversations.join
 func (api *Client) JoinChannelContext(ctx context.Context, channelName string) (*Channel, error) {
-	values := url.Values{
-		"token": {api.token},
-		"name":  {channelName},
+	channel, _, _, err := api.JoinConversationContext(ctx, channelName)
+	if !isSlackError(err, "channel_not_found") {
+		return channel, err
 	}
 
-	response, err := api.channelRequest(ctx, "channels.join", values)
-	if err != nil {
+	id, rerr := api.channels.ResolveChannelID(ctx, channelName)
+	if rerr == ErrChannelNotFound {
 		return nil, err
+	} else if rerr != nil {
+		return nil, rerr
 	}
-	return &response.Channel, nil
+
+	channel, _, _, err = api.JoinConversationContext(ctx, id)
+	return channel, err
 }
 
 // LeaveChannel makes the authenticated user leave the given channel
-// see https://api.slack.com/methods/channels.leave
+// see https://api.slack.com/methods/conversations.leave
 func (api *Client) LeaveChannel(channelID string) (bool, error) {
 	return api.LeaveChannelContext(context.Background(), channelID)
 }
 
 // LeaveChannelContext makes the authenticated user leave the given channel with a custom context
-// see https://api.slack.com/methods/channels.leave
+// see https://api.slack.com/methods/conversations.leave
 func (api *Client) LeaveChannelContext(ctx context.Context, channelID string) (bool, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channelID},
-	}
-
-	response, err := api.channelRequest(ctx, "channels.leave", values)
-	if err != nil {
-		return false, err
-	}
-
-	return response.NotInChannel, nil
+	return api.LeaveConversationContext(ctx, channelID)
 }
 
 // KickUserFromChannel kicks a user from a given channel
-// see https://api.slack.com/methods/channels.kick
+// see https://api.slack.com/methods/conversations.kick
 func (api *Client) KickUserFromChannel(channelID, user string) error {
 	return api.KickUserFromChannelContext(context.Background(), channelID, user)
 }
 
 // KickUserFromChannelContext kicks a user from a given channel with a custom context
-// see https://api.slack.com/methods/channels.kick
+// see https://api.slack.com/methods/conversations.kick
 func (api *Client) KickUserFromChannelContext(ctx context.Context, channelID, user string) (err error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channelID},
-		"user":    {user},
-	}
-
-	_, err = api.channelRequest(ctx, "channels.kick", values)
-	return err
+	return api.KickUserFromConversationContext(ctx, channelID, user)
 }
 
 // GetChannels retrieves all the channels
-// see https://api.slack.com/methods/channels.list
+// see https://api.slack.com/methods/conversations.list
 func (api *Client) GetChannels(excludeArchived bool, options ...GetChannelsOption) ([]Channel, error) {
 	return api.GetChannelsContext(context.Background(), excludeArchived, options...)
 }
 
-// GetChannelsContext retrieves all the channels with a custom context
-// see https://api.slack.com/methods/channels.list
+// GetChannelsContext retrieves all the public channels with a custom context,
+// following the pagination cursors of conversations.list.
+// see https://api.slack.com/methods/conversations.list
 func (api *Client) GetChannelsContext(ctx context.Context, excludeArchived bool, options ...GetChannelsOption) ([]Channel, error) {
 	config := channelsConfig{
 		values: url.Values{
 			"token": {api.token},
+			"types": {ConversationTypePublicChannel},
 		},
 	}
 
@@ -291,11 +207,17 @@ func (api *Client) GetChannelsContext(ctx context.Context, excludeArchived bool,
 		}
 	}
 
-	response, err := api.channelRequest(ctx, "channels.list", config.values)
+	conversations, err := api.listConversations(ctx, config.values)
 	if err != nil {
 		return nil, err
 	}
-	return response.Channels, nil
+
+	channels := make([]Channel, 0, len(conversations))
+	for _, c := range conversations {
+		channels = append(channels, c.Channel)
+	}
+
+	return channels, nil
 }
 
 // SetChannelReadMark sets the read mark of a given channel to a specific point
@@ -303,110 +225,121 @@ func (api *Client) GetChannelsContext(ctx context.Context, excludeArchived bool,
 // timer before making the call. In this way, any further updates needed during the timeout will not generate extra calls
 // (just one per channel). This is useful for when reading scroll-back history, or following a busy live channel. A
 // timeout of 5 seconds is a good starting point. Be sure to flush these calls on shutdown/logout.
-// see https://api.slack.com/methods/channels.mark
+// see https://api.slack.com/methods/conversations.mark
 func (api *Client) SetChannelReadMark(channelID, ts string) error {
 	return api.SetChannelReadMarkContext(context.Background(), channelID, ts)
 }
 
 // SetChannelReadMarkContext sets the read mark of a given channel to a specific point with a custom context
 // For more details see SetChannelReadMark documentation
-// see https://api.slack.com/methods/channels.mark
+// see https://api.slack.com/methods/conversations.mark
 func (api *Client) SetChannelReadMarkContext(ctx context.Context, channelID, ts string) (err error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channelID},
-		"ts":      {ts},
-	}
-
-	_, err = api.channelRequest(ctx, "channels.mark", values)
-	return err
+	return api.MarkConversationContext(ctx, channelID, ts)
 }
 
 // RenameChannel renames a given channel
-// see https://api.slack.com/methods/channels.rename
+// see https://api.slack.com/methods/conversations.rename
+//
+// Deprecated: use RenameConversation.
 func (api *Client) RenameChannel(channelID, name string) (*Channel, error) {
 	return api.RenameChannelContext(context.Background(), channelID, name)
 }
 
 // RenameChannelContext renames a given channel with a custom context
-// see https://api.slack.com/methods/channels.rename
+// see https://api.slack.com/methods/conversations.rename
+//
+// Deprecated: use RenameConversationContext.
 func (api *Client) RenameChannelContext(ctx context.Context, channelID, name string) (*Channel, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channelID},
-		"name":    {name},
-	}
-
-	// XXX: the created entry in this call returns a string instead of a number
-	// so I may have to do some workaround to solve it.
-	response, err := api.channelRequest(ctx, "channels.rename", values)
-	if err != nil {
-		return nil, err
-	}
-	return &response.Channel, nil
+	return api.RenameConversationContext(ctx, channelID, name)
 }
 
 // SetChannelPurpose sets the channel purpose and returns the purpose that was successfully set
-// see https://api.slack.com/methods/channels.setPurpose
+// see https://api.slack.com/methods/conversations.setPurpose
+//
+// Deprecated: use SetConversationPurpose.
 func (api *Client) SetChannelPurpose(channelID, purpose string) (string, error) {
 	return api.SetChannelPurposeContext(context.Background(), channelID, purpose)
 }
 
 // SetChannelPurposeContext sets the channel purpose and returns the purpose that was successfully set with a custom context
-// see https://api.slack.com/methods/channels.setPurpose
+// see https://api.slack.com/methods/conversations.setPurpose
+//
+// Deprecated: use SetConversationPurposeContext.
 func (api *Client) SetChannelPurposeContext(ctx context.Context, channelID, purpose string) (string, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channelID},
-		"purpose": {purpose},
-	}
-
-	response, err := api.channelRequest(ctx, "channels.setPurpose", values)
+	channel, err := api.SetConversationPurposeContext(ctx, channelID, purpose)
 	if err != nil {
 		return "", err
 	}
-	return response.Purpose, nil
+	return channel.Purpose.Value, nil
 }
 
 // SetChannelTopic sets the channel topic and returns the topic that was successfully set
-// see https://api.slack.com/methods/channels.setTopic
+// see https://api.slack.com/methods/conversations.setTopic
+//
+// Deprecated: use SetConversationTopic.
 func (api *Client) SetChannelTopic(channelID, topic string) (string, error) {
 	return api.SetChannelTopicContext(context.Background(), channelID, topic)
 }
 
 // SetChannelTopicContext sets the channel topic and returns the topic that was successfully set with a custom context
-// see https://api.slack.com/methods/channels.setTopic
+// see https://api.slack.com/methods/conversations.setTopic
+//
+// Deprecated: use SetConversationTopicContext.
 func (api *Client) SetChannelTopicContext(ctx context.Context, channelID, topic string) (string, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channelID},
-		"topic":   {topic},
-	}
-
-	response, err := api.channelRequest(ctx, "channels.setTopic", values)
+	channel, err := api.SetConversationTopicContext(ctx, channelID, topic)
 	if err != nil {
 		return "", err
 	}
-	return response.Topic, nil
+	return channel.Topic.Value, nil
 }
 
 // GetChannelReplies gets an entire thread (a message plus all the messages in reply to it).
-// see https://api.slack.com/methods/channels.replies
+// see https://api.slack.com/methods/conversations.replies
 func (api *Client) GetChannelReplies(channelID, thread_ts string) ([]Message, error) {
 	return api.GetChannelRepliesContext(context.Background(), channelID, thread_ts)
 }
 
 // GetChannelRepliesContext gets an entire thread (a message plus all the messages in reply to it) with a custom context
-// see https://api.slack.com/methods/channels.replies
+// see https://api.slack.com/methods/conversations.replies
 func (api *Client) GetChannelRepliesContext(ctx context.Context, channelID, thread_ts string) ([]Message, error) {
-	values := url.Values{
-		"token":     {api.token},
-		"channel":   {channelID},
-		"thread_ts": {thread_ts},
-	}
-	response, err := api.channelRequest(ctx, "channels.replies", values)
-	if err != nil {
-		return nil, err
+	return api.GetFullThreadContext(ctx, channelID, thread_ts)
+}
+
+// legacyConversation is a conversations.list entry, includes the fields
+// used by the legacy channel, group, and im methods.
+type legacyConversation struct {
+	Channel
+	IsUserDeleted bool `json:"is_user_deleted"`
+}
+
+// listConversations retrieves every page of conversations.list for the provided
+// values. used by the legacy channel, group, and im methods which returned the
+// entire list in a single call.
+func (api *Client) listConversations(ctx context.Context, values url.Values) (conversations []legacyConversation, err error) {
+	values.Set("limit", "1000")
+	for {
+		response := struct {
+			Channels         []legacyConversation `json:"channels"`
+			ResponseMetaData responseMetaData     `json:"response_metadata"`
+			SlackResponse
+		}{}
+
+		err = api.retryRateLimited(ctx, func() error {
+			if err := api.postMethod(ctx, "conversations.list", values, &response); err != nil {
+				return err
+			}
+
+			return response.Err()
+		})
+		if err != nil {
+			return conversations, err
+		}
+
+		conversations = append(conversations, response.Channels...)
+		if response.ResponseMetaData.NextCursor == "" {
+			return conversations, nil
+		}
+
+		values.Set("cursor", response.ResponseMetaData.NextCursor)
 	}
-	return response.History.Messages, nil
 }
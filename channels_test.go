@@ -0,0 +1,136 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLegacyMethodsUseConversations(t *testing.T) {
+	http.HandleFunc("/legacy/conversations.list", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "1000", r.FormValue("limit"))
+		switch r.FormValue("types") {
+		case ConversationTypePrivateChannel:
+			w.Write([]byte(`{"ok":true,"channels":[{"id":"G1","name":"secret","is_private":true}]}`))
+		case ConversationTypeIM:
+			w.Write([]byte(`{"ok":true,"channels":[{"id":"D1","is_im":true,"user":"U1","is_user_deleted":true}]}`))
+		default:
+			if r.FormValue("cursor") == "" {
+				assert.Equal(t, "true", r.FormValue("exclude_archived"))
+				w.Write([]byte(`{"ok":true,"channels":[{"id":"C1","name":"general"}],"response_metadata":{"next_cursor":"page2"}}`))
+				return
+			}
+			w.Write([]byte(`{"ok":true,"channels":[{"id":"C2","name":"random"}]}`))
+		}
+	})
+	http.HandleFunc("/legacy/conversations.history", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "C1", r.FormValue("channel"))
+		assert.Equal(t, "10", r.FormValue("limit"))
+		assert.Equal(t, "", r.FormValue("count"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"has_more":true,"messages":[{"type":"message","ts":"1.0","text":"hello"}]}`))
+	})
+	http.HandleFunc("/legacy/conversations.open", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "U1", r.FormValue("users"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"already_open":true,"channel":{"id":"D1"}}`))
+	})
+	http.HandleFunc("/legacy/conversations.setTopic", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":{"id":"C1","topic":{"value":"` + r.FormValue("topic") + `"}}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/legacy/"))
+
+	channels, err := api.GetChannels(true)
+	assert.Nil(t, err)
+	if assert.Len(t, channels, 2) {
+		assert.Equal(t, "C1", channels[0].ID)
+		assert.Equal(t, "C2", channels[1].ID)
+	}
+
+	groups, err := api.GetGroups(false)
+	assert.Nil(t, err)
+	if assert.Len(t, groups, 1) {
+		assert.Equal(t, "G1", groups[0].ID)
+		assert.True(t, groups[0].IsGroup)
+	}
+
+	ims, err := api.GetIMChannels()
+	assert.Nil(t, err)
+	if assert.Len(t, ims, 1) {
+		assert.Equal(t, "U1", ims[0].User)
+		assert.True(t, ims[0].IsUserDeleted)
+	}
+
+	params := NewHistoryParameters()
+	params.Count = 10
+	history, err := api.GetChannelHistory("C1", params)
+	assert.Nil(t, err)
+	assert.True(t, history.HasMore)
+	assert.Len(t, history.Messages, 1)
+
+	noOp, alreadyOpen, channelID, err := api.OpenIMChannel("U1")
+	assert.Nil(t, err)
+	assert.False(t, noOp)
+	assert.True(t, alreadyOpen)
+	assert.Equal(t, "D1", channelID)
+
+	topic, err := api.SetChannelTopic("C1", "hello world")
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", topic)
+}
+
+func TestJoinChannelByName(t *testing.T) {
+	http.HandleFunc("/joinbyname/conversations.join", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("channel") != "C2" {
+			w.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"channel":{"id":"C2","name":"random"}}`))
+	})
+	http.HandleFunc("/joinbyname/conversations.list", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, ConversationTypePublicChannel, r.FormValue("types"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channels":[{"id":"C1","name":"general"},{"id":"C2","name":"random"}]}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/joinbyname/"))
+
+	channel, err := api.JoinChannel("#random")
+	assert.Nil(t, err)
+	if assert.NotNil(t, channel) {
+		assert.Equal(t, "C2", channel.ID)
+	}
+
+	_, err = api.JoinChannel("#missing")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "channel_not_found", err.Error())
+	}
+}
+
+func TestInviteUserToGroupAlreadyInChannel(t *testing.T) {
+	http.HandleFunc("/groupinvite/conversations.invite", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":false,"error":"already_in_channel"}`))
+	})
+	http.HandleFunc("/groupinvite/conversations.info", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":{"id":"G1","name":"secret","is_private":true}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/groupinvite/"))
+
+	group, alreadyInGroup, err := api.InviteUserToGroup("G1", "U1")
+	assert.Nil(t, err)
+	assert.True(t, alreadyInGroup)
+	if assert.NotNil(t, group) {
+		assert.Equal(t, "G1", group.ID)
+	}
+}
@@ -25,10 +25,11 @@ const (
 )
 
 type chatResponseFull struct {
-	Channel          string `json:"channel"`
-	Timestamp        string `json:"ts"`         //Regular message timestamp
-	MessageTimeStamp string `json:"message_ts"` //Ephemeral message timestamp
-	Text             string `json:"text"`
+	Channel            string `json:"channel"`
+	Timestamp          string `json:"ts"`                   //Regular message timestamp
+	MessageTimeStamp   string `json:"message_ts"`           //Ephemeral message timestamp
+	ScheduledMessageID string `json:"scheduled_message_id"` //Scheduled message id
+	Text               string `json:"text"`
 	SlackResponse
 }
 
@@ -151,6 +152,23 @@ func (api *Client) UnfurlMessage(channelID, timestamp string, unfurls map[string
 	return api.SendMessageContext(context.Background(), channelID, MsgOptionUnfurl(timestamp, unfurls), MsgOptionCompose(options...))
 }
 
+// ScheduleMessage sends a message to a channel at the provided unix timestamp.
+// returns the channel and the id of the scheduled message.
+func (api *Client) ScheduleMessage(channelID, postAt string, options ...MsgOption) (string, string, error) {
+	return api.ScheduleMessageContext(context.Background(), channelID, postAt, options...)
+}
+
+// ScheduleMessageContext sends a message to a channel at the provided unix timestamp with a custom context.
+// returns the channel and the id of the scheduled message.
+func (api *Client) ScheduleMessageContext(ctx context.Context, channelID, postAt string, options ...MsgOption) (string, string, error) {
+	response, err := api.sendMessage(ctx, channelID, MsgOptionSchedule(postAt), MsgOptionCompose(options...))
+	if err != nil {
+		return "", "", err
+	}
+
+	return response.Channel, response.ScheduledMessageID, response.Err()
+}
+
 // SendMessage more flexible method for configuring messages.
 func (api *Client) SendMessage(channel string, options ...MsgOption) (string, string, string, error) {
 	return api.SendMessageContext(context.Background(), channel, options...)
@@ -159,20 +177,41 @@ func (api *Client) SendMessage(channel string, options ...MsgOption) (string, st
 // SendMessageContext more flexible method for configuring messages with a custom context.
 func (api *Client) SendMessageContext(ctx context.Context, channelID string, options ...MsgOption) (_channel string, _timestamp string, _text string, err error) {
 	var (
-		req      *http.Request
-		parser   func(*chatResponseFull) responseParser
 		response chatResponseFull
 	)
 
-	if req, parser, err = buildSender(api.endpoint, options...).BuildRequest(api.token, channelID); err != nil {
+	if response, err = api.sendMessage(ctx, channelID, options...); err != nil {
 		return "", "", "", err
 	}
 
-	if err = doPost(ctx, api.httpclient, req, parser(&response), api); err != nil {
-		return "", "", "", err
+	return response.Channel, response.getMessageTimestamp(), response.Text, response.Err()
+}
+
+func (api *Client) sendMessage(ctx context.Context, channelID string, options ...MsgOption) (response chatResponseFull, err error) {
+	var (
+		config sendConfig
+		req    *http.Request
+		parser func(*chatResponseFull) responseParser
+	)
+
+	if config, err = applyMsgOptions(api.token, channelID, api.endpoint, options...); err != nil {
+		return response, err
 	}
 
-	return response.Channel, response.getMessageTimestamp(), response.Text, response.Err()
+	if req, parser, err = config.buildRequest(); err != nil {
+		return response, err
+	}
+
+	err = doPost(ctx, api.httpclient, req, parser(&response), api)
+	if api.audit != nil {
+		api.audit(ctx, newAuditedMessage(config, response, err))
+	}
+
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
 }
 
 // UnsafeApplyMsgOptions utility function for debugging/testing chat requests.
@@ -219,6 +258,7 @@ const (
 	chatResponse      sendMode = "chat.responseURL"
 	chatMeMessage     sendMode = "chat.meMessage"
 	chatUnfurl        sendMode = "chat.unfurl"
+	chatSchedule      sendMode = "chat.scheduleMessage"
 )
 
 type sendConfig struct {
@@ -236,6 +276,11 @@ func (t sendConfig) BuildRequest(token, channelID string) (req *http.Request, _
 		return nil, nil, err
 	}
 
+	return t.buildRequest()
+}
+
+// buildRequest builds the request from a config with the options already applied.
+func (t sendConfig) buildRequest() (*http.Request, func(*chatResponseFull) responseParser, error) {
 	switch t.mode {
 	case chatResponse:
 		return responseURLSender{
@@ -303,6 +348,16 @@ func MsgOptionPostEphemeral(userID string) MsgOption {
 	}
 }
 
+// MsgOptionSchedule schedules a messages to be posted at the provided unix timestamp.
+func MsgOptionSchedule(postAt string) MsgOption {
+	return func(config *sendConfig) error {
+		config.endpoint = config.apiurl + string(chatSchedule)
+		config.values.Add("post_at", postAt)
+		config.values.Del("ts")
+		return nil
+	}
+}
+
 // MsgOptionMeMessage posts a "me message" type from the calling user
 func MsgOptionMeMessage() MsgOption {
 	return func(config *sendConfig) error {
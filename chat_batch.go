@@ -0,0 +1,122 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MessageUpdate describes an update to an existing message.
+type MessageUpdate struct {
+	Channel   string
+	Timestamp string
+	Options   []MsgOption
+}
+
+// MessageUpdateResult the outcome of a single MessageUpdate.
+type MessageUpdateResult struct {
+	Channel   string
+	Timestamp string
+	Err       error
+}
+
+// UpdateMessagesOption options for the UpdateMessages method call.
+type UpdateMessagesOption func(*updateMessagesConfig)
+
+// UpdateMessagesOptionConcurrency maximum number of concurrent chat.update requests, defaults to 4.
+func UpdateMessagesOptionConcurrency(n int) UpdateMessagesOption {
+	return func(c *updateMessagesConfig) {
+		c.concurrency = n
+	}
+}
+
+// UpdateMessagesOptionInterval minimum delay between the start of each chat.update request,
+// defaults to one second which keeps within slack's tier 3 rate limits.
+func UpdateMessagesOptionInterval(d time.Duration) UpdateMessagesOption {
+	return func(c *updateMessagesConfig) {
+		c.interval = d
+	}
+}
+
+type updateMessagesConfig struct {
+	concurrency int
+	interval    time.Duration
+}
+
+// UpdateMessages updates many messages concurrently, see UpdateMessagesContext.
+func (api *Client) UpdateMessages(updates []MessageUpdate, options ...UpdateMessagesOption) []MessageUpdateResult {
+	return api.UpdateMessagesContext(context.Background(), updates, options...)
+}
+
+// UpdateMessagesContext updates many messages concurrently with a custom context.
+// requests are paced to avoid rate limits, rate limited requests are retried after
+// the delay provided by slack. The results are returned in the same order as the updates.
+func (api *Client) UpdateMessagesContext(ctx context.Context, updates []MessageUpdate, options ...UpdateMessagesOption) []MessageUpdateResult {
+	var (
+		wg   sync.WaitGroup
+		pace <-chan time.Time
+	)
+
+	config := updateMessagesConfig{
+		concurrency: 4,
+		interval:    time.Second,
+	}
+
+	for _, opt := range options {
+		opt(&config)
+	}
+
+	if config.concurrency < 1 {
+		config.concurrency = 1
+	}
+
+	if config.interval > 0 {
+		ticker := time.NewTicker(config.interval)
+		defer ticker.Stop()
+		pace = ticker.C
+	}
+
+	results := make([]MessageUpdateResult, len(updates))
+	work := make(chan int)
+
+	for i := 0; i < config.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range work {
+				results[idx] = api.updateMessageWithRetry(ctx, updates[idx])
+			}
+		}()
+	}
+
+	for idx, u := range updates {
+		if err := ctx.Err(); err != nil {
+			results[idx] = MessageUpdateResult{Channel: u.Channel, Timestamp: u.Timestamp, Err: err}
+			continue
+		}
+
+		work <- idx
+
+		if pace != nil && idx < len(updates)-1 {
+			select {
+			case <-pace:
+			case <-ctx.Done():
+			}
+		}
+	}
+
+	close(work)
+	wg.Wait()
+
+	return results
+}
+
+func (api *Client) updateMessageWithRetry(ctx context.Context, u MessageUpdate) MessageUpdateResult {
+	result := MessageUpdateResult{Channel: u.Channel, Timestamp: u.Timestamp}
+	result.Err = api.retryRateLimited(ctx, func() error {
+		_, _, _, err := api.UpdateMessageContext(ctx, u.Channel, u.Timestamp, u.Options...)
+		return err
+	})
+
+	return result
+}
@@ -0,0 +1,57 @@
+package slack
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateMessages(t *testing.T) {
+	var (
+		m       sync.Mutex
+		limited bool
+	)
+
+	http.HandleFunc("/batch/chat.update", func(w http.ResponseWriter, r *http.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		ts := r.FormValue("ts")
+		switch {
+		case ts == "2" && !limited:
+			limited = true
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		case ts == "3":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"ok":false,"error":"message_not_found"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"` + r.FormValue("channel") + `","ts":"` + ts + `"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/batch/"))
+
+	results := api.UpdateMessages(
+		[]MessageUpdate{
+			{Channel: "C1", Timestamp: "1", Options: []MsgOption{MsgOptionText("one", false)}},
+			{Channel: "C1", Timestamp: "2", Options: []MsgOption{MsgOptionText("two", false)}},
+			{Channel: "C2", Timestamp: "3", Options: []MsgOption{MsgOptionText("three", false)}},
+		},
+		UpdateMessagesOptionConcurrency(2),
+		UpdateMessagesOptionInterval(0),
+	)
+
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, MessageUpdateResult{Channel: "C1", Timestamp: "1"}, results[0])
+	assert.Equal(t, MessageUpdateResult{Channel: "C1", Timestamp: "2"}, results[1])
+	assert.True(t, limited)
+	assert.Equal(t, "C2", results[2].Channel)
+	assert.EqualError(t, results[2].Err, "message_not_found")
+}
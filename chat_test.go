@@ -98,3 +98,25 @@ func TestPostMessageWithBlocks(t *testing.T) {
 
 	_, _, _ = api.PostMessage("CXXX", MsgOptionBlocks(blocks...), MsgOptionText("text", false))
 }
+
+func TestScheduleMessage(t *testing.T) {
+	http.HandleFunc("/chat.scheduleMessage", func(rw http.ResponseWriter, r *http.Request) {
+		if got, want := r.FormValue("post_at"), "1567414800"; got != want {
+			t.Errorf("unexpected post_at: got %s, want %s", got, want)
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"channel":"CXXX","scheduled_message_id":"Q1298393284","post_at":1567414800}`))
+	})
+
+	once.Do(startServer)
+	api := New(validToken, OptionAPIURL("http://"+serverAddr+"/"))
+
+	channel, id, err := api.ScheduleMessage("CXXX", "1567414800", MsgOptionText("text", false))
+	if err != nil {
+		t.Errorf("unexpected error returned: %v", err)
+	}
+
+	if channel != "CXXX" || id != "Q1298393284" {
+		t.Errorf("unexpected response: %s %s", channel, id)
+	}
+}
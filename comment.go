@@ -7,4 +7,6 @@ type Comment struct {
 	Timestamp JSONTime `json:"timestamp,omitempty"`
 	User      string   `json:"user,omitempty"`
 	Comment   string   `json:"comment,omitempty"`
+	IsIntro   bool     `json:"is_intro,omitempty"`
+	PinnedTo  []string `json:"pinned_to,omitempty"`
 }
@@ -0,0 +1,195 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// DefaultConfigMarker prefixes the pinned message used by the PinnedConfigStore.
+const DefaultConfigMarker = "bot-config:"
+
+// ChannelConfigStore persists the settings for a single channel. Implementations
+// must return an empty, non-nil map when no settings exist for the channel.
+type ChannelConfigStore interface {
+	Load(ctx context.Context, channelID string) (map[string]string, error)
+	Save(ctx context.Context, channelID string, settings map[string]string) error
+}
+
+// ChannelConfig provides simple key-value settings per channel, backed by
+// a pluggable ChannelConfigStore. Useful for toggles like "quiet hours" without
+// standing up a database.
+type ChannelConfig struct {
+	store ChannelConfigStore
+	m     *sync.Mutex
+}
+
+// NewChannelConfig builds a ChannelConfig backed by the provided store.
+func NewChannelConfig(store ChannelConfigStore) ChannelConfig {
+	return ChannelConfig{
+		store: store,
+		m:     &sync.Mutex{},
+	}
+}
+
+// Get a setting for the channel, the boolean reports whether the key was present.
+func (t ChannelConfig) Get(ctx context.Context, channelID, key string) (string, bool, error) {
+	settings, err := t.store.Load(ctx, channelID)
+	if err != nil {
+		return "", false, err
+	}
+
+	v, ok := settings[key]
+	return v, ok, nil
+}
+
+// Set a setting for the channel.
+func (t ChannelConfig) Set(ctx context.Context, channelID, key, value string) error {
+	return t.update(ctx, channelID, func(settings map[string]string) {
+		settings[key] = value
+	})
+}
+
+// Delete a setting for the channel.
+func (t ChannelConfig) Delete(ctx context.Context, channelID, key string) error {
+	return t.update(ctx, channelID, func(settings map[string]string) {
+		delete(settings, key)
+	})
+}
+
+func (t ChannelConfig) update(ctx context.Context, channelID string, fn func(map[string]string)) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	settings, err := t.store.Load(ctx, channelID)
+	if err != nil {
+		return err
+	}
+
+	fn(settings)
+
+	return t.store.Save(ctx, channelID, settings)
+}
+
+// NewMemoryConfigStore stores channel settings in memory, settings are lost
+// when the process exits.
+func NewMemoryConfigStore() *MemoryConfigStore {
+	return &MemoryConfigStore{
+		channels: make(map[string]map[string]string),
+	}
+}
+
+// MemoryConfigStore in memory implementation of the ChannelConfigStore.
+type MemoryConfigStore struct {
+	m        sync.Mutex
+	channels map[string]map[string]string
+}
+
+// Load the settings for the channel.
+func (t *MemoryConfigStore) Load(ctx context.Context, channelID string) (map[string]string, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	return copySettings(t.channels[channelID]), nil
+}
+
+// Save the settings for the channel.
+func (t *MemoryConfigStore) Save(ctx context.Context, channelID string, settings map[string]string) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.channels[channelID] = copySettings(settings)
+	return nil
+}
+
+// NewPinnedConfigStore stores channel settings as JSON inside a message pinned
+// to the channel itself. The message is identified by the marker prefix, if
+// the marker is empty DefaultConfigMarker is used.
+// The client requires the pins:read, pins:write, and chat:write scopes.
+func NewPinnedConfigStore(api *Client, marker string) PinnedConfigStore {
+	if marker == "" {
+		marker = DefaultConfigMarker
+	}
+
+	return PinnedConfigStore{
+		api:    api,
+		marker: marker,
+	}
+}
+
+// PinnedConfigStore ChannelConfigStore backed by a pinned message within the channel.
+type PinnedConfigStore struct {
+	api    *Client
+	marker string
+}
+
+// Load the settings for the channel.
+func (t PinnedConfigStore) Load(ctx context.Context, channelID string) (map[string]string, error) {
+	settings, _, err := t.find(ctx, channelID)
+	return settings, err
+}
+
+// Save the settings for the channel.
+func (t PinnedConfigStore) Save(ctx context.Context, channelID string, settings map[string]string) error {
+	_, ts, err := t.find(ctx, channelID)
+	if err != nil {
+		return err
+	}
+
+	// json.Marshal escapes <, >, and & which keeps the payload
+	// intact through slack's message formatting.
+	encoded, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	text := MsgOptionText(t.marker+string(encoded), false)
+	if ts != "" {
+		_, _, _, err = t.api.UpdateMessageContext(ctx, channelID, ts, text)
+		return err
+	}
+
+	if _, ts, err = t.api.PostMessageContext(ctx, channelID, text); err != nil {
+		return err
+	}
+
+	return t.api.AddPinContext(ctx, channelID, NewRefToMessage(channelID, ts))
+}
+
+// find the pinned configuration message, returns its decoded settings and timestamp.
+// the timestamp is empty when no configuration message exists.
+func (t PinnedConfigStore) find(ctx context.Context, channelID string) (map[string]string, string, error) {
+	items, _, err := t.api.ListPinsContext(ctx, channelID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, item := range items {
+		if item.Type != TYPE_MESSAGE || item.Message == nil {
+			continue
+		}
+
+		if !strings.HasPrefix(item.Message.Text, t.marker) {
+			continue
+		}
+
+		settings := make(map[string]string)
+		encoded := strings.TrimPrefix(item.Message.Text, t.marker)
+		if err = json.Unmarshal([]byte(encoded), &settings); err != nil {
+			return nil, "", err
+		}
+
+		return settings, item.Message.Timestamp, nil
+	}
+
+	return make(map[string]string), "", nil
+}
+
+func copySettings(settings map[string]string) map[string]string {
+	dup := make(map[string]string, len(settings))
+	for k, v := range settings {
+		dup[k] = v
+	}
+	return dup
+}
@@ -0,0 +1,78 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelConfigMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	cfg := NewChannelConfig(NewMemoryConfigStore())
+
+	_, ok, err := cfg.Get(ctx, "C1", "quiet_hours")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	assert.Nil(t, cfg.Set(ctx, "C1", "quiet_hours", "22-08"))
+	v, ok, err := cfg.Get(ctx, "C1", "quiet_hours")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "22-08", v)
+
+	_, ok, _ = cfg.Get(ctx, "C2", "quiet_hours")
+	assert.False(t, ok)
+
+	assert.Nil(t, cfg.Delete(ctx, "C1", "quiet_hours"))
+	_, ok, _ = cfg.Get(ctx, "C1", "quiet_hours")
+	assert.False(t, ok)
+}
+
+func TestChannelConfigPinnedStore(t *testing.T) {
+	var (
+		pinned  *Message
+		posted  int
+		updated int
+	)
+
+	http.HandleFunc("/config/pins.list", func(w http.ResponseWriter, r *http.Request) {
+		items := []Item{}
+		if pinned != nil {
+			items = append(items, NewMessageItem(r.FormValue("channel"), pinned))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(listPinsResponseFull{Items: items, SlackResponse: SlackResponse{Ok: true}})
+	})
+	http.HandleFunc("/config/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		posted++
+		pinned = &Message{Msg: Msg{Timestamp: "123.456", Text: r.FormValue("text")}}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"123.456"}`))
+	})
+	http.HandleFunc("/config/chat.update", func(w http.ResponseWriter, r *http.Request) {
+		updated++
+		assert.Equal(t, "123.456", r.FormValue("ts"))
+		pinned.Text = r.FormValue("text")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"123.456"}`))
+	})
+	http.HandleFunc("/config/pins.add", okJSONHandler)
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/config/"))
+	ctx := context.Background()
+	cfg := NewChannelConfig(NewPinnedConfigStore(api, ""))
+
+	assert.Nil(t, cfg.Set(ctx, "C1", "quiet_hours", "22-08"))
+	assert.Nil(t, cfg.Set(ctx, "C1", "mode", "<loud>"))
+	assert.Equal(t, 1, posted)
+	assert.Equal(t, 1, updated)
+
+	v, ok, err := cfg.Get(ctx, "C1", "mode")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "<loud>", v)
+}
@@ -18,6 +18,7 @@ type Conversation struct {
 	UnreadCountDisplay int      `json:"unread_count_display,omitempty"`
 	IsGroup            bool     `json:"is_group"`
 	IsShared           bool     `json:"is_shared"`
+	SharedTeamIDs      []string `json:"shared_team_ids,omitempty"`
 	IsIM               bool     `json:"is_im"`
 	IsExtShared        bool     `json:"is_ext_shared"`
 	IsOrgShared        bool     `json:"is_org_shared"`
@@ -59,6 +60,7 @@ type Purpose struct {
 	LastSet JSONTime `json:"last_set"`
 }
 
+// GetUsersInConversationParameters contains arguments for the GetUsersInConversation method call.
 type GetUsersInConversationParameters struct {
 	ChannelID string
 	Cursor    string
@@ -112,6 +114,54 @@ func (api *Client) GetUsersInConversationContext(ctx context.Context, params *Ge
 	return response.Members, response.ResponseMetaData.NextCursor, nil
 }
 
+// GetConversationMembers returns the IDs of every member of a conversation, see GetConversationMembersContext.
+func (api *Client) GetConversationMembers(channelID string) ([]string, error) {
+	return api.GetConversationMembersContext(context.Background(), channelID)
+}
+
+// GetConversationMembersContext returns the IDs of every member of a conversation with a custom context.
+// conversations.members is paged through 1000 members at a time.
+func (api *Client) GetConversationMembersContext(ctx context.Context, channelID string) (members []string, err error) {
+	var (
+		page []string
+		next string
+	)
+
+	params := GetUsersInConversationParameters{ChannelID: channelID, Limit: 1000}
+	for {
+		err = api.retryRateLimited(ctx, func() (err error) {
+			page, next, err = api.GetUsersInConversationContext(ctx, &params)
+			return err
+		})
+		if err != nil {
+			return members, err
+		}
+
+		members = append(members, page...)
+		if next == "" {
+			return members, nil
+		}
+
+		params.Cursor = next
+	}
+}
+
+// GetConversationMemberUsers returns the users who are members of a conversation, see GetConversationMemberUsersContext.
+func (api *Client) GetConversationMemberUsers(channelID string) ([]User, error) {
+	return api.GetConversationMemberUsersContext(context.Background(), channelID)
+}
+
+// GetConversationMemberUsersContext returns the users who are members of a conversation with a custom context.
+// the members are resolved in batches, see GetUsersInfoContext.
+func (api *Client) GetConversationMemberUsersContext(ctx context.Context, channelID string) ([]User, error) {
+	members, err := api.GetConversationMembersContext(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetUsersInfoContext(ctx, members...)
+}
+
 // GetConversationsForUser returns the list conversations for a given user
 func (api *Client) GetConversationsForUser(params *GetConversationsForUserParameters) (channels []Channel, nextCursor string, err error) {
 	return api.GetConversationsForUserContext(context.Background(), params)
@@ -191,13 +241,27 @@ func (api *Client) UnArchiveConversationContext(ctx context.Context, channelID s
 	return response.Err()
 }
 
-// SetTopicOfConversation sets the topic for a conversation
+// SetTopicOfConversation sets the topic for a conversation.
+//
+// Deprecated: use SetConversationTopic.
 func (api *Client) SetTopicOfConversation(channelID, topic string) (*Channel, error) {
-	return api.SetTopicOfConversationContext(context.Background(), channelID, topic)
+	return api.SetConversationTopicContext(context.Background(), channelID, topic)
 }
 
-// SetTopicOfConversationContext sets the topic for a conversation with a custom context
+// SetTopicOfConversationContext sets the topic for a conversation with a custom context.
+//
+// Deprecated: use SetConversationTopicContext.
 func (api *Client) SetTopicOfConversationContext(ctx context.Context, channelID, topic string) (*Channel, error) {
+	return api.SetConversationTopicContext(ctx, channelID, topic)
+}
+
+// SetConversationTopic sets the topic for a conversation and returns the updated conversation.
+func (api *Client) SetConversationTopic(channelID, topic string) (*Channel, error) {
+	return api.SetConversationTopicContext(context.Background(), channelID, topic)
+}
+
+// SetConversationTopicContext sets the topic for a conversation and returns the updated conversation with a custom context
+func (api *Client) SetConversationTopicContext(ctx context.Context, channelID, topic string) (*Channel, error) {
 	values := url.Values{
 		"token":   {api.token},
 		"channel": {channelID},
@@ -215,13 +279,27 @@ func (api *Client) SetTopicOfConversationContext(ctx context.Context, channelID,
 	return response.Channel, response.Err()
 }
 
-// SetPurposeOfConversation sets the purpose for a conversation
+// SetPurposeOfConversation sets the purpose for a conversation.
+//
+// Deprecated: use SetConversationPurpose.
 func (api *Client) SetPurposeOfConversation(channelID, purpose string) (*Channel, error) {
-	return api.SetPurposeOfConversationContext(context.Background(), channelID, purpose)
+	return api.SetConversationPurposeContext(context.Background(), channelID, purpose)
 }
 
-// SetPurposeOfConversationContext sets the purpose for a conversation with a custom context
+// SetPurposeOfConversationContext sets the purpose for a conversation with a custom context.
+//
+// Deprecated: use SetConversationPurposeContext.
 func (api *Client) SetPurposeOfConversationContext(ctx context.Context, channelID, purpose string) (*Channel, error) {
+	return api.SetConversationPurposeContext(ctx, channelID, purpose)
+}
+
+// SetConversationPurpose sets the purpose for a conversation and returns the updated conversation.
+func (api *Client) SetConversationPurpose(channelID, purpose string) (*Channel, error) {
+	return api.SetConversationPurposeContext(context.Background(), channelID, purpose)
+}
+
+// SetConversationPurposeContext sets the purpose for a conversation and returns the updated conversation with a custom context
+func (api *Client) SetConversationPurposeContext(ctx context.Context, channelID, purpose string) (*Channel, error) {
 	values := url.Values{
 		"token":   {api.token},
 		"channel": {channelID},
@@ -240,7 +318,7 @@ func (api *Client) SetPurposeOfConversationContext(ctx context.Context, channelI
 	return response.Channel, response.Err()
 }
 
-// RenameConversation renames a conversation
+// RenameConversation renames a conversation and returns the updated conversation.
 func (api *Client) RenameConversation(channelID, channelName string) (*Channel, error) {
 	return api.RenameConversationContext(context.Background(), channelID, channelName)
 }
@@ -270,8 +348,33 @@ func (api *Client) InviteUsersToConversation(channelID string, users ...string)
 	return api.InviteUsersToConversationContext(context.Background(), channelID, users...)
 }
 
-// InviteUsersToConversationContext invites users to a channel with a custom context
-func (api *Client) InviteUsersToConversationContext(ctx context.Context, channelID string, users ...string) (*Channel, error) {
+// maximum number of users accepted by a single conversations.invite request.
+const conversationsInviteMaxUsers = 1000
+
+// InviteUsersToConversationContext invites users to a channel with a custom context.
+// large lists of users are split across multiple requests.
+func (api *Client) InviteUsersToConversationContext(ctx context.Context, channelID string, users ...string) (channel *Channel, err error) {
+	if len(users) == 0 {
+		return nil, ErrParametersMissing
+	}
+
+	for len(users) > 0 {
+		n := len(users)
+		if n > conversationsInviteMaxUsers {
+			n = conversationsInviteMaxUsers
+		}
+
+		if channel, err = api.inviteUsersToConversation(ctx, channelID, users[:n]); err != nil {
+			return channel, err
+		}
+
+		users = users[n:]
+	}
+
+	return channel, nil
+}
+
+func (api *Client) inviteUsersToConversation(ctx context.Context, channelID string, users []string) (*Channel, error) {
 	values := url.Values{
 		"token":   {api.token},
 		"channel": {channelID},
@@ -338,17 +441,21 @@ func (api *Client) CloseConversationContext(ctx context.Context, channelID strin
 }
 
 // CreateConversation initiates a public or private channel-based conversation
-func (api *Client) CreateConversation(channelName string, isPrivate bool) (*Channel, error) {
-	return api.CreateConversationContext(context.Background(), channelName, isPrivate)
+func (api *Client) CreateConversation(channelName string, isPrivate bool, options ...ParamOption) (*Channel, error) {
+	return api.CreateConversationContext(context.Background(), channelName, isPrivate, options...)
 }
 
-// CreateConversationContext initiates a public or private channel-based conversation with a custom context
-func (api *Client) CreateConversationContext(ctx context.Context, channelName string, isPrivate bool) (*Channel, error) {
+// CreateConversationContext initiates a public or private channel-based conversation with a custom context,
+// org wide apps must provide the workspace using ParamOptionTeamID.
+func (api *Client) CreateConversationContext(ctx context.Context, channelName string, isPrivate bool, options ...ParamOption) (*Channel, error) {
 	values := url.Values{
 		"token":      {api.token},
 		"name":       {channelName},
 		"is_private": {strconv.FormatBool(isPrivate)},
 	}
+	for _, opt := range options {
+		opt(&values)
+	}
 	response, err := api.channelRequest(ctx, "conversations.create", values)
 	if err != nil {
 		return nil, err
@@ -357,18 +464,30 @@ func (api *Client) CreateConversationContext(ctx context.Context, channelName st
 	return &response.Channel, nil
 }
 
+// ParamOptionIncludeNumMembers include the number of members in the conversation, see GetConversationInfo.
+func ParamOptionIncludeNumMembers() ParamOption {
+	return func(values *url.Values) {
+		values.Set("include_num_members", "true")
+	}
+}
+
 // GetConversationInfo retrieves information about a conversation
-func (api *Client) GetConversationInfo(channelID string, includeLocale bool) (*Channel, error) {
-	return api.GetConversationInfoContext(context.Background(), channelID, includeLocale)
+func (api *Client) GetConversationInfo(channelID string, includeLocale bool, options ...ParamOption) (*Channel, error) {
+	return api.GetConversationInfoContext(context.Background(), channelID, includeLocale, options...)
 }
 
-// GetConversationInfoContext retrieves information about a conversation with a custom context
-func (api *Client) GetConversationInfoContext(ctx context.Context, channelID string, includeLocale bool) (*Channel, error) {
+// GetConversationInfoContext retrieves information about a conversation with a custom context.
+// NumMembers is only populated when ParamOptionIncludeNumMembers is provided.
+func (api *Client) GetConversationInfoContext(ctx context.Context, channelID string, includeLocale bool, options ...ParamOption) (*Channel, error) {
 	values := url.Values{
 		"token":          {api.token},
 		"channel":        {channelID},
 		"include_locale": {strconv.FormatBool(includeLocale)},
 	}
+	for _, opt := range options {
+		opt(&values)
+	}
+
 	response, err := api.channelRequest(ctx, "conversations.info", values)
 	if err != nil {
 		return nil, err
@@ -397,6 +516,31 @@ func (api *Client) LeaveConversationContext(ctx context.Context, channelID strin
 	return response.NotInChannel, err
 }
 
+// MarkConversation sets the read cursor of a conversation
+func (api *Client) MarkConversation(channelID, timestamp string) error {
+	return api.MarkConversationContext(context.Background(), channelID, timestamp)
+}
+
+// MarkConversationContext sets the read cursor of a conversation with a custom context,
+// the cursor is exposed as LastRead by GetConversationInfo.
+func (api *Client) MarkConversationContext(ctx context.Context, channelID, timestamp string) error {
+	values := url.Values{
+		"token":   {api.token},
+		"channel": {channelID},
+		"ts":      {timestamp},
+	}
+
+	response := SlackResponse{}
+	err := api.postMethod(ctx, "conversations.mark", values, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// GetConversationRepliesParameters contains arguments for the GetConversationReplies method call,
+// Timestamp is the timestamp of the thread's parent message.
 type GetConversationRepliesParameters struct {
 	ChannelID string
 	Timestamp string
@@ -453,11 +597,63 @@ func (api *Client) GetConversationRepliesContext(ctx context.Context, params *Ge
 	return response.Messages, response.HasMore, response.ResponseMetaData.NextCursor, response.Err()
 }
 
+// GetFullThread retrieves the parent message and every reply of a thread, see GetFullThreadContext.
+func (api *Client) GetFullThread(channelID, threadTimestamp string) ([]Message, error) {
+	return api.GetFullThreadContext(context.Background(), channelID, threadTimestamp)
+}
+
+// GetFullThreadContext retrieves the parent message and every reply of a thread with a custom context.
+// The messages are ordered oldest first so the parent message is first, long threads take multiple
+// conversations.replies requests.
+func (api *Client) GetFullThreadContext(ctx context.Context, channelID, threadTimestamp string) (msgs []Message, err error) {
+	var (
+		page    []Message
+		hasMore bool
+		next    string
+	)
+
+	params := GetConversationRepliesParameters{ChannelID: channelID, Timestamp: threadTimestamp, Limit: 200}
+	for {
+		err = api.retryRateLimited(ctx, func() (err error) {
+			page, hasMore, next, err = api.GetConversationRepliesContext(ctx, &params)
+			return err
+		})
+		if err != nil {
+			return msgs, err
+		}
+
+		// the parent message is included in every page.
+		for _, m := range page {
+			if len(msgs) > 0 && m.Timestamp == threadTimestamp {
+				continue
+			}
+			msgs = append(msgs, m)
+		}
+
+		if !hasMore || next == "" {
+			return msgs, nil
+		}
+
+		params.Cursor = next
+	}
+}
+
+// Conversation types used to filter conversations.list and users.conversations.
+const (
+	ConversationTypePublicChannel  = "public_channel"
+	ConversationTypePrivateChannel = "private_channel"
+	ConversationTypeMPIM           = "mpim"
+	ConversationTypeIM             = "im"
+)
+
+// GetConversationsParameters contains arguments for the GetConversations method call.
+// Types defaults to public_channel when empty, TeamID is required by org wide apps.
 type GetConversationsParameters struct {
 	Cursor          string
 	ExcludeArchived string
 	Limit           int
 	Types           []string
+	TeamID          string
 }
 
 // GetConversations returns the list of channels in a Slack team
@@ -468,8 +664,10 @@ func (api *Client) GetConversations(params *GetConversationsParameters) (channel
 // GetConversationsContext returns the list of channels in a Slack team with a custom context
 func (api *Client) GetConversationsContext(ctx context.Context, params *GetConversationsParameters) (channels []Channel, nextCursor string, err error) {
 	values := url.Values{
-		"token":            {api.token},
-		"exclude_archived": {params.ExcludeArchived},
+		"token": {api.token},
+	}
+	if params.ExcludeArchived != "" {
+		values.Add("exclude_archived", params.ExcludeArchived)
 	}
 	if params.Cursor != "" {
 		values.Add("cursor", params.Cursor)
@@ -480,6 +678,9 @@ func (api *Client) GetConversationsContext(ctx context.Context, params *GetConve
 	if params.Types != nil {
 		values.Add("types", strings.Join(params.Types, ","))
 	}
+	if params.TeamID != "" {
+		values.Add("team_id", params.TeamID)
+	}
 	response := struct {
 		Channels         []Channel        `json:"channels"`
 		ResponseMetaData responseMetaData `json:"response_metadata"`
@@ -494,6 +695,156 @@ func (api *Client) GetConversationsContext(ctx context.Context, params *GetConve
 	return response.Channels, response.ResponseMetaData.NextCursor, response.Err()
 }
 
+// ConversationPagination allows for paginating over conversations.list, see ForEachConversationContext for usage.
+type ConversationPagination struct {
+	Channels []Channel
+	params   GetConversationsParameters
+	previous *responseMetaData
+	c        *Client
+}
+
+// Done checks if the pagination has completed
+func (ConversationPagination) Done(err error) bool {
+	return err == errPaginationComplete
+}
+
+// Failure checks if pagination failed.
+func (t ConversationPagination) Failure(err error) error {
+	if t.Done(err) {
+		return nil
+	}
+
+	return err
+}
+
+// Next retrieves the next page of conversations.
+func (t ConversationPagination) Next(ctx context.Context) (_ ConversationPagination, err error) {
+	var (
+		channels []Channel
+		cursor   string
+	)
+
+	if t.c == nil || (t.previous != nil && t.previous.NextCursor == "") {
+		return t, errPaginationComplete
+	}
+
+	params := t.params
+	if t.previous != nil {
+		params.Cursor = t.previous.NextCursor
+	}
+
+	if channels, cursor, err = t.c.GetConversationsContext(ctx, &params); err != nil {
+		return t, err
+	}
+
+	t.Channels = channels
+	t.previous = &responseMetaData{NextCursor: cursor}
+
+	return t, nil
+}
+
+// GetConversationsPaginated fetches conversations in a paginated fashion, see ForEachConversationContext for usage.
+func (api *Client) GetConversationsPaginated(params GetConversationsParameters) ConversationPagination {
+	if params.Limit == 0 {
+		params.Limit = 200 // per slack api documentation.
+	}
+
+	return ConversationPagination{
+		c:      api,
+		params: params,
+	}
+}
+
+// ForEachConversation invokes fn for every conversation, see ForEachConversationContext.
+func (api *Client) ForEachConversation(params GetConversationsParameters, fn func(Channel) error) error {
+	return api.ForEachConversationContext(context.Background(), params, fn)
+}
+
+// ForEachConversationContext invokes fn for every conversation matching the parameters with a custom context.
+// Iteration stops at the first error returned by fn, returning ErrStopIteration stops the iteration without an error.
+func (api *Client) ForEachConversationContext(ctx context.Context, params GetConversationsParameters, fn func(Channel) error) (err error) {
+	p := api.GetConversationsPaginated(params)
+	for err == nil {
+		err = api.retryRateLimited(ctx, func() (err error) {
+			p, err = p.Next(ctx)
+			return err
+		})
+		if err != nil {
+			break
+		}
+
+		for _, channel := range p.Channels {
+			if err = fn(channel); err == ErrStopIteration {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	}
+
+	return p.Failure(err)
+}
+
+// ForEachConversationForUser invokes fn for every conversation the user is a member of, see ForEachConversationForUserContext.
+func (api *Client) ForEachConversationForUser(params GetConversationsForUserParameters, fn func(Channel) error) error {
+	return api.ForEachConversationForUserContext(context.Background(), params, fn)
+}
+
+// ForEachConversationForUserContext invokes fn for every conversation the user is a member of with a custom context,
+// an empty UserID lists the conversations of the calling user or bot. users.conversations is paged through 200
+// conversations at a time. Iteration stops at the first error returned by fn, returning ErrStopIteration stops
+// the iteration without an error.
+func (api *Client) ForEachConversationForUserContext(ctx context.Context, params GetConversationsForUserParameters, fn func(Channel) error) error {
+	var (
+		channels []Channel
+		cursor   string
+	)
+
+	if params.Limit == 0 {
+		params.Limit = 200 // per slack api documentation.
+	}
+
+	for {
+		err := api.retryRateLimited(ctx, func() (err error) {
+			channels, cursor, err = api.GetConversationsForUserContext(ctx, &params)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, channel := range channels {
+			if err = fn(channel); err == ErrStopIteration {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+
+		if cursor == "" {
+			return nil
+		}
+
+		params.Cursor = cursor
+	}
+}
+
+// GetAllConversationsForUser returns every conversation the user is a member of, see ForEachConversationForUserContext.
+func (api *Client) GetAllConversationsForUser(params GetConversationsForUserParameters) ([]Channel, error) {
+	return api.GetAllConversationsForUserContext(context.Background(), params)
+}
+
+// GetAllConversationsForUserContext returns every conversation the user is a member of with a custom context,
+// see ForEachConversationForUserContext.
+func (api *Client) GetAllConversationsForUserContext(ctx context.Context, params GetConversationsForUserParameters) (channels []Channel, err error) {
+	err = api.ForEachConversationForUserContext(ctx, params, func(c Channel) error {
+		channels = append(channels, c)
+		return nil
+	})
+
+	return channels, err
+}
+
 type OpenConversationParameters struct {
 	ChannelID string
 	ReturnIM  bool
@@ -563,6 +914,9 @@ func (api *Client) JoinConversationContext(ctx context.Context, channelID string
 	return response.Channel, response.Warning, warnings, nil
 }
 
+// GetConversationHistoryParameters contains arguments for the GetConversationHistory method call.
+// Latest and Oldest bound the time range of the messages returned, Inclusive includes
+// messages with the exact Latest or Oldest timestamp.
 type GetConversationHistoryParameters struct {
 	ChannelID string
 	Cursor    string
@@ -572,6 +926,29 @@ type GetConversationHistoryParameters struct {
 	Oldest    string
 }
 
+func (t GetConversationHistoryParameters) values(token string) url.Values {
+	values := url.Values{"token": {token}, "channel": {t.ChannelID}}
+	if t.Cursor != "" {
+		values.Add("cursor", t.Cursor)
+	}
+	if t.Inclusive {
+		values.Add("inclusive", "1")
+	} else {
+		values.Add("inclusive", "0")
+	}
+	if t.Latest != "" {
+		values.Add("latest", t.Latest)
+	}
+	if t.Limit != 0 {
+		values.Add("limit", strconv.Itoa(t.Limit))
+	}
+	if t.Oldest != "" {
+		values.Add("oldest", t.Oldest)
+	}
+
+	return values
+}
+
 type GetConversationHistoryResponse struct {
 	SlackResponse
 	HasMore          bool   `json:"has_more"`
@@ -583,38 +960,253 @@ type GetConversationHistoryResponse struct {
 	Messages []Message `json:"messages"`
 }
 
-// GetConversationHistory joins an existing conversation
+// GetConversationHistory retrieves the messages posted to a conversation, use
+// the NextCursor of the response to retrieve additional pages.
 func (api *Client) GetConversationHistory(params *GetConversationHistoryParameters) (*GetConversationHistoryResponse, error) {
 	return api.GetConversationHistoryContext(context.Background(), params)
 }
 
-// GetConversationHistoryContext joins an existing conversation with a custom context
+// GetConversationHistoryContext retrieves the messages posted to a conversation with a custom context
 func (api *Client) GetConversationHistoryContext(ctx context.Context, params *GetConversationHistoryParameters) (*GetConversationHistoryResponse, error) {
-	values := url.Values{"token": {api.token}, "channel": {params.ChannelID}}
-	if params.Cursor != "" {
-		values.Add("cursor", params.Cursor)
+	values := params.values(api.token)
+	response := GetConversationHistoryResponse{}
+
+	err := api.postMethod(ctx, "conversations.history", values, &response)
+	if err != nil {
+		return nil, err
 	}
-	if params.Inclusive {
-		values.Add("inclusive", "1")
-	} else {
-		values.Add("inclusive", "0")
+
+	return &response, response.Err()
+}
+
+// InviteSharedParameters contains arguments for the InviteShared method call,
+// either Emails or UserIDs must be provided.
+type InviteSharedParameters struct {
+	ChannelID       string
+	Emails          []string
+	UserIDs         []string
+	ExternalLimited bool
+}
+
+// InviteShared sends a Slack Connect invitation to share a channel with another organization,
+// returns the ID of the invite.
+func (api *Client) InviteShared(params InviteSharedParameters) (string, error) {
+	return api.InviteSharedContext(context.Background(), params)
+}
+
+// InviteSharedContext sends a Slack Connect invitation to share a channel with another organization with a custom context.
+// see https://api.slack.com/methods/conversations.inviteShared
+func (api *Client) InviteSharedContext(ctx context.Context, params InviteSharedParameters) (string, error) {
+	if len(params.Emails) == 0 && len(params.UserIDs) == 0 {
+		return "", ErrParametersMissing
 	}
-	if params.Latest != "" {
-		values.Add("latest", params.Latest)
+
+	values := url.Values{
+		"token":   {api.token},
+		"channel": {params.ChannelID},
 	}
-	if params.Limit != 0 {
-		values.Add("limit", strconv.Itoa(params.Limit))
+	if len(params.Emails) > 0 {
+		values.Add("emails", strings.Join(params.Emails, ","))
 	}
-	if params.Oldest != "" {
-		values.Add("oldest", params.Oldest)
+	if len(params.UserIDs) > 0 {
+		values.Add("user_ids", strings.Join(params.UserIDs, ","))
+	}
+	if params.ExternalLimited {
+		values.Add("external_limited", "true")
 	}
 
-	response := GetConversationHistoryResponse{}
+	response := struct {
+		InviteID string `json:"invite_id"`
+		SlackResponse
+	}{}
 
-	err := api.postMethod(ctx, "conversations.history", values, &response)
-	if err != nil {
+	if err := api.postMethod(ctx, "conversations.inviteShared", values, &response); err != nil {
+		return "", err
+	}
+
+	return response.InviteID, response.Err()
+}
+
+// AcceptSharedInviteParameters contains arguments for the AcceptSharedInvite method call,
+// either InviteID or ChannelID must be provided.
+type AcceptSharedInviteParameters struct {
+	ChannelName       string
+	InviteID          string
+	ChannelID         string
+	IsPrivate         bool
+	FreeTrialAccepted bool
+	TeamID            string
+}
+
+// AcceptSharedInviteResponse the result of accepting a Slack Connect invitation.
+type AcceptSharedInviteResponse struct {
+	ChannelID        string `json:"channel_id"`
+	InviteID         string `json:"invite_id"`
+	ImplicitApproval bool   `json:"implicit_approval"`
+}
+
+// AcceptSharedInvite accepts a Slack Connect channel invitation.
+func (api *Client) AcceptSharedInvite(params AcceptSharedInviteParameters) (*AcceptSharedInviteResponse, error) {
+	return api.AcceptSharedInviteContext(context.Background(), params)
+}
+
+// AcceptSharedInviteContext accepts a Slack Connect channel invitation with a custom context.
+// see https://api.slack.com/methods/conversations.acceptSharedInvite
+func (api *Client) AcceptSharedInviteContext(ctx context.Context, params AcceptSharedInviteParameters) (*AcceptSharedInviteResponse, error) {
+	if params.InviteID == "" && params.ChannelID == "" {
+		return nil, ErrParametersMissing
+	}
+
+	values := url.Values{
+		"token":        {api.token},
+		"channel_name": {params.ChannelName},
+	}
+	if params.InviteID != "" {
+		values.Add("invite_id", params.InviteID)
+	}
+	if params.ChannelID != "" {
+		values.Add("channel_id", params.ChannelID)
+	}
+	if params.IsPrivate {
+		values.Add("is_private", "true")
+	}
+	if params.FreeTrialAccepted {
+		values.Add("free_trial_accepted", "true")
+	}
+	if params.TeamID != "" {
+		values.Add("team_id", params.TeamID)
+	}
+
+	response := struct {
+		AcceptSharedInviteResponse
+		SlackResponse
+	}{}
+
+	if err := api.postMethod(ctx, "conversations.acceptSharedInvite", values, &response); err != nil {
 		return nil, err
 	}
 
-	return &response, response.Err()
+	return &response.AcceptSharedInviteResponse, response.Err()
+}
+
+// ApproveSharedInvite approves a Slack Connect channel invitation, targetTeamID is optional
+// and restricts the approval to the provided organization.
+func (api *Client) ApproveSharedInvite(inviteID, targetTeamID string) error {
+	return api.ApproveSharedInviteContext(context.Background(), inviteID, targetTeamID)
+}
+
+// ApproveSharedInviteContext approves a Slack Connect channel invitation with a custom context.
+// see https://api.slack.com/methods/conversations.approveSharedInvite
+func (api *Client) ApproveSharedInviteContext(ctx context.Context, inviteID, targetTeamID string) error {
+	return api.sharedInviteRequest(ctx, "conversations.approveSharedInvite", inviteID, targetTeamID)
+}
+
+// DeclineSharedInvite declines a Slack Connect channel invitation, targetTeamID is optional
+// and restricts the decline to the provided organization.
+func (api *Client) DeclineSharedInvite(inviteID, targetTeamID string) error {
+	return api.DeclineSharedInviteContext(context.Background(), inviteID, targetTeamID)
+}
+
+// DeclineSharedInviteContext declines a Slack Connect channel invitation with a custom context.
+// see https://api.slack.com/methods/conversations.declineSharedInvite
+func (api *Client) DeclineSharedInviteContext(ctx context.Context, inviteID, targetTeamID string) error {
+	return api.sharedInviteRequest(ctx, "conversations.declineSharedInvite", inviteID, targetTeamID)
+}
+
+func (api *Client) sharedInviteRequest(ctx context.Context, method, inviteID, targetTeamID string) error {
+	values := url.Values{
+		"token":     {api.token},
+		"invite_id": {inviteID},
+	}
+	if targetTeamID != "" {
+		values.Add("target_team", targetTeamID)
+	}
+
+	response := SlackResponse{}
+	if err := api.postMethod(ctx, method, values, &response); err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// ListConnectInvitesParameters contains arguments for the ListConnectInvites method call.
+type ListConnectInvitesParameters struct {
+	Count  int
+	Cursor string
+	TeamID string
+}
+
+// ConnectInviteTeam the organization which sent a Slack Connect invitation.
+type ConnectInviteTeam struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Domain      string   `json:"domain"`
+	IsVerified  bool     `json:"is_verified"`
+	DateCreated JSONTime `json:"date_created"`
+}
+
+// ConnectInviteDetail the invitation itself.
+type ConnectInviteDetail struct {
+	ID              string            `json:"id"`
+	DateCreated     JSONTime          `json:"date_created"`
+	DateInvalid     JSONTime          `json:"date_invalid"`
+	InvitingTeam    ConnectInviteTeam `json:"inviting_team"`
+	InvitingUser    *User             `json:"inviting_user,omitempty"`
+	RecipientEmail  string            `json:"recipient_email,omitempty"`
+	RecipientUserID string            `json:"recipient_user_id,omitempty"`
+	Link            string            `json:"link,omitempty"`
+}
+
+// ConnectInviteChannel the channel a Slack Connect invitation shares.
+type ConnectInviteChannel struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IsPrivate bool   `json:"is_private"`
+	IsIM      bool   `json:"is_im"`
+}
+
+// ConnectInvite a pending Slack Connect invitation, Direction is either incoming or outgoing.
+type ConnectInvite struct {
+	Direction       string               `json:"direction"`
+	Status          string               `json:"status"`
+	InviteType      string               `json:"invite_type"`
+	DateLastUpdated JSONTime             `json:"date_last_updated"`
+	Invite          ConnectInviteDetail  `json:"invite"`
+	Channel         ConnectInviteChannel `json:"channel"`
+}
+
+// ListConnectInvites lists the pending Slack Connect invitations of the workspace,
+// use the returned cursor to retrieve additional pages.
+func (api *Client) ListConnectInvites(params ListConnectInvitesParameters) ([]ConnectInvite, string, error) {
+	return api.ListConnectInvitesContext(context.Background(), params)
+}
+
+// ListConnectInvitesContext lists the pending Slack Connect invitations of the workspace with a custom context.
+// see https://api.slack.com/methods/conversations.listConnectInvites
+func (api *Client) ListConnectInvitesContext(ctx context.Context, params ListConnectInvitesParameters) ([]ConnectInvite, string, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+	if params.Count != 0 {
+		values.Add("count", strconv.Itoa(params.Count))
+	}
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+	if params.TeamID != "" {
+		values.Add("team_id", params.TeamID)
+	}
+
+	response := struct {
+		Invites          []ConnectInvite  `json:"invites"`
+		ResponseMetaData responseMetaData `json:"response_metadata"`
+		SlackResponse
+	}{}
+
+	if err := api.postMethod(ctx, "conversations.listConnectInvites", values, &response); err != nil {
+		return nil, "", err
+	}
+
+	return response.Invites, response.ResponseMetaData.NextCursor, response.Err()
 }
@@ -2,8 +2,10 @@ package slack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -317,6 +319,22 @@ func TestSetPurposeOfConversation(t *testing.T) {
 	}
 }
 
+func TestSetConversationTopicAndPurpose(t *testing.T) {
+	http.HandleFunc("/settings/conversations.setTopic", okChannelJsonHandler)
+	http.HandleFunc("/settings/conversations.setPurpose", okChannelJsonHandler)
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/settings/"))
+	inputChannel := getTestChannel()
+
+	channel, err := api.SetConversationTopic("CXXXXXXXX", inputChannel.Topic.Value)
+	assert.Nil(t, err)
+	assert.Equal(t, inputChannel.Topic.Value, channel.Topic.Value)
+
+	channel, err = api.SetConversationPurpose("CXXXXXXXX", inputChannel.Purpose.Value)
+	assert.Nil(t, err)
+	assert.Equal(t, inputChannel.Purpose.Value, channel.Purpose.Value)
+}
+
 func TestRenameConversation(t *testing.T) {
 	http.HandleFunc("/conversations.rename", okChannelJsonHandler)
 	once.Do(startServer)
@@ -396,6 +414,43 @@ func TestCreateConversation(t *testing.T) {
 	}
 }
 
+func TestCreateConversationTeamID(t *testing.T) {
+	http.HandleFunc("/lifecycle/conversations.create", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "incidents", r.FormValue("name"))
+		assert.Equal(t, "true", r.FormValue("is_private"))
+		assert.Equal(t, "T1", r.FormValue("team_id"))
+		okChannelJsonHandler(rw, r)
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/lifecycle/"))
+	channel, err := api.CreateConversation("incidents", true, ParamOptionTeamID("T1"))
+	assert.Nil(t, err)
+	assert.NotNil(t, channel)
+}
+
+func TestInviteUsersToConversationBatches(t *testing.T) {
+	var batches []int
+	http.HandleFunc("/membership/conversations.invite", func(rw http.ResponseWriter, r *http.Request) {
+		batches = append(batches, len(strings.Split(r.FormValue("users"), ",")))
+		okChannelJsonHandler(rw, r)
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/membership/"))
+
+	users := make([]string, 0, 1500)
+	for i := 0; i < 1500; i++ {
+		users = append(users, fmt.Sprintf("U%d", i))
+	}
+
+	channel, err := api.InviteUsersToConversation("CXXXXXXXX", users...)
+	assert.Nil(t, err)
+	assert.NotNil(t, channel)
+	assert.Equal(t, []int{1000, 500}, batches)
+
+	_, err = api.InviteUsersToConversation("CXXXXXXXX")
+	assert.Equal(t, ErrParametersMissing, err)
+}
+
 func TestGetConversationInfo(t *testing.T) {
 	http.HandleFunc("/conversations.info", okChannelJsonHandler)
 	once.Do(startServer)
@@ -488,6 +543,46 @@ func TestGetConversations(t *testing.T) {
 	}
 }
 
+func TestGetConversationsPagination(t *testing.T) {
+	http.HandleFunc("/pagination/conversations.list", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "public_channel,private_channel", r.FormValue("types"))
+		assert.Equal(t, "true", r.FormValue("exclude_archived"))
+		assert.Equal(t, "T1", r.FormValue("team_id"))
+
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("cursor") == "" {
+			rw.Write([]byte(`{"ok":true,"channels":[{"id":"C1"}],"response_metadata":{"next_cursor":"abc"}}`))
+			return
+		}
+		rw.Write([]byte(`{"ok":true,"channels":[{"id":"C2"}],"response_metadata":{"next_cursor":""}}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/pagination/"))
+
+	params := GetConversationsParameters{
+		ExcludeArchived: "true",
+		Types:           []string{ConversationTypePublicChannel, ConversationTypePrivateChannel},
+		TeamID:          "T1",
+	}
+
+	var ids []string
+	for {
+		channels, cursor, err := api.GetConversations(&params)
+		if !assert.Nil(t, err) {
+			return
+		}
+		for _, c := range channels {
+			ids = append(ids, c.ID)
+		}
+		if cursor == "" {
+			break
+		}
+		params.Cursor = cursor
+	}
+
+	assert.Equal(t, []string{"C1", "C2"}, ids)
+}
+
 func openConversationHandler(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Set("Content-Type", "application/json")
 	response, _ := json.Marshal(struct {
@@ -555,3 +650,303 @@ func TestGetConversationHistory(t *testing.T) {
 		return
 	}
 }
+
+func TestGetConversationHistoryMessages(t *testing.T) {
+	http.HandleFunc("/history/conversations.history", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "CXXXXXXXX", r.FormValue("channel"))
+		assert.Equal(t, "1500000000.000000", r.FormValue("oldest"))
+		assert.Equal(t, "1", r.FormValue("inclusive"))
+		assert.Equal(t, "100", r.FormValue("limit"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{
+			"ok": true,
+			"has_more": true,
+			"messages": [{
+				"type": "message",
+				"user": "U1",
+				"text": "status",
+				"ts": "1500000001.000000",
+				"blocks": [{"type":"section","block_id":"s1","text":{"type":"mrkdwn","text":"*status*"}}],
+				"files": [{"id":"F1","name":"report.pdf"}],
+				"reactions": [{"name":"eyes","count":1,"users":["U2"]}]
+			}],
+			"response_metadata": {"next_cursor": "bmV4dA=="}
+		}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/history/"))
+	params := GetConversationHistoryParameters{ChannelID: "CXXXXXXXX", Oldest: "1500000000.000000", Inclusive: true, Limit: 100}
+	history, err := api.GetConversationHistory(&params)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	assert.True(t, history.HasMore)
+	assert.Equal(t, "bmV4dA==", history.ResponseMetaData.NextCursor)
+	assert.Equal(t, 1, len(history.Messages))
+
+	msg := history.Messages[0]
+	assert.Equal(t, "s1", msg.Blocks.BlockSet[0].(*SectionBlock).BlockID)
+	assert.Equal(t, "F1", msg.Files[0].ID)
+	assert.Equal(t, "eyes", msg.Reactions[0].Name)
+}
+
+func TestGetConversationMemberUsers(t *testing.T) {
+	var batches []string
+	limited := false
+	http.HandleFunc("/members/conversations.members", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("cursor") == "" {
+			rw.Write([]byte(`{"ok":true,"members":["U1"],"response_metadata":{"next_cursor":"abc"}}`))
+			return
+		}
+		rw.Write([]byte(`{"ok":true,"members":["U2"],"response_metadata":{"next_cursor":""}}`))
+	})
+	http.HandleFunc("/members/users.info", func(rw http.ResponseWriter, r *http.Request) {
+		if !limited {
+			limited = true
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		batches = append(batches, r.FormValue("users"))
+		users := []string{}
+		for _, id := range strings.Split(r.FormValue("users"), ",") {
+			users = append(users, `{"id":"`+id+`"}`)
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"users":[` + strings.Join(users, ",") + `]}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/members/"))
+
+	members, err := api.GetConversationMembers("CXXXXXXXX")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"U1", "U2"}, members)
+
+	users, err := api.GetConversationMemberUsers("CXXXXXXXX")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(users))
+	assert.Equal(t, "U1", users[0].ID)
+	assert.Equal(t, "U2", users[1].ID)
+	assert.Equal(t, []string{"U1,U2"}, batches)
+}
+
+func TestMarkConversation(t *testing.T) {
+	http.HandleFunc("/conversations.mark", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "CXXXXXXXX", r.FormValue("channel"))
+		assert.Equal(t, "1500000000.000000", r.FormValue("ts"))
+		okJSONHandler(rw, r)
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+	err := api.MarkConversation("CXXXXXXXX", "1500000000.000000")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+}
+
+func TestForEachConversation(t *testing.T) {
+	limited := false
+	http.HandleFunc("/foreach/conversations.list", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "private_channel", r.FormValue("types"))
+		switch r.FormValue("cursor") {
+		case "":
+			rw.Write([]byte(`{"ok":true,"channels":[{"id":"C1"},{"id":"C2"}],"response_metadata":{"next_cursor":"page2"}}`))
+		case "page2":
+			if !limited {
+				limited = true
+				rw.Header().Set("Retry-After", "0")
+				rw.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			rw.Write([]byte(`{"ok":true,"channels":[{"id":"C3"}],"response_metadata":{"next_cursor":""}}`))
+		}
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/foreach/"))
+	params := GetConversationsParameters{Types: []string{ConversationTypePrivateChannel}}
+
+	var ids []string
+	err := api.ForEachConversation(params, func(c Channel) error {
+		ids = append(ids, c.ID)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C1", "C2", "C3"}, ids)
+	assert.True(t, limited)
+
+	ids = nil
+	err = api.ForEachConversation(params, func(c Channel) error {
+		ids = append(ids, c.ID)
+		if c.ID == "C2" {
+			return ErrStopIteration
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C1", "C2"}, ids)
+
+	err = api.ForEachConversation(params, func(c Channel) error {
+		return ErrParametersMissing
+	})
+	assert.Equal(t, ErrParametersMissing, err)
+}
+
+func TestGetAllConversationsForUser(t *testing.T) {
+	http.HandleFunc("/userconvs/users.conversations", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "U1", r.FormValue("user"))
+		assert.Equal(t, "public_channel,im", r.FormValue("types"))
+		assert.Equal(t, "200", r.FormValue("limit"))
+		switch r.FormValue("cursor") {
+		case "":
+			rw.Write([]byte(`{"ok":true,"channels":[{"id":"C1"},{"id":"D1"}],"response_metadata":{"next_cursor":"page2"}}`))
+		case "page2":
+			rw.Write([]byte(`{"ok":true,"channels":[{"id":"C2"}],"response_metadata":{"next_cursor":""}}`))
+		}
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/userconvs/"))
+	params := GetConversationsForUserParameters{
+		UserID: "U1",
+		Types:  []string{ConversationTypePublicChannel, ConversationTypeIM},
+	}
+
+	channels, err := api.GetAllConversationsForUser(params)
+	assert.Nil(t, err)
+	assert.Len(t, channels, 3)
+	assert.Equal(t, "C2", channels[2].ID)
+
+	var ids []string
+	err = api.ForEachConversationForUser(params, func(c Channel) error {
+		ids = append(ids, c.ID)
+		return ErrStopIteration
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C1"}, ids)
+}
+
+func TestGetConversationInfoIncludeNumMembers(t *testing.T) {
+	http.HandleFunc("/info/conversations.info", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.FormValue("include_locale"))
+		assert.Equal(t, "true", r.FormValue("include_num_members"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"channel":{"id":"C1","locale":"en-US","is_member":true,"is_shared":true,"num_members":42,"shared_team_ids":["T1","T2"]}}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/info/"))
+
+	channel, err := api.GetConversationInfo("C1", true, ParamOptionIncludeNumMembers())
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, "en-US", channel.Locale)
+	assert.True(t, channel.IsMember)
+	assert.True(t, channel.IsShared)
+	assert.Equal(t, 42, channel.NumMembers)
+	assert.Equal(t, []string{"T1", "T2"}, channel.SharedTeamIDs)
+}
+
+func TestSharedInvites(t *testing.T) {
+	http.HandleFunc("/connect/conversations.inviteShared", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "C1", r.FormValue("channel"))
+		assert.Equal(t, "a@example.com,b@example.com", r.FormValue("emails"))
+		assert.Equal(t, "true", r.FormValue("external_limited"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"invite_id":"I1"}`))
+	})
+	http.HandleFunc("/connect/conversations.acceptSharedInvite", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "shared", r.FormValue("channel_name"))
+		assert.Equal(t, "I1", r.FormValue("invite_id"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"channel_id":"C2","invite_id":"I1","implicit_approval":true}`))
+	})
+	http.HandleFunc("/connect/conversations.approveSharedInvite", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "I1", r.FormValue("invite_id"))
+		assert.Equal(t, "T2", r.FormValue("target_team"))
+		okJSONHandler(rw, r)
+	})
+	http.HandleFunc("/connect/conversations.declineSharedInvite", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "I2", r.FormValue("invite_id"))
+		assert.Equal(t, "", r.FormValue("target_team"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":false,"error":"invalid_invite"}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/connect/"))
+
+	_, err := api.InviteShared(InviteSharedParameters{ChannelID: "C1"})
+	assert.Equal(t, ErrParametersMissing, err)
+
+	inviteID, err := api.InviteShared(InviteSharedParameters{ChannelID: "C1", Emails: []string{"a@example.com", "b@example.com"}, ExternalLimited: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "I1", inviteID)
+
+	accepted, err := api.AcceptSharedInvite(AcceptSharedInviteParameters{ChannelName: "shared", InviteID: "I1"})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "C2", accepted.ChannelID)
+		assert.True(t, accepted.ImplicitApproval)
+	}
+
+	assert.Nil(t, api.ApproveSharedInvite("I1", "T2"))
+	assert.EqualError(t, api.DeclineSharedInvite("I2", ""), "invalid_invite")
+}
+
+func TestListConnectInvites(t *testing.T) {
+	http.HandleFunc("/connect/conversations.listConnectInvites", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "50", r.FormValue("count"))
+		assert.Equal(t, "abc", r.FormValue("cursor"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"invites":[{"direction":"incoming","status":"pending","date_last_updated":1600000000,"invite_type":"channel","invite":{"id":"I1","date_created":1600000000,"inviting_team":{"id":"T2","name":"Partner"},"inviting_user":{"id":"U2","name":"partner"}},"channel":{"id":"C1","name":"shared","is_private":true}}],"response_metadata":{"next_cursor":"def"}}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/connect/"))
+
+	invites, cursor, err := api.ListConnectInvites(ListConnectInvitesParameters{Count: 50, Cursor: "abc"})
+	if !assert.Nil(t, err) || !assert.Len(t, invites, 1) {
+		return
+	}
+	assert.Equal(t, "def", cursor)
+	assert.Equal(t, "incoming", invites[0].Direction)
+	assert.Equal(t, JSONTime(1600000000), invites[0].DateLastUpdated)
+	assert.Equal(t, "I1", invites[0].Invite.ID)
+	assert.Equal(t, "Partner", invites[0].Invite.InvitingTeam.Name)
+	assert.Equal(t, "U2", invites[0].Invite.InvitingUser.ID)
+	assert.Equal(t, "C1", invites[0].Channel.ID)
+	assert.True(t, invites[0].Channel.IsPrivate)
+}
+
+func TestGetFullThread(t *testing.T) {
+	limited := false
+	http.HandleFunc("/thread/conversations.replies", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "C1", r.FormValue("channel"))
+		assert.Equal(t, "1.0", r.FormValue("ts"))
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cursor") {
+		case "":
+			rw.Write([]byte(`{"ok":true,"has_more":true,"messages":[{"ts":"1.0","text":"parent"},{"ts":"2.0","text":"a"}],"response_metadata":{"next_cursor":"page2"}}`))
+		case "page2":
+			if !limited {
+				limited = true
+				rw.Header().Set("Retry-After", "0")
+				rw.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			rw.Write([]byte(`{"ok":true,"has_more":false,"messages":[{"ts":"1.0","text":"parent"},{"ts":"3.0","text":"b"}]}`))
+		}
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/thread/"))
+
+	msgs, err := api.GetFullThread("C1", "1.0")
+	assert.Nil(t, err)
+	assert.True(t, limited)
+	texts := make([]string, 0, len(msgs))
+	for _, m := range msgs {
+		texts = append(texts, m.Text)
+	}
+	assert.Equal(t, []string{"parent", "a", "b"}, texts)
+}
@@ -0,0 +1,182 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCountdownInterval the default duration between edits of a countdown message.
+const DefaultCountdownInterval = time.Minute
+
+// CountdownOption options for the Countdown.
+type CountdownOption func(*Countdown)
+
+// CountdownOptionInterval duration between edits of the countdown message, defaults to
+// DefaultCountdownInterval.
+func CountdownOptionInterval(d time.Duration) CountdownOption {
+	return func(t *Countdown) {
+		t.interval = d
+	}
+}
+
+// CountdownOptionFormat renders the text of the countdown message from the remaining time,
+// by default the title is followed by the remaining time, e.g. *release* in 1h 5m.
+func CountdownOptionFormat(fn func(remaining time.Duration) string) CountdownOption {
+	return func(t *Countdown) {
+		t.format = fn
+	}
+}
+
+// CountdownOptionFinal the text of the message once the deadline is reached, defaults to
+// the title followed by a checkered flag.
+func CountdownOptionFinal(text string) CountdownOption {
+	return func(t *Countdown) {
+		t.final = text
+	}
+}
+
+// NewCountdown builds a Countdown to the deadline.
+func NewCountdown(api *Client, channelID, title string, deadline time.Time, options ...CountdownOption) *Countdown {
+	t := &Countdown{
+		api:      api,
+		channel:  channelID,
+		deadline: deadline,
+		interval: DefaultCountdownInterval,
+		final:    fmt.Sprintf("*%s* :checkered_flag:", title),
+		format: func(remaining time.Duration) string {
+			return fmt.Sprintf("*%s* in %s", title, FormatCountdown(remaining))
+		},
+		now:   time.Now,
+		after: time.After,
+	}
+
+	for _, opt := range options {
+		opt(t)
+	}
+
+	if t.interval <= 0 {
+		t.interval = DefaultCountdownInterval
+	}
+
+	return t
+}
+
+// Countdown maintains a message counting down to a deadline, e.g. for release trains.
+// The message is edited every interval, once less than an interval remains the periodic
+// edits stop and the final update is scheduled for the deadline.
+type Countdown struct {
+	api      *Client
+	channel  string
+	deadline time.Time
+	interval time.Duration
+	format   func(time.Duration) string
+	final    string
+	now      func() time.Time
+	after    func(time.Duration) <-chan time.Time
+	m        sync.Mutex
+	ts       string
+}
+
+// Timestamp of the countdown message, empty until the message is posted.
+func (t *Countdown) Timestamp() string {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	return t.ts
+}
+
+// Run posts the countdown message and keeps it up to date until the deadline is reached or
+// the context is cancelled. Failed periodic edits are logged and retried at the next interval,
+// failing to post the message or the final update is returned.
+func (t *Countdown) Run(ctx context.Context) error {
+	if err := t.send(ctx, t.render()); err != nil {
+		return err
+	}
+
+	for {
+		remaining := t.deadline.Sub(t.now())
+		if remaining <= 0 {
+			return t.send(ctx, t.final)
+		}
+
+		// align the edits with the interval, when less than an interval remains this
+		// waits until the deadline.
+		wait := remaining % t.interval
+		if wait == 0 {
+			wait = t.interval
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.after(wait):
+		}
+
+		if t.deadline.Sub(t.now()) <= 0 {
+			continue
+		}
+
+		if err := t.send(ctx, t.render()); err != nil {
+			t.api.Debugf("countdown update failed: %s", err)
+		}
+	}
+}
+
+func (t *Countdown) render() string {
+	remaining := t.deadline.Sub(t.now())
+	if remaining <= 0 {
+		return t.final
+	}
+
+	return t.format(remaining)
+}
+
+func (t *Countdown) send(ctx context.Context, text string) (err error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	options := []MsgOption{
+		MsgOptionText(text, false),
+		MsgOptionBlocks(NewSectionBlock(NewTextBlockObject(MarkdownType, Truncate(text, SectionTextMaxLength), false, false), nil, nil)),
+	}
+
+	if t.ts == "" {
+		_, t.ts, err = t.api.PostMessageContext(ctx, t.channel, options...)
+		return err
+	}
+
+	_, _, _, err = t.api.UpdateMessageContext(ctx, t.channel, t.ts, options...)
+	return err
+}
+
+// FormatCountdown formats the remaining duration in days, hours, minutes, and seconds,
+// omitting empty units, e.g. 1d 2h 5s. the duration is rounded up to the second.
+func FormatCountdown(d time.Duration) string {
+	if d <= 0 {
+		return "0s"
+	}
+
+	secs := int64((d + time.Second - 1) / time.Second)
+	units := []struct {
+		suffix string
+		size   int64
+	}{
+		{suffix: "d", size: 24 * 60 * 60},
+		{suffix: "h", size: 60 * 60},
+		{suffix: "m", size: 60},
+		{suffix: "s", size: 1},
+	}
+
+	parts := make([]string, 0, len(units))
+	for _, u := range units {
+		if n := secs / u.size; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, u.suffix))
+			secs -= n * u.size
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,75 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountdown(t *testing.T) {
+	var (
+		posted  []string
+		updated []string
+	)
+
+	http.HandleFunc("/countdown/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		posted = append(posted, r.FormValue("text"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"123.456"}`))
+	})
+	http.HandleFunc("/countdown/chat.update", func(w http.ResponseWriter, r *http.Request) {
+		updated = append(updated, r.FormValue("text"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"123.456","text":""}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/countdown/"))
+
+	now := time.Unix(0, 0)
+	var waits []time.Duration
+	c := NewCountdown(api, "C1", "release", now.Add(150*time.Second))
+	c.now = func() time.Time { return now }
+	c.after = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		now = now.Add(d)
+		ch := make(chan time.Time, 1)
+		ch <- now
+		return ch
+	}
+
+	assert.Nil(t, c.Run(context.Background()))
+	assert.Equal(t, "123.456", c.Timestamp())
+	assert.Equal(t, []string{"*release* in 2m 30s"}, posted)
+	assert.Equal(t, []string{"*release* in 2m", "*release* in 1m", "*release* :checkered_flag:"}, updated)
+	assert.Equal(t, []time.Duration{30 * time.Second, time.Minute, time.Minute}, waits)
+}
+
+func TestCountdownCancelled(t *testing.T) {
+	http.HandleFunc("/countdowncancel/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"123.456"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/countdowncancel/"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := NewCountdown(api, "C1", "release", time.Now().Add(time.Hour))
+	c.after = func(time.Duration) <-chan time.Time {
+		cancel()
+		return nil
+	}
+
+	assert.Equal(t, context.Canceled, c.Run(ctx))
+	assert.Equal(t, "123.456", c.Timestamp())
+}
+
+func TestFormatCountdown(t *testing.T) {
+	assert.Equal(t, "0s", FormatCountdown(0))
+	assert.Equal(t, "1s", FormatCountdown(time.Millisecond))
+	assert.Equal(t, "1d 2h 5s", FormatCountdown(26*time.Hour+5*time.Second))
+}
@@ -0,0 +1,188 @@
+package slack
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// DeliveryWindow describes the working hours of a recipient. Start and End are
+// offsets from midnight in the recipient's timezone, when Start is after End
+// the window spans midnight. Days restricts the window to the given weekdays,
+// when empty every day is allowed.
+type DeliveryWindow struct {
+	Start time.Duration
+	End   time.Duration
+	Days  []time.Weekday
+}
+
+// NewWeekdayDeliveryWindow working hours between start and end on monday through friday.
+func NewWeekdayDeliveryWindow(start, end time.Duration) DeliveryWindow {
+	return DeliveryWindow{
+		Start: start,
+		End:   end,
+		Days:  []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	}
+}
+
+// Next returns the earliest time at or after now that falls within the window.
+func (t DeliveryWindow) Next(now time.Time) time.Time {
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	// the previous day is included to detect windows spanning midnight.
+	for i := -1; i <= 7; i++ {
+		day := midnight.AddDate(0, 0, i)
+		if !t.allowed(day.Weekday()) {
+			continue
+		}
+
+		start := wallClock(day, t.Start)
+		end := wallClock(day, t.End)
+		if t.End <= t.Start {
+			end = wallClock(day.AddDate(0, 0, 1), t.End)
+		}
+
+		if !now.Before(start) && now.Before(end) {
+			return now
+		}
+
+		if start.After(now) {
+			return start
+		}
+	}
+
+	// no allowed days within the window, deliver immediately.
+	return now
+}
+
+// wallClock the time offset from midnight of the day by the wall clock of its location,
+// days with a daylight saving transition are not 24 hours long.
+func wallClock(day time.Time, offset time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, int(offset/time.Second), int(offset%time.Second), day.Location())
+}
+
+func (t DeliveryWindow) allowed(d time.Weekday) bool {
+	if len(t.Days) == 0 {
+		return true
+	}
+
+	for _, allowed := range t.Days {
+		if allowed == d {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TimezoneResolver determines the timezone of a user.
+type TimezoneResolver func(ctx context.Context, userID string) (*time.Location, error)
+
+// DeliveryPolicyOption options for the DeliveryPolicy.
+type DeliveryPolicyOption func(*DeliveryPolicy)
+
+// DeliveryPolicyOptionTimezone resolve recipient timezones using the provided function,
+// by default the timezone is retrieved using users.info.
+func DeliveryPolicyOptionTimezone(resolver TimezoneResolver) DeliveryPolicyOption {
+	return func(p *DeliveryPolicy) {
+		p.timezone = resolver
+	}
+}
+
+// NewDeliveryPolicy defers non-urgent messages sent outside of the recipient's
+// delivery window using chat.scheduleMessage.
+func NewDeliveryPolicy(api *Client, window DeliveryWindow, options ...DeliveryPolicyOption) DeliveryPolicy {
+	p := DeliveryPolicy{
+		api:      api,
+		window:   window,
+		timezone: userTimezoneResolver(api),
+		now:      time.Now,
+	}
+
+	for _, opt := range options {
+		opt(&p)
+	}
+
+	return p
+}
+
+// DeliveryPolicy delivers messages within a recipient's working hours.
+type DeliveryPolicy struct {
+	api      *Client
+	window   DeliveryWindow
+	timezone TimezoneResolver
+	now      func() time.Time
+}
+
+// Delivery describes the outcome of a message sent via a DeliveryPolicy.
+// when the message was deferred ScheduledMessageID and PostAt are set, otherwise
+// Timestamp is set.
+type Delivery struct {
+	Channel            string
+	Timestamp          string
+	ScheduledMessageID string
+	PostAt             time.Time
+}
+
+// Scheduled returns true if the message was deferred.
+func (t Delivery) Scheduled() bool {
+	return t.ScheduledMessageID != ""
+}
+
+// PostMessage delivers a message to a channel on behalf of the recipient's working hours.
+// see PostMessageContext.
+func (t DeliveryPolicy) PostMessage(recipientID, channelID string, options ...MsgOption) (Delivery, error) {
+	return t.PostMessageContext(context.Background(), recipientID, channelID, options...)
+}
+
+// PostMessageContext delivers a message to a channel, if the current time is outside of the
+// recipient's delivery window the message is scheduled for the start of the next window.
+func (t DeliveryPolicy) PostMessageContext(ctx context.Context, recipientID, channelID string, options ...MsgOption) (d Delivery, err error) {
+	var (
+		loc *time.Location
+	)
+
+	if loc, err = t.timezone(ctx, recipientID); err != nil {
+		return d, err
+	}
+
+	now := t.now().In(loc)
+	postAt := t.window.Next(now)
+	if !postAt.After(now) {
+		return t.UrgentContext(ctx, channelID, options...)
+	}
+
+	d.PostAt = postAt
+	d.Channel, d.ScheduledMessageID, err = t.api.ScheduleMessageContext(ctx, channelID, strconv.FormatInt(postAt.Unix(), 10), options...)
+	return d, err
+}
+
+// Urgent delivers the message immediately regardless of the delivery window.
+func (t DeliveryPolicy) Urgent(channelID string, options ...MsgOption) (Delivery, error) {
+	return t.UrgentContext(context.Background(), channelID, options...)
+}
+
+// UrgentContext delivers the message immediately regardless of the delivery window with a custom context.
+func (t DeliveryPolicy) UrgentContext(ctx context.Context, channelID string, options ...MsgOption) (d Delivery, err error) {
+	d.Channel, d.Timestamp, err = t.api.PostMessageContext(ctx, channelID, options...)
+	return d, err
+}
+
+func userTimezoneResolver(api *Client) TimezoneResolver {
+	return func(ctx context.Context, userID string) (*time.Location, error) {
+		u, err := api.GetUserInfoContext(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if u.TZ == "" {
+			return time.FixedZone(u.TZLabel, u.TZOffset), nil
+		}
+
+		if loc, err := time.LoadLocation(u.TZ); err == nil {
+			return loc, nil
+		}
+
+		return time.FixedZone(u.TZ, u.TZOffset), nil
+	}
+}
@@ -0,0 +1,82 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliveryWindowNext(t *testing.T) {
+	// 2019-09-02 is a monday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2019, time.September, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	weekdays := NewWeekdayDeliveryWindow(9*time.Hour, 17*time.Hour)
+	assert.Equal(t, at(2, 10, 30), weekdays.Next(at(2, 10, 30)))
+	assert.Equal(t, at(2, 9, 0), weekdays.Next(at(2, 7, 0)))
+	assert.Equal(t, at(3, 9, 0), weekdays.Next(at(2, 17, 0)))
+	assert.Equal(t, at(9, 9, 0), weekdays.Next(at(6, 18, 0)))
+	assert.Equal(t, at(9, 9, 0), weekdays.Next(at(7, 12, 0)))
+
+	overnight := DeliveryWindow{Start: 22 * time.Hour, End: 6 * time.Hour}
+	assert.Equal(t, at(3, 2, 0), overnight.Next(at(3, 2, 0)))
+	assert.Equal(t, at(3, 22, 0), overnight.Next(at(3, 12, 0)))
+}
+
+func TestDeliveryWindowNextDaylightSaving(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone database unavailable", err)
+	}
+
+	// clocks moved forward an hour at 2am on 2019-03-10, and back an hour on 2019-11-03.
+	window := DeliveryWindow{Start: 9 * time.Hour, End: 17 * time.Hour}
+	assert.Equal(t, time.Date(2019, time.March, 10, 9, 0, 0, 0, loc), window.Next(time.Date(2019, time.March, 10, 7, 0, 0, 0, loc)))
+	assert.Equal(t, time.Date(2019, time.November, 3, 9, 0, 0, 0, loc), window.Next(time.Date(2019, time.November, 3, 7, 0, 0, 0, loc)))
+	assert.Equal(t, time.Date(2019, time.March, 11, 9, 0, 0, 0, loc), window.Next(time.Date(2019, time.March, 10, 17, 30, 0, 0, loc)))
+}
+
+func TestDeliveryPolicy(t *testing.T) {
+	var scheduled, posted int
+
+	http.HandleFunc("/delivery/chat.scheduleMessage", func(w http.ResponseWriter, r *http.Request) {
+		scheduled++
+		assert.Equal(t, "1567414800", r.FormValue("post_at"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","scheduled_message_id":"Q1298393284","post_at":1567414800}`))
+	})
+	http.HandleFunc("/delivery/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		posted++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"1567400000.000100"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/delivery/"))
+	policy := NewDeliveryPolicy(
+		api,
+		NewWeekdayDeliveryWindow(9*time.Hour, 17*time.Hour),
+		DeliveryPolicyOptionTimezone(func(ctx context.Context, userID string) (*time.Location, error) {
+			return time.UTC, nil
+		}),
+	)
+	policy.now = func() time.Time { return time.Date(2019, time.September, 2, 7, 0, 0, 0, time.UTC) }
+
+	d, err := policy.PostMessage("U1", "C1", MsgOptionText("deploy finished", false))
+	assert.Nil(t, err)
+	assert.True(t, d.Scheduled())
+	assert.Equal(t, "Q1298393284", d.ScheduledMessageID)
+	assert.Equal(t, int64(1567414800), d.PostAt.Unix())
+
+	d, err = policy.Urgent("C1", MsgOptionText("production is down", false))
+	assert.Nil(t, err)
+	assert.False(t, d.Scheduled())
+	assert.Equal(t, "1567400000.000100", d.Timestamp)
+
+	assert.Equal(t, 1, scheduled)
+	assert.Equal(t, 1, posted)
+}
@@ -0,0 +1,89 @@
+package slack
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/websocket"
+)
+
+// DialContextFunc creates the network connections used by the client.
+type DialContextFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// OptionDialer creates every connection made by the client using the dialer, including the
+// websocket of the RTM connection. Set the dialer's LocalAddr to egress from an allow-listed
+// address or its Resolver to control name resolution. See OptionDialContext.
+func OptionDialer(d *net.Dialer) func(*Client) {
+	return OptionDialContext(d.DialContext)
+}
+
+// OptionDialContext creates every connection made by the client using the provided function,
+// e.g. to restrict connections to tcp6 on dual stack networks. Only applies to the default
+// http client or clients provided by OptionHTTPClient that are *http.Client using a *http.Transport.
+func OptionDialContext(fn DialContextFunc) func(*Client) {
+	return func(c *Client) {
+		c.dial = fn
+	}
+}
+
+// dialHTTPClient returns a copy of the client whose transport uses the dial function,
+// clients which cannot be configured are returned unchanged.
+func dialHTTPClient(client httpClient, dial DialContextFunc) (httpClient, bool) {
+	hc, ok := client.(*http.Client)
+	if !ok {
+		return client, false
+	}
+
+	var transport *http.Transport
+	switch t := hc.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return client, false
+	}
+
+	transport.DialContext = dial
+	dup := *hc
+	dup.Transport = transport
+
+	return &dup, true
+}
+
+// dialWebsocket returns a copy of the websocket dialer which uses the dial function,
+// dialers with their own NetDial are returned unchanged.
+func dialWebsocket(d *websocket.Dialer, dial DialContextFunc) *websocket.Dialer {
+	if dial == nil || d.NetDial != nil {
+		return d
+	}
+
+	dup := *d
+	dup.NetDial = func(network, address string) (net.Conn, error) {
+		return dial(context.Background(), network, address)
+	}
+
+	return &dup
+}
+
+// configureWebsocket returns a copy of the websocket dialer using the proxy and tls
+// configuration when provided, the dialer is returned unchanged otherwise.
+func configureWebsocket(d *websocket.Dialer, proxy func(*http.Request) (*url.URL, error), config *tls.Config) *websocket.Dialer {
+	if proxy == nil && config == nil {
+		return d
+	}
+
+	dup := *d
+	if proxy != nil {
+		dup.Proxy = proxy
+	}
+
+	if config != nil {
+		dup.TLSClientConfig = config
+	}
+
+	return &dup
+}
@@ -0,0 +1,60 @@
+package slack
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionDialContext(t *testing.T) {
+	var (
+		dials int32
+	)
+
+	http.HandleFunc("/dial/auth.test", okJSONHandler)
+
+	once.Do(startServer)
+	d := &net.Dialer{}
+	dial := func(ctx context.Context, network, address string) (net.Conn, error) {
+		atomic.AddInt32(&dials, 1)
+		return d.DialContext(ctx, network, address)
+	}
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/dial/"), OptionDialContext(dial))
+
+	_, err := api.AuthTest()
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&dials))
+
+	ws := dialWebsocket(websocket.DefaultDialer, api.dial)
+	assert.NotNil(t, ws.NetDial)
+	assert.Nil(t, websocket.DefaultDialer.NetDial)
+
+	// clients which cannot be configured are left as is.
+	custom := readOnlyClient{httpClient: &http.Client{}}
+	client, ok := dialHTTPClient(custom, dial)
+	assert.False(t, ok)
+	assert.Equal(t, custom, client)
+}
+
+func TestConfigureWebsocket(t *testing.T) {
+	assert.Equal(t, websocket.DefaultDialer, configureWebsocket(websocket.DefaultDialer, nil, nil))
+
+	config := &tls.Config{ServerName: "slack.com"}
+	proxy := http.ProxyURL(&url.URL{Scheme: "http", Host: "proxy.example.com:3128"})
+	d := configureWebsocket(websocket.DefaultDialer, proxy, config)
+
+	assert.Equal(t, config, d.TLSClientConfig)
+	u, err := d.Proxy(&http.Request{URL: &url.URL{Scheme: "wss", Host: "slack.com"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "proxy.example.com:3128", u.Host)
+
+	// the default dialer is not modified.
+	assert.Nil(t, websocket.DefaultDialer.TLSClientConfig)
+}
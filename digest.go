@@ -0,0 +1,195 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Digest defaults.
+const (
+	DefaultDigestInterval = time.Hour
+	DefaultDigestTitle    = "Digest"
+	DefaultDigestMaxItems = 20
+	DefaultDigestAttempts = 3
+)
+
+// DigestOption options for the Digest.
+type DigestOption func(*Digest)
+
+// DigestOptionInterval how often the digests are posted by Run, defaults to DefaultDigestInterval.
+func DigestOptionInterval(d time.Duration) DigestOption {
+	return func(t *Digest) {
+		t.interval = d
+	}
+}
+
+// DigestOptionTitle the header of the digest, defaults to DefaultDigestTitle.
+func DigestOptionTitle(title string) DigestOption {
+	return func(t *Digest) {
+		t.title = title
+	}
+}
+
+// DigestOptionMaxItems maximum number of items listed in a single digest, remaining items
+// are summarized by count. defaults to DefaultDigestMaxItems.
+func DigestOptionMaxItems(n int) DigestOption {
+	return func(t *Digest) {
+		t.max = n
+	}
+}
+
+// DigestOptionAttempts the number of flushes a digest is attempted before its notifications
+// are discarded, e.g. the channel was archived. defaults to DefaultDigestAttempts.
+func DigestOptionAttempts(n int) DigestOption {
+	return func(t *Digest) {
+		t.attempts = n
+	}
+}
+
+// NewDigest builds a Digest.
+func NewDigest(api *Client, options ...DigestOption) *Digest {
+	d := &Digest{
+		api:      api,
+		interval: DefaultDigestInterval,
+		title:    DefaultDigestTitle,
+		max:      DefaultDigestMaxItems,
+		attempts: DefaultDigestAttempts,
+		pending:  make(map[string][]string),
+		failures: make(map[string]int),
+	}
+
+	for _, opt := range options {
+		opt(d)
+	}
+
+	return d
+}
+
+// Digest buffers notifications and posts them as a single message per channel,
+// reducing notification noise.
+type Digest struct {
+	api      *Client
+	interval time.Duration
+	title    string
+	max      int
+	attempts int
+	m        sync.Mutex
+	order    []string
+	pending  map[string][]string
+	failures map[string]int
+}
+
+// Add a notification to the next digest for the channel, text is formatted as mrkdwn.
+func (t *Digest) Add(channelID, text string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if _, ok := t.pending[channelID]; !ok {
+		t.order = append(t.order, channelID)
+	}
+
+	t.pending[channelID] = append(t.pending[channelID], text)
+}
+
+// Run posts the pending digests every interval until the context is cancelled,
+// any remaining notifications are posted before returning.
+func (t *Digest) Run(ctx context.Context) error {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// the context is already cancelled, use a fresh one for the final flush.
+			return t.FlushContext(context.Background())
+		case <-ticker.C:
+			if err := t.FlushContext(ctx); err != nil {
+				t.api.Debugf("digest flush failed: %s", err)
+			}
+		}
+	}
+}
+
+// Flush see FlushContext.
+func (t *Digest) Flush() error {
+	return t.FlushContext(context.Background())
+}
+
+// FlushContext posts the pending digests with a custom context. Notifications for channels
+// which failed to post are retained for the next flush until the digest has been attempted
+// the configured number of times (see DigestOptionAttempts), the first error is returned.
+func (t *Digest) FlushContext(ctx context.Context) (err error) {
+	t.m.Lock()
+	order, pending := t.order, t.pending
+	t.order, t.pending = nil, make(map[string][]string)
+	t.m.Unlock()
+
+	for _, channelID := range order {
+		items := pending[channelID]
+		_, _, cause := t.api.PostMessageContext(ctx, channelID, t.render(items)...)
+		t.attempted(channelID, items, cause)
+		if err == nil {
+			err = cause
+		}
+	}
+
+	return err
+}
+
+// attempted records the outcome of posting the digest, failed digests are requeued
+// until they run out of attempts.
+func (t *Digest) attempted(channelID string, items []string, cause error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if cause == nil {
+		delete(t.failures, channelID)
+		return
+	}
+
+	if t.failures[channelID]++; t.failures[channelID] >= t.attempts {
+		delete(t.failures, channelID)
+		t.api.Debugf("digest for %s discarded %d notifications after %d attempts: %s", channelID, len(items), t.attempts, cause)
+		return
+	}
+
+	if _, ok := t.pending[channelID]; !ok {
+		t.order = append(t.order, channelID)
+	}
+
+	t.pending[channelID] = append(items, t.pending[channelID]...)
+}
+
+func (t *Digest) render(items []string) []MsgOption {
+	listed := items
+	if t.max > 0 && len(listed) > t.max {
+		listed = listed[:t.max]
+	}
+
+	summary := fmt.Sprintf("%d updates", len(items))
+	if len(items) == 1 {
+		summary = "1 update"
+	}
+
+	blocks := make([]Block, 0, len(listed)+3)
+	blocks = append(
+		blocks,
+		NewHeaderBlock(PlainText(Truncate(t.title, HeaderTextMaxLength), false)),
+		NewContextBlock("", Mrkdwn(summary)),
+	)
+
+	for _, item := range listed {
+		blocks = append(blocks, NewSectionBlock(Mrkdwn(Truncate(item, SectionTextMaxLength)), nil, nil))
+	}
+
+	if remaining := len(items) - len(listed); remaining > 0 {
+		blocks = append(blocks, NewContextBlock("", Mrkdwn(fmt.Sprintf("and %d more", remaining))))
+	}
+
+	return []MsgOption{
+		MsgOptionText(fmt.Sprintf("%s: %s", t.title, summary), false),
+		MsgOptionBlocks(blocks...),
+	}
+}
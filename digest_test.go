@@ -0,0 +1,74 @@
+package slack
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigestFlush(t *testing.T) {
+	var (
+		fail   = true
+		posted = map[string]Blocks{}
+	)
+
+	http.HandleFunc("/digest/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if fail && r.FormValue("channel") == "C2" {
+			w.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+			return
+		}
+
+		var blocks Blocks
+		assert.Nil(t, json.Unmarshal([]byte(r.FormValue("blocks")), &blocks))
+		posted[r.FormValue("channel")] = blocks
+		w.Write([]byte(`{"ok":true,"channel":"` + r.FormValue("channel") + `","ts":"1.0"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/digest/"))
+	d := NewDigest(api, DigestOptionTitle("Deploys"), DigestOptionMaxItems(2))
+
+	d.Add("C1", "api deployed")
+	d.Add("C1", "web deployed")
+	d.Add("C1", "worker deployed")
+	d.Add("C2", "db migrated")
+
+	assert.NotNil(t, d.Flush())
+
+	// header, summary, two items and the remainder.
+	assert.Equal(t, 5, len(posted["C1"].BlockSet))
+	assert.Equal(t, "Deploys", posted["C1"].BlockSet[0].(*HeaderBlock).Text.Text)
+	assert.Equal(t, "api deployed", posted["C1"].BlockSet[2].(*SectionBlock).Text.Text)
+
+	// failed channels are retried on the next flush.
+	fail = false
+	delete(posted, "C1")
+	assert.Nil(t, d.Flush())
+	assert.Equal(t, 3, len(posted["C2"].BlockSet))
+	_, ok := posted["C1"]
+	assert.False(t, ok)
+}
+
+func TestDigestAttempts(t *testing.T) {
+	var calls int
+	http.HandleFunc("/digestattempts/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":false,"error":"is_archived"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/digestattempts/"))
+	d := NewDigest(api, DigestOptionAttempts(2))
+
+	d.Add("C1", "api deployed")
+	assert.NotNil(t, d.Flush())
+	assert.NotNil(t, d.Flush())
+
+	// the notifications are discarded once the attempts are exhausted.
+	assert.Nil(t, d.Flush())
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,246 @@
+package slack
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+)
+
+// Encrypter encrypts data before it is persisted by a store and decrypts it when loaded,
+// see NewEncryptedConfigStore, NewEncryptedMessageStore, and NewEncryptedMirrorStore.
+// NonceStores are not covered, nonces are request signatures rather than secrets
+// and must be compared as is.
+type Encrypter interface {
+	Encrypt(plaintext []byte) ([]byte, error)
+	Decrypt(ciphertext []byte) ([]byte, error)
+}
+
+// NewAESEncrypter encrypts data using AES-GCM, the key must be 16, 24, or 32 bytes
+// to select AES-128, AES-192, or AES-256.
+func NewAESEncrypter(key []byte) (AESEncrypter, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return AESEncrypter{}, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return AESEncrypter{}, err
+	}
+
+	return AESEncrypter{aead: aead}, nil
+}
+
+// AESEncrypter AES-GCM implementation of the Encrypter, a random nonce is
+// generated for every call to Encrypt and prefixed to the ciphertext.
+type AESEncrypter struct {
+	aead cipher.AEAD
+}
+
+// Encrypt the plaintext.
+func (t AESEncrypter) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, t.aead.NonceSize(), t.aead.NonceSize()+len(plaintext)+t.aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return t.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt the ciphertext.
+func (t AESEncrypter) Decrypt(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < t.aead.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+
+	nonce, sealed := ciphertext[:t.aead.NonceSize()], ciphertext[t.aead.NonceSize():]
+	return t.aead.Open(nil, nonce, sealed, nil)
+}
+
+// NewEncryptedConfigStore encrypts the values of the channel settings before
+// they are saved by the underlying store and decrypts them when loaded. keys
+// are stored as is.
+func NewEncryptedConfigStore(store ChannelConfigStore, enc Encrypter) EncryptedConfigStore {
+	return EncryptedConfigStore{
+		store: store,
+		enc:   enc,
+	}
+}
+
+// EncryptedConfigStore ChannelConfigStore which transparently encrypts the settings.
+type EncryptedConfigStore struct {
+	store ChannelConfigStore
+	enc   Encrypter
+}
+
+// Load the settings for the channel.
+func (t EncryptedConfigStore) Load(ctx context.Context, channelID string) (map[string]string, error) {
+	settings, err := t.store.Load(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := make(map[string]string, len(settings))
+	for k, v := range settings {
+		if decrypted[k], err = decryptString(t.enc, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return decrypted, nil
+}
+
+// Save the settings for the channel.
+func (t EncryptedConfigStore) Save(ctx context.Context, channelID string, settings map[string]string) (err error) {
+	encrypted := make(map[string]string, len(settings))
+	for k, v := range settings {
+		if encrypted[k], err = encryptString(t.enc, v); err != nil {
+			return err
+		}
+	}
+
+	return t.store.Save(ctx, channelID, encrypted)
+}
+
+// NewEncryptedMessageStore encrypts the messages before they are appended to the
+// underlying store and decrypts them when retrieved. only the channel and timestamp
+// of each message are stored as is, allowing the underlying store to index them.
+func NewEncryptedMessageStore(store MessageStore, enc Encrypter) EncryptedMessageStore {
+	return EncryptedMessageStore{
+		store: store,
+		enc:   enc,
+	}
+}
+
+// EncryptedMessageStore MessageStore which transparently encrypts the messages.
+type EncryptedMessageStore struct {
+	store MessageStore
+	enc   Encrypter
+}
+
+// Append implements the MessageStore interface.
+func (t EncryptedMessageStore) Append(m Message) error {
+	encoded, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := encryptString(t.enc, string(encoded))
+	if err != nil {
+		return err
+	}
+
+	return t.store.Append(Message{
+		Msg: Msg{
+			Channel:   m.Channel,
+			Timestamp: m.Timestamp,
+			Text:      encrypted,
+		},
+	})
+}
+
+// Get implements the MessageStore interface.
+func (t EncryptedMessageStore) Get(channelID, timestamp string) (Message, bool, error) {
+	return t.decrypt(t.store.Get(channelID, timestamp))
+}
+
+// Latest implements the MessageStore interface.
+func (t EncryptedMessageStore) Latest(channelID string) (Message, bool, error) {
+	return t.decrypt(t.store.Latest(channelID))
+}
+
+// Delete implements the MessageStore interface.
+func (t EncryptedMessageStore) Delete(channelID, timestamp string) error {
+	return t.store.Delete(channelID, timestamp)
+}
+
+func (t EncryptedMessageStore) decrypt(m Message, ok bool, err error) (Message, bool, error) {
+	if err != nil || !ok {
+		return Message{}, ok, err
+	}
+
+	decrypted, err := decryptString(t.enc, m.Text)
+	if err != nil {
+		return Message{}, false, err
+	}
+
+	var original Message
+	if err = json.Unmarshal([]byte(decrypted), &original); err != nil {
+		return Message{}, false, err
+	}
+
+	return original, true, nil
+}
+
+// NewEncryptedMirrorStore encrypts the timestamps of the mirrored messages before
+// they are saved by the underlying store and decrypts them when loaded. keys
+// are stored as is.
+func NewEncryptedMirrorStore(store MirrorStore, enc Encrypter) EncryptedMirrorStore {
+	return EncryptedMirrorStore{
+		store: store,
+		enc:   enc,
+	}
+}
+
+// EncryptedMirrorStore MirrorStore which transparently encrypts the timestamps.
+type EncryptedMirrorStore struct {
+	store MirrorStore
+	enc   Encrypter
+}
+
+// Save implements the MirrorStore interface.
+func (t EncryptedMirrorStore) Save(ctx context.Context, key, timestamp string) error {
+	encrypted, err := encryptString(t.enc, timestamp)
+	if err != nil {
+		return err
+	}
+
+	return t.store.Save(ctx, key, encrypted)
+}
+
+// Load implements the MirrorStore interface.
+func (t EncryptedMirrorStore) Load(ctx context.Context, key string) (string, bool, error) {
+	encrypted, ok, err := t.store.Load(ctx, key)
+	if err != nil || !ok {
+		return "", ok, err
+	}
+
+	timestamp, err := decryptString(t.enc, encrypted)
+	if err != nil {
+		return "", false, err
+	}
+
+	return timestamp, true, nil
+}
+
+// Delete implements the MirrorStore interface.
+func (t EncryptedMirrorStore) Delete(ctx context.Context, key string) error {
+	return t.store.Delete(ctx, key)
+}
+
+func encryptString(enc Encrypter, s string) (string, error) {
+	encrypted, err := enc.Encrypt([]byte(s))
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+func decryptString(enc Encrypter, s string) (string, error) {
+	encrypted, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+
+	decrypted, err := enc.Decrypt(encrypted)
+	if err != nil {
+		return "", err
+	}
+
+	return string(decrypted), nil
+}
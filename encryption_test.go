@@ -0,0 +1,116 @@
+package slack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAESEncrypter(t *testing.T) {
+	_, err := NewAESEncrypter([]byte("short"))
+	assert.NotNil(t, err)
+
+	enc, err := NewAESEncrypter([]byte("0123456789abcdef0123456789abcdef"))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	a, err := enc.Encrypt([]byte("xoxb-secret"))
+	assert.Nil(t, err)
+	b, err := enc.Encrypt([]byte("xoxb-secret"))
+	assert.Nil(t, err)
+	assert.NotEqual(t, a, b)
+
+	decrypted, err := enc.Decrypt(a)
+	assert.Nil(t, err)
+	assert.Equal(t, "xoxb-secret", string(decrypted))
+
+	_, err = enc.Decrypt([]byte("x"))
+	assert.Equal(t, ErrInvalidCiphertext, err)
+}
+
+func TestEncryptedConfigStore(t *testing.T) {
+	ctx := context.Background()
+	enc, err := NewAESEncrypter([]byte("0123456789abcdef"))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	backing := NewMemoryConfigStore()
+	cfg := NewChannelConfig(NewEncryptedConfigStore(backing, enc))
+
+	assert.Nil(t, cfg.Set(ctx, "C1", "token", "xoxb-secret"))
+
+	raw, err := backing.Load(ctx, "C1")
+	assert.Nil(t, err)
+	assert.NotEqual(t, "xoxb-secret", raw["token"])
+	assert.NotEmpty(t, raw["token"])
+
+	v, ok, err := cfg.Get(ctx, "C1", "token")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "xoxb-secret", v)
+}
+
+func TestEncryptedMessageStore(t *testing.T) {
+	enc, err := NewAESEncrypter([]byte("0123456789abcdef"))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	backing := NewMemoryMessageStore(10)
+	store := NewEncryptedMessageStore(backing, enc)
+
+	m := Message{Msg: Msg{Channel: "C1", Timestamp: "1.0", User: "U1", Text: "secret"}}
+	assert.Nil(t, store.Append(m))
+
+	raw, ok, err := backing.Get("C1", "1.0")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.NotContains(t, raw.Text, "secret")
+	assert.Empty(t, raw.User)
+
+	found, ok, err := store.Get("C1", "1.0")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, m, found)
+
+	latest, ok, err := store.Latest("C1")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, m, latest)
+
+	assert.Nil(t, store.Delete("C1", "1.0"))
+	_, ok, err = store.Get("C1", "1.0")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestEncryptedMirrorStore(t *testing.T) {
+	ctx := context.Background()
+	enc, err := NewAESEncrypter([]byte("0123456789abcdef"))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	backing := NewMemoryMirrorStore()
+	store := NewEncryptedMirrorStore(backing, enc)
+
+	assert.Nil(t, store.Save(ctx, "C1:1.0", "2.0"))
+
+	raw, ok, err := backing.Load(ctx, "C1:1.0")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.NotEqual(t, "2.0", raw)
+
+	ts, ok, err := store.Load(ctx, "C1:1.0")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "2.0", ts)
+
+	assert.Nil(t, store.Delete(ctx, "C1:1.0"))
+	_, ok, err = store.Load(ctx, "C1:1.0")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
@@ -4,12 +4,22 @@ import "github.com/nlopes/slack/internal/errorsx"
 
 // Errors returned by various methods.
 const (
-	ErrAlreadyDisconnected  = errorsx.String("Invalid call to Disconnect - Slack API is already disconnected")
-	ErrRTMDisconnected      = errorsx.String("disconnect received while trying to connect")
-	ErrParametersMissing    = errorsx.String("received empty parameters")
-	ErrInvalidConfiguration = errorsx.String("invalid configuration")
-	ErrMissingHeaders       = errorsx.String("missing headers")
-	ErrExpiredTimestamp     = errorsx.String("timestamp is too old")
+	ErrAlreadyDisconnected   = errorsx.String("Invalid call to Disconnect - Slack API is already disconnected")
+	ErrRTMDisconnected       = errorsx.String("disconnect received while trying to connect")
+	ErrParametersMissing     = errorsx.String("received empty parameters")
+	ErrInvalidConfiguration  = errorsx.String("invalid configuration")
+	ErrMissingHeaders        = errorsx.String("missing headers")
+	ErrExpiredTimestamp      = errorsx.String("timestamp is too old")
+	ErrReplayedRequest       = errorsx.String("request has already been processed")
+	ErrDuplicateNotification = errorsx.String("identical notification was recently sent")
+	ErrUnacknowledged        = errorsx.String("escalation was not acknowledged")
+	ErrReadOnly              = errorsx.String("client is read only")
+	ErrStopIteration         = errorsx.String("iteration stopped")
+	ErrInvalidCiphertext     = errorsx.String("invalid ciphertext")
+	ErrChannelNotFound       = errorsx.String("channel_not_found")
+	ErrUserNotFound          = errorsx.String("user_not_found")
+	ErrAckTimeout            = errorsx.String("timed out waiting for the message to be acknowledged")
+	ErrTooManySubscriptions  = errorsx.String("too many presence subscriptions")
 )
 
 // internal errors
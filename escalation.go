@@ -0,0 +1,160 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// EscalationStep a single stage of an escalation. ChannelID may be a channel or
+// a user ID, in which case the alert is sent as a direct message.
+type EscalationStep struct {
+	ChannelID string
+	Timeout   time.Duration
+}
+
+// EscalatorOption options for the Escalator.
+type EscalatorOption func(*Escalator)
+
+// EscalatorOptionReactions the reactions which acknowledge an alert, defaults to white_check_mark.
+func EscalatorOptionReactions(names ...string) EscalatorOption {
+	return func(e *Escalator) {
+		e.reactions = names
+	}
+}
+
+// EscalatorOptionUsers restricts which users are able to acknowledge an alert via reactions,
+// by default any user may acknowledge.
+func EscalatorOptionUsers(userIDs ...string) EscalatorOption {
+	return func(e *Escalator) {
+		e.users = userIDs
+	}
+}
+
+// NewEscalator builds an Escalator for on-call style alerting.
+func NewEscalator(api *Client, options ...EscalatorOption) *Escalator {
+	e := &Escalator{
+		api:       api,
+		reactions: []string{"white_check_mark"},
+		pending:   make(map[string]chan struct{}),
+	}
+
+	for _, opt := range options {
+		opt(e)
+	}
+
+	return e
+}
+
+// Escalator posts an alert and waits for it to be acknowledged, moving on to the
+// next step when the alert is ignored. Alerts are acknowledged by reacting to the
+// message, pass the reaction_added events received from the RTM or the events api
+// to HandleReaction, or by calling Acknowledge, usually from a button's block action handler.
+type Escalator struct {
+	api       *Client
+	reactions []string
+	users     []string
+	m         sync.Mutex
+	pending   map[string]chan struct{}
+}
+
+// Escalation describes the message that was acknowledged, or the final message
+// sent when no step was acknowledged.
+type Escalation struct {
+	Channel      string
+	Timestamp    string
+	Step         int
+	Acknowledged bool
+}
+
+// Escalate see EscalateContext.
+func (t *Escalator) Escalate(steps []EscalationStep, options ...MsgOption) (Escalation, error) {
+	return t.EscalateContext(context.Background(), steps, options...)
+}
+
+// EscalateContext posts the message to each step in turn with a custom context, stopping
+// once the message is acknowledged. Returns ErrUnacknowledged if every step times out.
+func (t *Escalator) EscalateContext(ctx context.Context, steps []EscalationStep, options ...MsgOption) (e Escalation, err error) {
+	for idx, step := range steps {
+		e = Escalation{Step: idx}
+		if e.Channel, e.Timestamp, err = t.api.PostMessageContext(ctx, step.ChannelID, options...); err != nil {
+			return e, err
+		}
+
+		if e.Acknowledged, err = t.await(ctx, e.Channel, e.Timestamp, step.Timeout); err != nil || e.Acknowledged {
+			return e, err
+		}
+
+		t.api.Debugf("escalation step %d (%s) was not acknowledged within %s", idx, step.ChannelID, step.Timeout)
+	}
+
+	return e, ErrUnacknowledged
+}
+
+// Acknowledge resolves the escalation waiting on the message, returns false
+// if no escalation is waiting on it.
+func (t *Escalator) Acknowledge(channelID, timestamp string) bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	key := channelID + ":" + timestamp
+	ack, ok := t.pending[key]
+	if ok {
+		close(ack)
+		delete(t.pending, key)
+	}
+
+	return ok
+}
+
+// HandleReaction acknowledges the escalation waiting on the reacted message when the
+// reaction and the user are permitted, returns false otherwise. removing the reaction
+// does not revoke the acknowledgement.
+func (t *Escalator) HandleReaction(ev *ReactionAddedEvent) bool {
+	if !containsString(t.reactions, ev.Reaction) {
+		return false
+	}
+
+	if len(t.users) > 0 && !containsString(t.users, ev.User) {
+		return false
+	}
+
+	return t.Acknowledge(ev.Item.Channel, ev.Item.Timestamp)
+}
+
+func (t *Escalator) await(ctx context.Context, channelID, timestamp string, timeout time.Duration) (bool, error) {
+	key := channelID + ":" + timestamp
+	ack := make(chan struct{})
+
+	t.m.Lock()
+	t.pending[key] = ack
+	t.m.Unlock()
+
+	defer func() {
+		t.m.Lock()
+		delete(t.pending, key)
+		t.m.Unlock()
+	}()
+
+	expired := time.NewTimer(timeout)
+	defer expired.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case <-ack:
+		return true, nil
+	case <-expired.C:
+		return false, nil
+	}
+}
+
+func containsString(set []string, s string) bool {
+	for _, v := range set {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,101 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscalatorEscalates(t *testing.T) {
+	posted := make(chan string, 10)
+	http.HandleFunc("/escalation/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		channel := r.FormValue("channel")
+		if channel == "U1" {
+			channel = "D1"
+		}
+		posted <- channel
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"` + channel + `","ts":"123.456"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/escalation/"))
+	e := NewEscalator(api, EscalatorOptionUsers("U1"))
+
+	// the first step is never acknowledged, the second waits for the reaction.
+	steps := []EscalationStep{
+		{ChannelID: "C1", Timeout: 10 * time.Millisecond},
+		{ChannelID: "U1", Timeout: 5 * time.Second},
+	}
+
+	type escalated struct {
+		result Escalation
+		err    error
+	}
+
+	done := make(chan escalated, 1)
+	go func() {
+		result, err := e.Escalate(steps, MsgOptionText("database is down", false))
+		done <- escalated{result: result, err: err}
+	}()
+
+	for _, channel := range []string{"C1", "D1"} {
+		select {
+		case c := <-posted:
+			assert.Equal(t, channel, c)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the alert to be posted to %s", channel)
+		}
+	}
+
+	for !escalationWaiting(e, "D1", "123.456") {
+		time.Sleep(time.Millisecond)
+	}
+
+	// only the permitted reaction from a permitted user acknowledges the alert.
+	assert.False(t, e.HandleReaction(&ReactionAddedEvent{User: "U1", Reaction: "eyes", Item: reactionItem{Channel: "D1", Timestamp: "123.456"}}))
+	assert.False(t, e.HandleReaction(&ReactionAddedEvent{User: "U2", Reaction: "white_check_mark", Item: reactionItem{Channel: "D1", Timestamp: "123.456"}}))
+	assert.True(t, e.HandleReaction(&ReactionAddedEvent{User: "U1", Reaction: "white_check_mark", Item: reactionItem{Channel: "D1", Timestamp: "123.456"}}))
+
+	select {
+	case r := <-done:
+		assert.Nil(t, r.err)
+		assert.True(t, r.result.Acknowledged)
+		assert.Equal(t, 1, r.result.Step)
+		assert.Equal(t, "D1", r.result.Channel)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the escalation to be acknowledged")
+	}
+
+	_, err := e.Escalate(steps[:1], MsgOptionText("database is down", false))
+	assert.Equal(t, ErrUnacknowledged, err)
+}
+
+func TestEscalatorAcknowledge(t *testing.T) {
+	e := NewEscalator(New("testing-token"))
+	assert.False(t, e.Acknowledge("C1", "123.456"))
+
+	done := make(chan bool)
+	go func() {
+		ok, _ := e.await(context.Background(), "C1", "123.456", time.Second)
+		done <- ok
+	}()
+
+	for !e.Acknowledge("C1", "123.456") {
+		time.Sleep(time.Millisecond)
+	}
+
+	assert.True(t, <-done)
+}
+
+// escalationWaiting reports whether an escalation is waiting on the message.
+func escalationWaiting(e *Escalator, channelID, timestamp string) bool {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	_, ok := e.pending[channelID+":"+timestamp]
+	return ok
+}
@@ -0,0 +1,259 @@
+// Command scaffold generates a runnable skeleton of a slack app wiring the client, the events api
+// handler, the interaction handler, the oauth installer and the RTM with the chosen options.
+//
+// Usage:
+//
+//	go run github.com/nlopes/slack/examples/scaffold -out ./bot -events -interactions
+//
+// or from a go:generate directive:
+//
+//	//go:generate go run github.com/nlopes/slack/examples/scaffold -out . -events -install
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+type config struct {
+	Package      string
+	Addr         string
+	Events       bool
+	Interactions bool
+	Install      bool
+	RTM          bool
+	Retries      int
+	UserAgent    string
+}
+
+// HTTP reports whether the app serves http requests.
+func (t config) HTTP() bool {
+	return t.Events || t.Interactions || t.Install
+}
+
+// Client reports whether the app calls the slack api, installation only apps exchange the
+// oauth code without a token.
+func (t config) Client() bool {
+	return t.Events || t.Interactions || t.RTM
+}
+
+// Verified reports whether the app receives requests signed by slack.
+func (t config) Verified() bool {
+	return t.Events || t.Interactions
+}
+
+func main() {
+	var (
+		out    string
+		force  bool
+		config config
+	)
+
+	flag.StringVar(&out, "out", ".", "directory the skeleton is written to")
+	flag.BoolVar(&force, "force", false, "overwrite an existing main.go")
+	flag.StringVar(&config.Package, "package", "main", "package of the generated file")
+	flag.StringVar(&config.Addr, "addr", ":3000", "address the app listens on")
+	flag.BoolVar(&config.Events, "events", false, "handle events api requests on /slack/events")
+	flag.BoolVar(&config.Interactions, "interactions", false, "handle interactions on /slack/interactions")
+	flag.BoolVar(&config.Install, "install", false, "handle oauth installations on /slack/install")
+	flag.BoolVar(&config.RTM, "rtm", false, "receive events over an RTM connection")
+	flag.IntVar(&config.Retries, "retries", 3, "number of times failed api requests are retried")
+	flag.StringVar(&config.UserAgent, "user-agent", "", "identifies the app in the user agent of requests")
+	flag.Parse()
+
+	if !config.HTTP() && !config.RTM {
+		log.Fatalln("at least one of -events, -interactions, -install or -rtm is required")
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := skeleton.Execute(buf, config); err != nil {
+		log.Fatalln("failed to generate the skeleton:", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalln("failed to format the skeleton:", err)
+	}
+
+	path := filepath.Join(out, "main.go")
+	if _, err := os.Stat(path); err == nil && !force {
+		log.Fatalln(path, "already exists, use -force to overwrite it")
+	}
+
+	if err = os.MkdirAll(out, 0755); err != nil {
+		log.Fatalln("failed to create the output directory:", err)
+	}
+
+	if err = ioutil.WriteFile(path, formatted, 0644); err != nil {
+		log.Fatalln("failed to write the skeleton:", err)
+	}
+
+	log.Println("generated", path)
+}
+
+var skeleton = template.Must(template.New("main.go").Parse(`// Code generated by github.com/nlopes/slack/examples/scaffold, edit as needed.
+
+package {{ .Package }}
+
+import (
+	{{- if .Verified }}
+	"bytes"
+	"context"
+	"io/ioutil"
+	{{- end }}
+	"log"
+	{{- if .HTTP }}
+	"net/http"
+	{{- end }}
+	"os"
+
+	"github.com/nlopes/slack"
+	{{- if .Events }}
+	"github.com/nlopes/slack/slackevents"
+	{{- end }}
+)
+
+func main() {
+	logger := log.New(os.Stderr, "app: ", log.LstdFlags|log.Lshortfile)
+	{{- if .Client }}
+	api := slack.New(
+		os.Getenv("SLACK_TOKEN"),
+		slack.OptionLog(logger),
+		slack.OptionRetry({{ .Retries }}),
+		{{- if .UserAgent }}
+		slack.OptionUserAgent({{ printf "%q" .UserAgent }}),
+		{{- end }}
+	)
+	{{- end }}
+	{{- if .RTM }}
+
+	rtm := api.NewRTM()
+	go rtm.ManageConnection()
+	go handleRTM(rtm, logger)
+	{{- end }}
+	{{- if .HTTP }}
+
+	mux := http.NewServeMux()
+	{{- if .Events }}
+	mux.Handle("/slack/events", verify(os.Getenv("SLACK_SIGNING_SECRET"), slackevents.NewHandler(
+		func(ctx context.Context, event slackevents.EventsAPIEvent) error {
+			return handleEvent(ctx, api, event)
+		},
+		slackevents.OptionNoVerifyToken(), // requests are verified by their signature.
+	)))
+	{{- end }}
+	{{- if .Interactions }}
+	mux.Handle("/slack/interactions", verify(os.Getenv("SLACK_SIGNING_SECRET"), slack.NewInteractionHandler(
+		func(ctx context.Context, cb slack.InteractionCallback) (interface{}, error) {
+			return nil, handleInteraction(ctx, api, cb)
+		},
+	)))
+	{{- end }}
+	{{- if .Install }}
+	mux.HandleFunc("/slack/install", install(os.Getenv("SLACK_CLIENT_ID"), os.Getenv("SLACK_CLIENT_SECRET"), logger))
+	{{- end }}
+
+	logger.Println("listening on {{ .Addr }}")
+	logger.Fatalln(http.ListenAndServe({{ printf "%q" .Addr }}, mux))
+	{{- else }}
+
+	select {}
+	{{- end }}
+}
+{{- if .Verified }}
+
+// verify rejects requests which are not signed by slack.
+func verify(secret string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		sv, err := slack.NewSecretsVerifier(r.Header, secret)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if _, err = sv.Write(body); err != nil || sv.Ensure() != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
+{{- end }}
+{{- if .Events }}
+
+// handleEvent handles the events api events, returning an error prompts slack to retry the delivery.
+func handleEvent(ctx context.Context, api *slack.Client, event slackevents.EventsAPIEvent) error {
+	switch ev := event.InnerEvent.Data.(type) {
+	case *slackevents.AppMentionEvent:
+		_, _, err := api.PostMessageContext(ctx, ev.Channel, slack.MsgOptionText("Yes, hello.", false))
+		return err
+	}
+
+	return nil
+}
+{{- end }}
+{{- if .Interactions }}
+
+// handleInteraction handles button clicks, modal submissions and other interactions.
+func handleInteraction(ctx context.Context, api *slack.Client, cb slack.InteractionCallback) error {
+	switch cb.Type {
+	case slack.InteractionTypeBlockActions:
+		for _, action := range cb.ActionCallback.BlockActions {
+			log.Println("action", action.ActionID, "by", cb.User.ID)
+		}
+	case slack.InteractionTypeViewSubmission:
+		log.Println("submitted", cb.View.CallbackID, "by", cb.User.ID)
+	}
+
+	return nil
+}
+{{- end }}
+{{- if .Install }}
+
+// install completes the oauth flow when the app is installed to a workspace.
+func install(clientID, clientSecret string, logger *log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := slack.GetOAuthResponseContext(r.Context(), http.DefaultClient, clientID, clientSecret, r.FormValue("code"), "")
+		if err != nil {
+			logger.Println("installation failed:", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// store the tokens of the team, e.g. in a database keyed by the team id.
+		logger.Println("installed to", resp.TeamID, resp.TeamName)
+		w.Write([]byte("installed"))
+	}
+}
+{{- end }}
+{{- if .RTM }}
+
+// handleRTM handles the events received over the RTM connection.
+func handleRTM(rtm *slack.RTM, logger *log.Logger) {
+	for msg := range rtm.IncomingEvents {
+		switch ev := msg.Data.(type) {
+		case *slack.ConnectedEvent:
+			logger.Println("connected, connection count:", ev.ConnectionCount)
+		case *slack.MessageEvent:
+			logger.Println("message", ev.Channel, ev.Text)
+		case *slack.InvalidAuthEvent:
+			logger.Fatalln("invalid credentials")
+		}
+	}
+}
+{{- end }}
+`))
@@ -0,0 +1,131 @@
+package slack
+
+import (
+	"context"
+)
+
+// DeliveryPath how a message was delivered by PostMessageWithFallback.
+type DeliveryPath string
+
+// Delivery paths.
+const (
+	DeliveryChannel   DeliveryPath = "channel"
+	DeliveryEphemeral DeliveryPath = "ephemeral"
+	DeliveryDM        DeliveryPath = "dm"
+)
+
+// DefaultFallbackErrors the chat.postMessage errors which result in a fallback delivery, the
+// app is unable to post to the channel but may still be able to reach the user.
+var DefaultFallbackErrors = []string{
+	"not_in_channel",
+	"restricted_action",
+	"restricted_action_read_only_channel",
+	"restricted_action_thread_only_channel",
+	"restricted_action_non_threadable_channel",
+}
+
+// FallbackOption options for PostMessageWithFallback.
+type FallbackOption func(*fallbackConfig)
+
+// FallbackOptionPaths the paths attempted in order when posting to the channel fails,
+// defaults to an ephemeral message followed by a direct message. no paths disables the fallback.
+func FallbackOptionPaths(paths ...DeliveryPath) FallbackOption {
+	return func(c *fallbackConfig) {
+		c.paths = paths
+	}
+}
+
+// FallbackOptionErrors the errors which trigger the fallback, replaces DefaultFallbackErrors.
+func FallbackOptionErrors(codes ...string) FallbackOption {
+	return func(c *fallbackConfig) {
+		c.errors = make(map[string]bool, len(codes))
+		for _, code := range codes {
+			c.errors[code] = true
+		}
+	}
+}
+
+type fallbackConfig struct {
+	paths  []DeliveryPath
+	errors map[string]bool
+}
+
+// FallbackDelivery the outcome of PostMessageWithFallback.
+type FallbackDelivery struct {
+	// Path the path which delivered the message.
+	Path      DeliveryPath
+	Channel   string
+	Timestamp string
+	// Cause the error posting to the channel which triggered the fallback, nil when the
+	// message was delivered to the channel.
+	Cause error
+}
+
+// PostMessageWithFallback posts the message to the channel, see PostMessageWithFallbackContext.
+func (api *Client) PostMessageWithFallback(channelID, userID string, message []MsgOption, options ...FallbackOption) (FallbackDelivery, error) {
+	return api.PostMessageWithFallbackContext(context.Background(), channelID, userID, message, options...)
+}
+
+// PostMessageWithFallbackContext posts the message to the channel with a custom context. When the
+// app cannot post to the channel (see DefaultFallbackErrors) the message is delivered to the user
+// via the fallback paths in order, e.g. as an ephemeral message in the channel or a direct message.
+// Ephemeral messages are skipped when the app is not in the channel and direct messages are sent
+// outside of any thread.
+// Other errors are returned without attempting a fallback. The returned delivery describes which
+// path succeeded, when every path fails the error of the last path is returned.
+func (api *Client) PostMessageWithFallbackContext(ctx context.Context, channelID, userID string, message []MsgOption, options ...FallbackOption) (d FallbackDelivery, err error) {
+	config := fallbackConfig{
+		paths: []DeliveryPath{DeliveryEphemeral, DeliveryDM},
+	}
+	FallbackOptionErrors(DefaultFallbackErrors...)(&config)
+
+	for _, opt := range options {
+		opt(&config)
+	}
+
+	d.Path = DeliveryChannel
+	if d.Channel, d.Timestamp, err = api.PostMessageContext(ctx, channelID, message...); err == nil {
+		return d, nil
+	}
+
+	if userID == "" || !config.errors[err.Error()] {
+		return d, err
+	}
+
+	d.Cause = err
+	for _, path := range config.paths {
+		switch path {
+		case DeliveryEphemeral:
+			// ephemeral messages also require the app to be a member of the channel.
+			if d.Cause.Error() == "not_in_channel" {
+				continue
+			}
+
+			d.Path, d.Channel = path, channelID
+			d.Timestamp, err = api.PostEphemeralContext(ctx, channelID, userID, message...)
+		case DeliveryDM:
+			d.Path = path
+			d.Channel, d.Timestamp, err = api.PostMessageContext(ctx, userID, append(message[:len(message):len(message)], msgOptionUnscoped())...)
+		default:
+			continue
+		}
+
+		if err == nil {
+			return d, nil
+		}
+
+		api.Debugf("fallback %s to %s failed: %v", path, userID, err)
+	}
+
+	return d, err
+}
+
+// msgOptionUnscoped removes the options which only apply to the original channel, the thread
+// does not exist in the direct message.
+func msgOptionUnscoped() MsgOption {
+	return func(config *sendConfig) error {
+		config.values.Del("thread_ts")
+		config.values.Del("reply_broadcast")
+		return nil
+	}
+}
@@ -0,0 +1,84 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+)
+
+func fallbackHandlers(prefix string, ephemeral bool) {
+	http.HandleFunc(prefix+"chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("channel") {
+		case "CPRIVATE":
+			rw.Write([]byte(`{"ok":false,"error":"not_in_channel"}`))
+		case "CRESTRICTED":
+			rw.Write([]byte(`{"ok":false,"error":"restricted_action"}`))
+		case "CMISSING":
+			rw.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+		default:
+			if r.FormValue("thread_ts") != "" {
+				rw.Write([]byte(`{"ok":false,"error":"thread_not_found"}`))
+				return
+			}
+			rw.Write([]byte(`{"ok":true,"channel":"D1","ts":"1.1"}`))
+		}
+	})
+	http.HandleFunc(prefix+"chat.postEphemeral", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if !ephemeral {
+			rw.Write([]byte(`{"ok":false,"error":"user_not_in_channel"}`))
+			return
+		}
+		rw.Write([]byte(`{"ok":true,"message_ts":"2.2"}`))
+	})
+}
+
+func TestPostMessageWithFallbackDM(t *testing.T) {
+	fallbackHandlers("/fallbackdm/", false)
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/fallbackdm/"))
+
+	d, err := api.PostMessageWithFallback("CPRIVATE", "U1", []MsgOption{MsgOptionText("hello", false), MsgOptionTS("1.0")})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if d.Path != DeliveryDM || d.Channel != "D1" || d.Timestamp != "1.1" || d.Cause == nil || d.Cause.Error() != "not_in_channel" {
+		t.Errorf("unexpected delivery %#v", d)
+	}
+
+	// the ephemeral message is skipped when the app is not in the channel.
+	fallbackHandlers("/fallbackskip/", true)
+	api = New("testing-token", OptionAPIURL("http://"+serverAddr+"/fallbackskip/"))
+	d, err = api.PostMessageWithFallback("CPRIVATE", "U1", []MsgOption{MsgOptionText("hello", false)})
+	if err != nil || d.Path != DeliveryDM {
+		t.Errorf("unexpected delivery %v %#v", err, d)
+	}
+}
+
+func TestPostMessageWithFallbackEphemeral(t *testing.T) {
+	fallbackHandlers("/fallbackephemeral/", true)
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/fallbackephemeral/"))
+
+	d, err := api.PostMessageWithFallback("CRESTRICTED", "U1", []MsgOption{MsgOptionText("hello", false)})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if d.Path != DeliveryEphemeral || d.Channel != "CRESTRICTED" || d.Timestamp != "2.2" {
+		t.Errorf("unexpected delivery %#v", d)
+	}
+
+	// other errors are not eligible for a fallback.
+	d, err = api.PostMessageWithFallback("CMISSING", "U1", []MsgOption{MsgOptionText("hello", false)})
+	if err == nil || err.Error() != "channel_not_found" || d.Path != DeliveryChannel {
+		t.Errorf("expected channel_not_found, got %v %#v", err, d)
+	}
+
+	// disabled fallback.
+	_, err = api.PostMessageWithFallback("CPRIVATE", "U1", []MsgOption{MsgOptionText("hello", false)}, FallbackOptionPaths())
+	if err == nil || err.Error() != "not_in_channel" {
+		t.Errorf("expected not_in_channel, got %v", err)
+	}
+}
@@ -0,0 +1,191 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultFanOutInterval the default delay between direct messages sent by FanOutDM, keeps
+// within the rate limits of chat.postMessage.
+const DefaultFanOutInterval = time.Second
+
+// OptOutStore the preferences of users who do not want to receive direct messages.
+type OptOutStore interface {
+	OptedOut(userID string) (bool, error)
+}
+
+// NewMemoryOptOutStore an in memory OptOutStore with the provided users opted out.
+func NewMemoryOptOutStore(userIDs ...string) *MemoryOptOutStore {
+	s := &MemoryOptOutStore{users: make(map[string]bool)}
+	s.OptOut(userIDs...)
+	return s
+}
+
+// MemoryOptOutStore an in memory OptOutStore, safe for concurrent use.
+type MemoryOptOutStore struct {
+	m     sync.RWMutex
+	users map[string]bool
+}
+
+// OptOut the users from direct messages.
+func (t *MemoryOptOutStore) OptOut(userIDs ...string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	for _, id := range userIDs {
+		t.users[id] = true
+	}
+}
+
+// OptIn the users to direct messages.
+func (t *MemoryOptOutStore) OptIn(userIDs ...string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	for _, id := range userIDs {
+		delete(t.users, id)
+	}
+}
+
+// OptedOut implements the OptOutStore interface.
+func (t *MemoryOptOutStore) OptedOut(userID string) (bool, error) {
+	t.m.RLock()
+	defer t.m.RUnlock()
+
+	return t.users[userID], nil
+}
+
+// DMStatus the outcome of a direct message sent by FanOutDM.
+type DMStatus string
+
+// Outcomes of direct messages.
+const (
+	DMDelivered DMStatus = "delivered"
+	DMOptedOut  DMStatus = "opted_out"
+	DMDisabled  DMStatus = "disabled"
+	DMFailed    DMStatus = "failed"
+)
+
+// DMResult the outcome of the direct message to a single user.
+type DMResult struct {
+	User      string
+	Channel   string
+	Timestamp string
+	Status    DMStatus
+	Err       error
+}
+
+// FanOutOption options for FanOutDM.
+type FanOutOption func(*fanOutConfig)
+
+// FanOutOptionOptOuts users opted out in the store are skipped.
+func FanOutOptionOptOuts(store OptOutStore) FanOutOption {
+	return func(c *fanOutConfig) {
+		c.optouts = store
+	}
+}
+
+// FanOutOptionInterval minimum delay between direct messages, defaults to DefaultFanOutInterval.
+func FanOutOptionInterval(d time.Duration) FanOutOption {
+	return func(c *fanOutConfig) {
+		c.interval = d
+	}
+}
+
+type fanOutConfig struct {
+	optouts  OptOutStore
+	interval time.Duration
+}
+
+// FanOutDM sends the message to each of the users, see FanOutDMContext.
+func (api *Client) FanOutDM(userIDs []string, message []MsgOption, options ...FanOutOption) []DMResult {
+	return api.FanOutDMContext(context.Background(), userIDs, message, options...)
+}
+
+// FanOutDMContext sends the message as a direct message to each of the users with a custom context.
+// messages are sent sequentially and paced, rate limited messages are retried after the delay
+// provided by slack. Users who opted out are skipped, users who cannot receive direct messages
+// from the app (e.g. the messages tab is disabled) are reported with the DMDisabled status.
+// The results are returned in the same order as the users.
+func (api *Client) FanOutDMContext(ctx context.Context, userIDs []string, message []MsgOption, options ...FanOutOption) []DMResult {
+	var (
+		pace <-chan time.Time
+	)
+
+	config := fanOutConfig{
+		interval: DefaultFanOutInterval,
+	}
+
+	for _, opt := range options {
+		opt(&config)
+	}
+
+	if config.interval > 0 {
+		ticker := time.NewTicker(config.interval)
+		defer ticker.Stop()
+		pace = ticker.C
+	}
+
+	results := make([]DMResult, 0, len(userIDs))
+	sent := false
+	for _, id := range userIDs {
+		result := DMResult{User: id}
+
+		if config.optouts != nil {
+			optedOut, err := config.optouts.OptedOut(id)
+			if err != nil {
+				result.Status, result.Err = DMFailed, err
+				results = append(results, result)
+				continue
+			}
+
+			if optedOut {
+				result.Status = DMOptedOut
+				results = append(results, result)
+				continue
+			}
+		}
+
+		if pace != nil && sent {
+			select {
+			case <-pace:
+			case <-ctx.Done():
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			result.Status, result.Err = DMFailed, err
+			results = append(results, result)
+			continue
+		}
+
+		results = append(results, api.sendDMWithRetry(ctx, result, message))
+		sent = true
+	}
+
+	return results
+}
+
+func (api *Client) sendDMWithRetry(ctx context.Context, result DMResult, message []MsgOption) DMResult {
+	result.Err = api.retryRateLimited(ctx, func() (err error) {
+		result.Channel, result.Timestamp, err = api.PostMessageContext(ctx, result.User, message...)
+		return err
+	})
+	result.Status = dmStatus(result.Err)
+
+	return result
+}
+
+func dmStatus(err error) DMStatus {
+	if err == nil {
+		return DMDelivered
+	}
+
+	switch err.Error() {
+	case "messages_tab_disabled", "cannot_dm_bot", "user_disabled", "is_archived":
+		return DMDisabled
+	default:
+		return DMFailed
+	}
+}
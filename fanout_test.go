@@ -0,0 +1,56 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFanOutDM(t *testing.T) {
+	var posted []string
+
+	http.HandleFunc("/fanout/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		channel := r.FormValue("channel")
+		posted = append(posted, channel)
+		w.Header().Set("Content-Type", "application/json")
+		switch channel {
+		case "U3":
+			w.Write([]byte(`{"ok":false,"error":"messages_tab_disabled"}`))
+		case "U4":
+			w.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+		default:
+			w.Write([]byte(`{"ok":true,"channel":"D` + channel + `","ts":"123.456"}`))
+		}
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/fanout/"))
+
+	results := api.FanOutDM(
+		[]string{"U1", "U2", "U3", "U4"},
+		[]MsgOption{MsgOptionText("maintenance tonight", false)},
+		FanOutOptionOptOuts(NewMemoryOptOutStore("U2")),
+		FanOutOptionInterval(0),
+	)
+
+	assert.Equal(t, []string{"U1", "U3", "U4"}, posted)
+	assert.Len(t, results, 4)
+	assert.Equal(t, DMResult{User: "U1", Channel: "DU1", Timestamp: "123.456", Status: DMDelivered}, results[0])
+	assert.Equal(t, DMResult{User: "U2", Status: DMOptedOut}, results[1])
+	assert.Equal(t, DMDisabled, results[2].Status)
+	assert.EqualError(t, results[2].Err, "messages_tab_disabled")
+	assert.Equal(t, DMFailed, results[3].Status)
+}
+
+func TestMemoryOptOutStore(t *testing.T) {
+	store := NewMemoryOptOutStore("U1", "U2")
+	store.OptIn("U1")
+
+	optedOut, err := store.OptedOut("U1")
+	assert.Nil(t, err)
+	assert.False(t, optedOut)
+
+	optedOut, _ = store.OptedOut("U2")
+	assert.True(t, optedOut)
+}
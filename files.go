@@ -61,12 +61,19 @@ type File struct {
 	Thumb720    string `json:"thumb_720"`
 	Thumb720W   int    `json:"thumb_720_w"`
 	Thumb720H   int    `json:"thumb_720_h"`
+	Thumb800    string `json:"thumb_800"`
+	Thumb800W   int    `json:"thumb_800_w"`
+	Thumb800H   int    `json:"thumb_800_h"`
 	Thumb960    string `json:"thumb_960"`
 	Thumb960W   int    `json:"thumb_960_w"`
 	Thumb960H   int    `json:"thumb_960_h"`
 	Thumb1024   string `json:"thumb_1024"`
 	Thumb1024W  int    `json:"thumb_1024_w"`
 	Thumb1024H  int    `json:"thumb_1024_h"`
+	ThumbPDF    string `json:"thumb_pdf"`
+	ThumbPDFW   int    `json:"thumb_pdf_w"`
+	ThumbPDFH   int    `json:"thumb_pdf_h"`
+	ThumbVideo  string `json:"thumb_video"`
 
 	Permalink       string `json:"permalink"`
 	PermalinkPublic string `json:"permalink_public"`
@@ -74,6 +81,7 @@ type File struct {
 	EditLink         string `json:"edit_link"`
 	Preview          string `json:"preview"`
 	PreviewHighlight string `json:"preview_highlight"`
+	PreviewTruncated bool   `json:"preview_is_truncated"`
 	Lines            int    `json:"lines"`
 	LinesMore        int    `json:"lines_more"`
 
@@ -89,6 +97,24 @@ type File struct {
 	Shares          Share    `json:"shares"`
 }
 
+// PublicURL returns a direct link to the contents of a file shared publicly using
+// ShareFilePublicURL, the link can be embedded or downloaded without authentication.
+// returns an empty string when the file isn't publicly shared.
+func (t File) PublicURL() string {
+	if !t.PublicURLShared || t.PermalinkPublic == "" || t.URLPrivate == "" {
+		return ""
+	}
+
+	// the secret is the final segment of the public permalink, e.g.
+	// https://slack-files.com/T0000000-F0000000-1a2b3c4d
+	idx := strings.LastIndex(t.PermalinkPublic, "-")
+	if idx == -1 {
+		return ""
+	}
+
+	return t.URLPrivate + "?pub_secret=" + url.QueryEscape(t.PermalinkPublic[idx+1:])
+}
+
 type Share struct {
 	Public  map[string][]ShareFileInfo `json:"public"`
 	Private map[string][]ShareFileInfo `json:"private"`
@@ -136,17 +162,21 @@ type GetFilesParameters struct {
 
 // ListFilesParameters contains all the parameters necessary (including the optional ones) for a ListFiles() request
 type ListFilesParameters struct {
-	Limit   int
-	User    string
-	Channel string
-	Types   string
-	Cursor  string
+	Limit         int
+	User          string
+	Channel       string
+	Types         string
+	TimestampFrom JSONTime
+	TimestampTo   JSONTime
+	TeamID        string
+	Cursor        string
 }
 
 type fileResponseFull struct {
 	File     `json:"file"`
 	Paging   `json:"paging"`
 	Comments []Comment        `json:"comments"`
+	Comment  Comment          `json:"comment"`
 	Files    []File           `json:"files"`
 	Metadata ResponseMetadata `json:"response_metadata"`
 
@@ -197,9 +227,60 @@ func (api *Client) GetFileInfoContext(ctx context.Context, fileID string, count,
 	return &response.File, response.Comments, &response.Paging, nil
 }
 
+// GetFileInfoCursor retrieves a file and a page of its comments, see GetFileInfoCursorContext.
+func (api *Client) GetFileInfoCursor(fileID, cursor string, limit int) (*File, []Comment, string, error) {
+	return api.GetFileInfoCursorContext(context.Background(), fileID, cursor, limit)
+}
+
+// GetFileInfoCursorContext retrieves a file and a page of its comments using cursor based pagination
+// with a custom context, returns the cursor of the next page of comments.
+func (api *Client) GetFileInfoCursorContext(ctx context.Context, fileID, cursor string, limit int) (*File, []Comment, string, error) {
+	values := url.Values{
+		"token": {api.token},
+		"file":  {fileID},
+	}
+
+	if cursor != "" {
+		values.Add("cursor", cursor)
+	}
+
+	if limit > 0 {
+		values.Add("limit", strconv.Itoa(limit))
+	}
+
+	response, err := api.fileRequest(ctx, "files.info", values)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return &response.File, response.Comments, response.Metadata.Cursor, nil
+}
+
 // GetFile retreives a given file from its private download URL
 func (api *Client) GetFile(downloadURL string, writer io.Writer) error {
-	return downloadFile(api.httpclient, api.token, downloadURL, writer, api)
+	return api.GetFileContext(context.Background(), downloadURL, writer)
+}
+
+// GetFileContext streams a file from its private download URL (e.g. File.URLPrivateDownload) into
+// the writer with a custom context, authenticating with the token of the client.
+func (api *Client) GetFileContext(ctx context.Context, downloadURL string, writer io.Writer) error {
+	return downloadFile(ctx, api.httpclient, api.token, downloadURL, writer, api)
+}
+
+// OpenFile opens a file from its private download URL, see OpenFileContext.
+func (api *Client) OpenFile(downloadURL string) (io.ReadCloser, error) {
+	return api.OpenFileContext(context.Background(), downloadURL)
+}
+
+// OpenFileContext opens a file from its private download URL with a custom context, authenticating
+// with the token of the client. The caller must close the returned reader.
+func (api *Client) OpenFileContext(ctx context.Context, downloadURL string) (io.ReadCloser, error) {
+	resp, err := openDownload(ctx, api.httpclient, api.token, downloadURL, api)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
 }
 
 // GetFiles retrieves all files according to the parameters given
@@ -224,9 +305,21 @@ func (api *Client) ListFilesContext(ctx context.Context, params ListFilesParamet
 	if params.Channel != DEFAULT_FILES_CHANNEL {
 		values.Add("channel", params.Channel)
 	}
-	if params.Limit != DEFAULT_FILES_COUNT {
+	if params.Limit > 0 && params.Limit != DEFAULT_FILES_COUNT {
 		values.Add("limit", strconv.Itoa(params.Limit))
 	}
+	if params.Types != "" && params.Types != DEFAULT_FILES_TYPES {
+		values.Add("types", params.Types)
+	}
+	if params.TimestampFrom != DEFAULT_FILES_TS_FROM {
+		values.Add("ts_from", strconv.FormatInt(int64(params.TimestampFrom), 10))
+	}
+	if params.TimestampTo > 0 {
+		values.Add("ts_to", strconv.FormatInt(int64(params.TimestampTo), 10))
+	}
+	if params.TeamID != "" {
+		values.Add("team_id", params.TeamID)
+	}
 	if params.Cursor != "" {
 		values.Add("cursor", params.Cursor)
 	}
@@ -241,6 +334,45 @@ func (api *Client) ListFilesContext(ctx context.Context, params ListFilesParamet
 	return response.Files, &params, nil
 }
 
+// ForEachFile invokes fn for every file, see ForEachFileContext.
+func (api *Client) ForEachFile(params ListFilesParameters, fn func(File) error) error {
+	return api.ForEachFileContext(context.Background(), params, fn)
+}
+
+// ForEachFileContext invokes fn for every file matching the parameters with a custom context, the
+// filters (user, channel, types, and time range) are combined. Iteration stops at the first error
+// returned by fn, returning ErrStopIteration stops the iteration without an error.
+func (api *Client) ForEachFileContext(ctx context.Context, params ListFilesParameters, fn func(File) error) error {
+	var (
+		files []File
+		next  *ListFilesParameters
+	)
+
+	for {
+		err := api.retryRateLimited(ctx, func() (err error) {
+			files, next, err = api.ListFilesContext(ctx, params)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			if err = fn(file); err == ErrStopIteration {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+
+		if next.Cursor == "" {
+			return nil
+		}
+
+		params = *next
+	}
+}
+
 // GetFilesContext retrieves all files according to the parameters given with a custom context
 func (api *Client) GetFilesContext(ctx context.Context, params GetFilesParameters) ([]File, *Paging, error) {
 	values := url.Values{
@@ -329,6 +461,57 @@ func (api *Client) UploadFileContext(ctx context.Context, params FileUploadParam
 	return &response.File, response.Err()
 }
 
+// AddFileComment adds a comment to a file, see AddFileCommentContext.
+func (api *Client) AddFileComment(fileID, comment string) (*Comment, error) {
+	return api.AddFileCommentContext(context.Background(), fileID, comment)
+}
+
+// AddFileCommentContext adds a comment to a file using files.comments.add with a custom context.
+func (api *Client) AddFileCommentContext(ctx context.Context, fileID, comment string) (*Comment, error) {
+	if fileID == "" || comment == "" {
+		return nil, ErrParametersMissing
+	}
+
+	values := url.Values{
+		"token":   {api.token},
+		"file":    {fileID},
+		"comment": {comment},
+	}
+
+	response, err := api.fileRequest(ctx, "files.comments.add", values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Comment, nil
+}
+
+// EditFileComment edits a file's comment, see EditFileCommentContext.
+func (api *Client) EditFileComment(fileID, commentID, comment string) (*Comment, error) {
+	return api.EditFileCommentContext(context.Background(), fileID, commentID, comment)
+}
+
+// EditFileCommentContext edits a file's comment using files.comments.edit with a custom context.
+func (api *Client) EditFileCommentContext(ctx context.Context, fileID, commentID, comment string) (*Comment, error) {
+	if fileID == "" || commentID == "" || comment == "" {
+		return nil, ErrParametersMissing
+	}
+
+	values := url.Values{
+		"token":   {api.token},
+		"file":    {fileID},
+		"id":      {commentID},
+		"comment": {comment},
+	}
+
+	response, err := api.fileRequest(ctx, "files.comments.edit", values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Comment, nil
+}
+
 // DeleteFileComment deletes a file's comment
 func (api *Client) DeleteFileComment(commentID, fileID string) error {
 	return api.DeleteFileCommentContext(context.Background(), fileID, commentID)
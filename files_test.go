@@ -2,7 +2,9 @@ package slack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -211,3 +213,294 @@ func TestUploadFileWithoutFilename(t *testing.T) {
 		t.Errorf("Error message should mention empty FileUploadParameters.Filename")
 	}
 }
+
+type failingReader struct {
+	remaining int
+}
+
+func (t *failingReader) Read(p []byte) (int, error) {
+	if t.remaining <= 0 {
+		return 0, errors.New("disk failure")
+	}
+
+	if len(p) > t.remaining {
+		p = p[:t.remaining]
+	}
+	t.remaining -= len(p)
+
+	return len(p), nil
+}
+
+func TestUploadFileReaderFailure(t *testing.T) {
+	var (
+		uploads int
+	)
+
+	http.HandleFunc("/streaming/auth.test", authTestHandler)
+	http.HandleFunc("/streaming/files.upload", func(rw http.ResponseWriter, r *http.Request) {
+		if _, err := ioutil.ReadAll(r.Body); err != nil {
+			return
+		}
+		uploads++
+		uploadFileHandler(rw, r)
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/streaming/"))
+
+	_, err := api.UploadFile(FileUploadParameters{
+		Filename: "test.txt",
+		Reader:   &failingReader{remaining: 1 << 20},
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "disk failure") {
+		t.Errorf("expected the reader error, got %v", err)
+	}
+
+	if uploads != 0 {
+		t.Errorf("expected the truncated upload to be aborted")
+	}
+}
+
+func TestShareFilePublicURL(t *testing.T) {
+	shared := false
+	handler := func(rw http.ResponseWriter, r *http.Request) {
+		if r.FormValue("file") != "F1" {
+			rw.Write([]byte(`{"ok":false,"error":"file_not_found"}`))
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(rw).Encode(fileResponseFull{
+			SlackResponse: SlackResponse{Ok: true},
+			File: File{
+				ID:              "F1",
+				URLPrivate:      "https://files.slack.com/files-pri/T1-F1/report.pdf",
+				PermalinkPublic: "https://slack-files.com/T1-F1-4a7b9c",
+				PublicURLShared: shared,
+			},
+		})
+	}
+	http.HandleFunc("/publicurl/files.sharedPublicURL", func(rw http.ResponseWriter, r *http.Request) {
+		shared = true
+		handler(rw, r)
+	})
+	http.HandleFunc("/publicurl/files.revokePublicURL", func(rw http.ResponseWriter, r *http.Request) {
+		shared = false
+		handler(rw, r)
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/publicurl/"))
+
+	file, _, _, err := api.ShareFilePublicURL("F1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if file.PublicURL() != "https://files.slack.com/files-pri/T1-F1/report.pdf?pub_secret=4a7b9c" {
+		t.Errorf("unexpected public url %s", file.PublicURL())
+	}
+
+	if file, err = api.RevokeFilePublicURL("F1"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if file.PublicURL() != "" {
+		t.Errorf("expected no public url once revoked, got %s", file.PublicURL())
+	}
+
+	if _, err = api.RevokeFilePublicURL("F2"); err == nil || err.Error() != "file_not_found" {
+		t.Errorf("expected file_not_found, got %v", err)
+	}
+}
+
+func TestListFilesFilters(t *testing.T) {
+	http.HandleFunc("/listfiles/files.list", func(rw http.ResponseWriter, r *http.Request) {
+		assert := func(k, expected string) {
+			if v := r.FormValue(k); v != expected {
+				t.Errorf("expected %s=%q, got %q", k, expected, v)
+			}
+		}
+		assert("channel", "C1")
+		assert("types", "images,pdfs")
+		assert("ts_from", "100")
+		assert("ts_to", "200")
+		assert("limit", "")
+		assert("cursor", "abc")
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"files":[{"id":"F1"}],"response_metadata":{"next_cursor":"def"}}`))
+	})
+	http.HandleFunc("/listfiles/files.info", func(rw http.ResponseWriter, r *http.Request) {
+		if r.FormValue("cursor") != "c1" || r.FormValue("limit") != "2" {
+			t.Errorf("unexpected paging %v", r.Form)
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"file":{"id":"F1"},"comments":[{"id":"Fc1","comment":"nice"}],"response_metadata":{"next_cursor":"c2"}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/listfiles/"))
+
+	files, next, err := api.ListFiles(ListFilesParameters{
+		Channel:       "C1",
+		Types:         "images,pdfs",
+		TimestampFrom: 100,
+		TimestampTo:   200,
+		Cursor:        "abc",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(files) != 1 || next.Cursor != "def" {
+		t.Errorf("unexpected response %v %v", files, next)
+	}
+
+	file, comments, cursor, err := api.GetFileInfoCursor("F1", "c1", 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if file.ID != "F1" || len(comments) != 1 || comments[0].Comment != "nice" || cursor != "c2" {
+		t.Errorf("unexpected response %v %v %s", file, comments, cursor)
+	}
+}
+
+func TestAddFileComment(t *testing.T) {
+	http.HandleFunc("/comments/files.comments.add", func(rw http.ResponseWriter, r *http.Request) {
+		if r.FormValue("file") != "F1" || r.FormValue("comment") != "looks good" {
+			t.Errorf("unexpected request %v", r.Form)
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"comment":{"id":"Fc1","created":1356032811,"timestamp":1356032811,"user":"U1","comment":"looks good","is_intro":false}}`))
+	})
+	http.HandleFunc("/comments/files.info", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"file":{"id":"F1","comments_count":1},"comments":[{"id":"Fc1","user":"U1","comment":"looks good","pinned_to":["C1"]}],"paging":{"count":100,"total":1,"page":1,"pages":1}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/comments/"))
+
+	if _, err := api.AddFileComment("F1", ""); err != ErrParametersMissing {
+		t.Errorf("expected missing parameters, got %v", err)
+	}
+
+	comment, err := api.AddFileComment("F1", "looks good")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if comment.ID != "Fc1" || comment.User != "U1" || comment.Created != 1356032811 {
+		t.Errorf("unexpected comment %#v", comment)
+	}
+
+	_, comments, paging, err := api.GetFileInfo("F1", 100, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := []Comment{{ID: "Fc1", User: "U1", Comment: "looks good", PinnedTo: []string{"C1"}}}
+	if !reflect.DeepEqual(comments, expected) || paging.Total != 1 {
+		t.Errorf("unexpected comments %#v", comments)
+	}
+}
+
+func TestGetFileFollowsRedirects(t *testing.T) {
+	http.HandleFunc("/download/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/download/file.csv", http.StatusFound)
+	})
+	http.HandleFunc("/download/file.csv", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer testing-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte("a,b,c"))
+	})
+	http.HandleFunc("/download/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	once.Do(startServer)
+
+	// a client which does not follow redirects itself.
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	for i, api := range []*Client{New("testing-token"), New("testing-token", OptionHTTPClient(client))} {
+		buf := &bytes.Buffer{}
+		if err := api.GetFileContext(context.Background(), "http://"+serverAddr+"/download/redirect", buf); err != nil {
+			t.Fatalf("%d: Unexpected error: %s", i, err)
+		}
+		if buf.String() != "a,b,c" {
+			t.Errorf("%d: unexpected content %q", i, buf.String())
+		}
+
+		r, err := api.OpenFile("http://" + serverAddr + "/download/redirect")
+		if err != nil {
+			t.Fatalf("%d: Unexpected error: %s", i, err)
+		}
+		body, _ := ioutil.ReadAll(r)
+		r.Close()
+		if string(body) != "a,b,c" {
+			t.Errorf("%d: unexpected content %q", i, body)
+		}
+
+		if _, err = api.OpenFile("http://" + serverAddr + "/download/missing"); err == nil {
+			t.Errorf("%d: expected an error for a missing file", i)
+		}
+	}
+
+	if trustedDownloadHost("files.slack.com", "example.com") || !trustedDownloadHost("files.slack.com", "slack.com") {
+		t.Error("unexpected trusted download hosts")
+	}
+}
+
+func TestForEachFile(t *testing.T) {
+	var (
+		limited bool
+	)
+
+	http.HandleFunc("/eachfile/files.list", func(rw http.ResponseWriter, r *http.Request) {
+		if r.FormValue("user") != "U1" || r.FormValue("types") != "images" || r.FormValue("ts_to") != "200" {
+			t.Errorf("unexpected filters %v", r.Form)
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cursor") {
+		case "":
+			rw.Write([]byte(`{"ok":true,"files":[{"id":"F1"},{"id":"F2"}],"response_metadata":{"next_cursor":"page2"}}`))
+		case "page2":
+			if !limited {
+				limited = true
+				rw.Header().Set("Retry-After", "0")
+				rw.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			rw.Write([]byte(`{"ok":true,"files":[{"id":"F3"}],"response_metadata":{"next_cursor":""}}`))
+		}
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/eachfile/"))
+	params := ListFilesParameters{User: "U1", Types: "images", TimestampTo: 200}
+
+	var ids []string
+	err := api.ForEachFile(params, func(f File) error {
+		ids = append(ids, f.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(ids, []string{"F1", "F2", "F3"}) || !limited {
+		t.Errorf("unexpected files %v", ids)
+	}
+
+	ids = nil
+	err = api.ForEachFile(params, func(f File) error {
+		ids = append(ids, f.ID)
+		return ErrStopIteration
+	})
+	if err != nil || len(ids) != 1 {
+		t.Errorf("expected iteration to stop, got %v %v", ids, err)
+	}
+}
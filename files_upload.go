@@ -0,0 +1,330 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// UploadFileV2Parameters contains all the parameters necessary (including the optional ones) for an UploadFileV2() request.
+//
+// Like FileUploadParameters the file is provided by one of Content, Reader, or File. When using the Reader
+// option both the Filename and FileSize must be specified as slack requires the length of the upload upfront.
+type UploadFileV2Parameters struct {
+	File            string
+	Content         string
+	Reader          io.Reader
+	FileSize        int
+	Filename        string
+	Title           string
+	AltTxt          string
+	SnippetType     string
+	InitialComment  string
+	Channel         string
+	ThreadTimestamp string
+}
+
+// FileSummary the file uploaded by UploadFileV2.
+type FileSummary struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// GetUploadURLExternalParameters parameters for files.getUploadURLExternal.
+type GetUploadURLExternalParameters struct {
+	Filename    string
+	FileSize    int
+	AltTxt      string
+	SnippetType string
+}
+
+// GetUploadURLExternalResponse the destination of an external upload.
+type GetUploadURLExternalResponse struct {
+	UploadURL string `json:"upload_url"`
+	FileID    string `json:"file_id"`
+	SlackResponse
+}
+
+// CompleteUploadExternalParameters parameters for files.completeUploadExternal.
+type CompleteUploadExternalParameters struct {
+	Files           []FileSummary
+	Channel         string
+	ThreadTimestamp string
+	InitialComment  string
+}
+
+type completeUploadExternalResponse struct {
+	Files []FileSummary `json:"files"`
+	SlackResponse
+}
+
+// GetUploadURLExternal requests a URL to upload a file to, see GetUploadURLExternalContext.
+func (api *Client) GetUploadURLExternal(params GetUploadURLExternalParameters) (*GetUploadURLExternalResponse, error) {
+	return api.GetUploadURLExternalContext(context.Background(), params)
+}
+
+// GetUploadURLExternalContext requests a URL to upload a file to with a custom context.
+func (api *Client) GetUploadURLExternalContext(ctx context.Context, params GetUploadURLExternalParameters) (*GetUploadURLExternalResponse, error) {
+	if params.Filename == "" || params.FileSize <= 0 {
+		return nil, ErrParametersMissing
+	}
+
+	values := url.Values{
+		"token":    {api.token},
+		"filename": {params.Filename},
+		"length":   {strconv.Itoa(params.FileSize)},
+	}
+
+	if params.AltTxt != "" {
+		values.Add("alt_txt", params.AltTxt)
+	}
+
+	if params.SnippetType != "" {
+		values.Add("snippet_type", params.SnippetType)
+	}
+
+	response := &GetUploadURLExternalResponse{}
+	if err := api.postMethod(ctx, "files.getUploadURLExternal", values, response); err != nil {
+		return nil, err
+	}
+
+	return response, response.Err()
+}
+
+// UploadToURL streams the contents of the reader to an upload URL, see UploadToURLContext.
+func (api *Client) UploadToURL(uploadURL string, size int, r io.Reader) error {
+	return api.UploadToURLContext(context.Background(), uploadURL, size, r)
+}
+
+// UploadToURLContext streams the contents of the reader to an upload URL returned by
+// files.getUploadURLExternal with a custom context.
+func (api *Client) UploadToURLContext(ctx context.Context, uploadURL string, size int, r io.Reader) error {
+	// seekable sources are read through independent sections so retries can be replayed.
+	open := sections(r, int64(size))
+	if open != nil {
+		r = open()
+	}
+
+	req, err := http.NewRequest(http.MethodPost, uploadURL, r)
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = int64(size)
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	if open != nil {
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(open()), nil
+		}
+	}
+
+	resp, err := api.httpclient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkStatusCode(resp, api)
+}
+
+// CompleteUploadExternal finalizes uploads, see CompleteUploadExternalContext.
+func (api *Client) CompleteUploadExternal(params CompleteUploadExternalParameters) ([]FileSummary, error) {
+	return api.CompleteUploadExternalContext(context.Background(), params)
+}
+
+// CompleteUploadExternalContext finalizes uploads started with files.getUploadURLExternal,
+// optionally sharing them to a channel or thread, with a custom context.
+func (api *Client) CompleteUploadExternalContext(ctx context.Context, params CompleteUploadExternalParameters) ([]FileSummary, error) {
+	encoded, err := json.Marshal(params.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{
+		"token": {api.token},
+		"files": {string(encoded)},
+	}
+
+	if params.Channel != "" {
+		values.Add("channel_id", params.Channel)
+	}
+
+	if params.ThreadTimestamp != "" {
+		values.Add("thread_ts", params.ThreadTimestamp)
+	}
+
+	if params.InitialComment != "" {
+		values.Add("initial_comment", params.InitialComment)
+	}
+
+	response := &completeUploadExternalResponse{}
+	if err = api.postMethod(ctx, "files.completeUploadExternal", values, response); err != nil {
+		return nil, err
+	}
+
+	return response.Files, response.Err()
+}
+
+// UploadFileV2 uploads a file, see UploadFileV2Context.
+func (api *Client) UploadFileV2(params UploadFileV2Parameters) (*FileSummary, error) {
+	return api.UploadFileV2Context(context.Background(), params)
+}
+
+// UploadFileV2Context uploads a file using files.getUploadURLExternal and files.completeUploadExternal
+// with a custom context, the replacement for files.upload. The file is streamed to slack without
+// being buffered in memory.
+func (api *Client) UploadFileV2Context(ctx context.Context, params UploadFileV2Parameters) (*FileSummary, error) {
+	r, size, err := openUploadFileV2(&params)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return api.uploadFileV2(ctx, params, size, func(ctx context.Context, uploadURL string) error {
+		return api.UploadToURLContext(ctx, uploadURL, size, r)
+	})
+}
+
+// UploadFilesV2Parameters contains the parameters for uploading several files into a single message.
+// The Channel, ThreadTimestamp, and InitialComment of the individual files are ignored.
+type UploadFilesV2Parameters struct {
+	Files           []UploadFileV2Parameters
+	Channel         string
+	ThreadTimestamp string
+	InitialComment  string
+}
+
+// UploadFilesV2 uploads several files, see UploadFilesV2Context.
+func (api *Client) UploadFilesV2(params UploadFilesV2Parameters) ([]FileSummary, error) {
+	return api.UploadFilesV2Context(context.Background(), params)
+}
+
+// UploadFilesV2Context uploads each of the files with a custom context and completes them together,
+// sharing them in a single message with the initial comment when a channel is provided.
+// The files are uploaded sequentially and nothing is shared if any of the uploads fail.
+func (api *Client) UploadFilesV2Context(ctx context.Context, params UploadFilesV2Parameters) ([]FileSummary, error) {
+	if len(params.Files) == 0 {
+		return nil, ErrParametersMissing
+	}
+
+	staged := make([]FileSummary, 0, len(params.Files))
+	for _, file := range params.Files {
+		summary, err := api.stageUploadFileV2(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+
+		staged = append(staged, summary)
+	}
+
+	return api.CompleteUploadExternalContext(ctx, CompleteUploadExternalParameters{
+		Files:           staged,
+		Channel:         params.Channel,
+		ThreadTimestamp: params.ThreadTimestamp,
+		InitialComment:  params.InitialComment,
+	})
+}
+
+func (api *Client) stageUploadFileV2(ctx context.Context, params UploadFileV2Parameters) (FileSummary, error) {
+	r, size, err := openUploadFileV2(&params)
+	if err != nil {
+		return FileSummary{}, err
+	}
+	defer r.Close()
+
+	return api.uploadExternal(ctx, params, size, func(ctx context.Context, uploadURL string) error {
+		return api.UploadToURLContext(ctx, uploadURL, size, r)
+	})
+}
+
+// openUploadFileV2 opens the contents of the file, defaulting the filename to the name of the
+// local file.
+func openUploadFileV2(params *UploadFileV2Parameters) (io.ReadCloser, int, error) {
+	switch {
+	case params.Content != "":
+		return ioutil.NopCloser(strings.NewReader(params.Content)), len(params.Content), nil
+	case params.File != "":
+		file, err := os.Open(params.File)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		info, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return nil, 0, err
+		}
+
+		if params.Filename == "" {
+			params.Filename = filepath.Base(params.File)
+		}
+
+		return file, int(info.Size()), nil
+	case params.Reader != nil && params.FileSize > 0:
+		return ioutil.NopCloser(params.Reader), params.FileSize, nil
+	default:
+		return nil, 0, fmt.Errorf("files.getUploadURLExternal: UploadFileV2Parameters requires a non-empty Content, File, or Reader with FileSize")
+	}
+}
+
+// uploadFileV2 uploads the file using uploadExternal and completes the upload.
+func (api *Client) uploadFileV2(ctx context.Context, params UploadFileV2Parameters, size int, transfer func(ctx context.Context, uploadURL string) error) (*FileSummary, error) {
+	summary, err := api.uploadExternal(ctx, params, size, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := api.CompleteUploadExternalContext(ctx, CompleteUploadExternalParameters{
+		Files:           []FileSummary{summary},
+		Channel:         params.Channel,
+		ThreadTimestamp: params.ThreadTimestamp,
+		InitialComment:  params.InitialComment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("files.completeUploadExternal: no files returned")
+	}
+
+	return &files[0], nil
+}
+
+// uploadExternal requests an upload URL for the file and transfers the contents using transfer,
+// the upload must then be completed using files.completeUploadExternal.
+func (api *Client) uploadExternal(ctx context.Context, params UploadFileV2Parameters, size int, transfer func(ctx context.Context, uploadURL string) error) (FileSummary, error) {
+	if params.Filename == "" {
+		return FileSummary{}, fmt.Errorf("files.getUploadURLExternal: UploadFileV2Parameters.Filename is mandatory")
+	}
+
+	upload, err := api.GetUploadURLExternalContext(ctx, GetUploadURLExternalParameters{
+		Filename:    params.Filename,
+		FileSize:    size,
+		AltTxt:      params.AltTxt,
+		SnippetType: params.SnippetType,
+	})
+	if err != nil {
+		return FileSummary{}, err
+	}
+
+	if err = transfer(ctx, upload.UploadURL); err != nil {
+		return FileSummary{}, err
+	}
+
+	title := params.Title
+	if title == "" {
+		title = params.Filename
+	}
+
+	return FileSummary{ID: upload.FileID, Title: title}, nil
+}
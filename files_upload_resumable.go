@@ -0,0 +1,216 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultUploadRetries the number of times a failed upload is retried by default.
+const DefaultUploadRetries = 5
+
+// ResumableUploadOption options for resumable uploads.
+type ResumableUploadOption func(*resumableUpload)
+
+// ResumableUploadOptionRetries number of times a failed upload is retried before it is
+// aborted, defaults to DefaultUploadRetries.
+func ResumableUploadOptionRetries(n int) ResumableUploadOption {
+	return func(t *resumableUpload) {
+		t.retries = n
+	}
+}
+
+// ResumableUploadOptionProgress invoked as the contents are transferred with the number
+// of bytes sent so far and the total size of the upload. the count restarts from zero
+// when a failed upload is retried.
+func ResumableUploadOptionProgress(fn func(sent, total int64)) ResumableUploadOption {
+	return func(t *resumableUpload) {
+		t.progress = fn
+	}
+}
+
+type resumableUpload struct {
+	retries  int
+	backoff  backoff
+	progress func(sent, total int64)
+}
+
+func newResumableUpload(options ...ResumableUploadOption) resumableUpload {
+	t := resumableUpload{
+		retries:  DefaultUploadRetries,
+		backoff:  backoff{Initial: time.Second, Max: 30 * time.Second},
+		progress: func(int64, int64) {},
+	}
+
+	for _, opt := range options {
+		opt(&t)
+	}
+
+	return t
+}
+
+// UploadToURLResumable uploads the contents of the reader, see UploadToURLResumableContext.
+func (api *Client) UploadToURLResumable(uploadURL string, r io.ReaderAt, size int64, options ...ResumableUploadOption) error {
+	return api.UploadToURLResumableContext(context.Background(), uploadURL, r, size, options...)
+}
+
+// UploadToURLResumableContext uploads the contents of the reader to an upload URL returned by
+// files.getUploadURLExternal with a custom context. The upload url accepts the file in a single
+// request, failed uploads are re-read from the start of the reader and retried with backoff.
+func (api *Client) UploadToURLResumableContext(ctx context.Context, uploadURL string, r io.ReaderAt, size int64, options ...ResumableUploadOption) (err error) {
+	t := newResumableUpload(options...)
+
+	for attempt := 0; ; attempt++ {
+		body := &progressReader{Reader: io.NewSectionReader(r, 0, size), total: size, fn: t.progress}
+		if err = t.attempt(ctx, api, uploadURL, body, size); err == nil {
+			return nil
+		}
+
+		if attempt >= t.retries || !retryableUploadError(err) {
+			return fmt.Errorf("upload failed: %v", err)
+		}
+
+		delay := t.backoff.Duration()
+		if rerr, ok := err.(*RateLimitedError); ok {
+			delay = rerr.RetryAfter
+		}
+
+		api.Debugf("upload failed, retrying in %s: %v", delay, err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (t *resumableUpload) attempt(ctx context.Context, api *Client, uploadURL string, body io.Reader, size int64) error {
+	req, err := http.NewRequest(http.MethodPost, uploadURL, body)
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := api.httpclient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkStatusCode(resp, api)
+}
+
+// progressReader reports the number of bytes read.
+type progressReader struct {
+	io.Reader
+	sent  int64
+	total int64
+	fn    func(sent, total int64)
+}
+
+func (t *progressReader) Read(p []byte) (n int, err error) {
+	n, err = t.Reader.Read(p)
+	if n > 0 {
+		t.sent += int64(n)
+		t.fn(t.sent, t.total)
+	}
+
+	return n, err
+}
+
+// retryableUploadError network errors and retryable responses are retried, cancellations
+// and permanent failures (e.g. 4xx responses) are not.
+func retryableUploadError(err error) bool {
+	if r, ok := err.(interface{ Retryable() bool }); ok {
+		return r.Retryable()
+	}
+
+	return err != context.Canceled && err != context.DeadlineExceeded
+}
+
+// UploadFileV2Resumable uploads a file retrying failed transfers, see UploadFileV2ResumableContext.
+func (api *Client) UploadFileV2Resumable(params UploadFileV2Parameters, options ...ResumableUploadOption) (*FileSummary, error) {
+	return api.UploadFileV2ResumableContext(context.Background(), params, options...)
+}
+
+// UploadFileV2ResumableContext uploads a file like UploadFileV2Context but transfers the contents
+// using UploadToURLResumableContext, retrying failed transfers of large files over unreliable
+// links. The Reader of the parameters must implement either io.ReaderAt or io.Seeker so the
+// contents can be re-read.
+func (api *Client) UploadFileV2ResumableContext(ctx context.Context, params UploadFileV2Parameters, options ...ResumableUploadOption) (*FileSummary, error) {
+	var (
+		r    io.ReaderAt
+		size int
+	)
+
+	switch {
+	case params.Content != "":
+		r, size = strings.NewReader(params.Content), len(params.Content)
+	case params.File != "":
+		file, err := os.Open(params.File)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+
+		if params.Filename == "" {
+			params.Filename = filepath.Base(params.File)
+		}
+
+		r, size = file, int(info.Size())
+	case params.Reader != nil:
+		switch src := params.Reader.(type) {
+		case io.ReaderAt:
+			r = src
+		case io.ReadSeeker:
+			r = &readSeekerAt{ReadSeeker: src}
+		default:
+			return nil, fmt.Errorf("files.getUploadURLExternal: UploadFileV2Parameters.Reader must implement io.ReaderAt or io.Seeker for resumable uploads")
+		}
+
+		size = params.FileSize
+	}
+
+	if r == nil || size <= 0 {
+		return nil, fmt.Errorf("files.getUploadURLExternal: UploadFileV2Parameters requires a non-empty Content, File, or Reader with FileSize")
+	}
+
+	return api.uploadFileV2(ctx, params, size, func(ctx context.Context, uploadURL string) error {
+		return api.UploadToURLResumableContext(ctx, uploadURL, r, int64(size), options...)
+	})
+}
+
+// readSeekerAt adapts an io.ReadSeeker into an io.ReaderAt.
+type readSeekerAt struct {
+	io.ReadSeeker
+	m sync.Mutex
+}
+
+func (t *readSeekerAt) ReadAt(p []byte, off int64) (n int, err error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if _, err = t.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	if n, err = io.ReadFull(t.ReadSeeker, p); err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+
+	return n, err
+}
@@ -0,0 +1,162 @@
+package slack
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadFileV2(t *testing.T) {
+	var (
+		uploaded string
+	)
+
+	http.HandleFunc("/uploadv2/files.getUploadURLExternal", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "report.txt", r.FormValue("filename"))
+		assert.Equal(t, "11", r.FormValue("length"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"upload_url":"http://` + serverAddr + `/uploadv2/upload/F1","file_id":"F1"}`))
+	})
+	http.HandleFunc("/uploadv2/upload/F1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, int64(11), r.ContentLength)
+		body, _ := ioutil.ReadAll(r.Body)
+		uploaded = string(body)
+	})
+	http.HandleFunc("/uploadv2/files.completeUploadExternal", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `[{"id":"F1","title":"Report"}]`, r.FormValue("files"))
+		assert.Equal(t, "C1", r.FormValue("channel_id"))
+		assert.Equal(t, "123.456", r.FormValue("thread_ts"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"files":[{"id":"F1","title":"Report"}]}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/uploadv2/"))
+
+	file, err := api.UploadFileV2(UploadFileV2Parameters{
+		Reader:          strings.NewReader("hello world"),
+		FileSize:        11,
+		Filename:        "report.txt",
+		Title:           "Report",
+		Channel:         "C1",
+		ThreadTimestamp: "123.456",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &FileSummary{ID: "F1", Title: "Report"}, file)
+	assert.Equal(t, "hello world", uploaded)
+
+	_, err = api.UploadFileV2(UploadFileV2Parameters{Reader: strings.NewReader("hello"), Filename: "report.txt"})
+	assert.NotNil(t, err)
+}
+
+func TestUploadFileV2Resumable(t *testing.T) {
+	var (
+		m        sync.Mutex
+		bodies   []string
+		failures = 1
+	)
+
+	http.HandleFunc("/resumable/files.getUploadURLExternal", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "10", r.FormValue("length"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"upload_url":"http://` + serverAddr + `/resumable/upload/F1","file_id":"F1"}`))
+	})
+	http.HandleFunc("/resumable/upload/F1", func(w http.ResponseWriter, r *http.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		// fail the first attempt to exercise the retry.
+		if failures > 0 {
+			failures--
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+	})
+	http.HandleFunc("/resumable/files.completeUploadExternal", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"files":[{"id":"F1","title":"data.bin"}]}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/resumable/"))
+
+	var sent int64
+	file, err := api.UploadFileV2Resumable(
+		UploadFileV2Parameters{
+			Reader:   &seekOnly{ReadSeeker: strings.NewReader("0123456789")},
+			FileSize: 10,
+			Filename: "data.bin",
+		},
+		ResumableUploadOptionProgress(func(n, total int64) {
+			sent = n
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, &FileSummary{ID: "F1", Title: "data.bin"}, file)
+	// the whole file is resent by the retry.
+	assert.Equal(t, []string{"0123456789", "0123456789"}, bodies)
+	assert.Equal(t, int64(10), sent)
+
+	_, err = api.UploadFileV2Resumable(UploadFileV2Parameters{Reader: ioutil.NopCloser(strings.NewReader("x")), FileSize: 1, Filename: "x"})
+	assert.NotNil(t, err)
+}
+
+// seekOnly hides the io.ReaderAt implementation of the underlying reader.
+type seekOnly struct {
+	io.ReadSeeker
+}
+
+func TestUploadFilesV2(t *testing.T) {
+	var (
+		m        sync.Mutex
+		uploaded = map[string]string{}
+	)
+
+	http.HandleFunc("/uploadsv2/files.getUploadURLExternal", func(w http.ResponseWriter, r *http.Request) {
+		id := "F" + r.FormValue("filename")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"upload_url":"http://` + serverAddr + `/uploadsv2/upload/` + id + `","file_id":"` + id + `"}`))
+	})
+	http.HandleFunc("/uploadsv2/upload/", func(w http.ResponseWriter, r *http.Request) {
+		m.Lock()
+		defer m.Unlock()
+		body, _ := ioutil.ReadAll(r.Body)
+		uploaded[strings.TrimPrefix(r.URL.Path, "/uploadsv2/upload/")] = string(body)
+	})
+	http.HandleFunc("/uploadsv2/files.completeUploadExternal", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `[{"id":"Fa.txt","title":"a.txt"},{"id":"Fb.txt","title":"B"}]`, r.FormValue("files"))
+		assert.Equal(t, "C1", r.FormValue("channel_id"))
+		assert.Equal(t, "123.456", r.FormValue("thread_ts"))
+		assert.Equal(t, "reports", r.FormValue("initial_comment"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"files":[{"id":"Fa.txt","title":"a.txt"},{"id":"Fb.txt","title":"B"}]}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/uploadsv2/"))
+
+	files, err := api.UploadFilesV2(UploadFilesV2Parameters{
+		Files: []UploadFileV2Parameters{
+			{Content: "alpha", Filename: "a.txt"},
+			{Reader: strings.NewReader("beta"), FileSize: 4, Filename: "b.txt", Title: "B"},
+		},
+		Channel:         "C1",
+		ThreadTimestamp: "123.456",
+		InitialComment:  "reports",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []FileSummary{{ID: "Fa.txt", Title: "a.txt"}, {ID: "Fb.txt", Title: "B"}}, files)
+	assert.Equal(t, map[string]string{"Fa.txt": "alpha", "Fb.txt": "beta"}, uploaded)
+
+	_, err = api.UploadFilesV2(UploadFilesV2Parameters{})
+	assert.Equal(t, ErrParametersMissing, err)
+}
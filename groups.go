@@ -3,7 +3,6 @@ package slack
 import (
 	"context"
 	"net/url"
-	"strconv"
 )
 
 // Group contains all the information for a group
@@ -27,6 +26,15 @@ type groupResponseFull struct {
 	SlackResponse
 }
 
+// groupFromChannel converts a private channel returned by the conversations api into a Group.
+func groupFromChannel(ch *Channel) *Group {
+	if ch == nil {
+		return nil
+	}
+
+	return &Group{GroupConversation: ch.GroupConversation, IsGroup: true}
+}
+
 func (api *Client) groupRequest(ctx context.Context, path string, values url.Values) (*groupResponseFull, error) {
 	response := &groupResponseFull{}
 	err := api.postMethod(ctx, path, values, response)
@@ -44,13 +52,7 @@ func (api *Client) ArchiveGroup(group string) error {
 
 // ArchiveGroupContext archives a private group
 func (api *Client) ArchiveGroupContext(ctx context.Context, group string) error {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {group},
-	}
-
-	_, err := api.groupRequest(ctx, "groups.archive", values)
-	return err
+	return api.ArchiveConversationContext(ctx, group)
 }
 
 // UnarchiveGroup unarchives a private group
@@ -60,13 +62,7 @@ func (api *Client) UnarchiveGroup(group string) error {
 
 // UnarchiveGroupContext unarchives a private group
 func (api *Client) UnarchiveGroupContext(ctx context.Context, group string) error {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {group},
-	}
-
-	_, err := api.groupRequest(ctx, "groups.unarchive", values)
-	return err
+	return api.UnArchiveConversationContext(ctx, group)
 }
 
 // CreateGroup creates a private group
@@ -76,16 +72,11 @@ func (api *Client) CreateGroup(group string) (*Group, error) {
 
 // CreateGroupContext creates a private group
 func (api *Client) CreateGroupContext(ctx context.Context, group string) (*Group, error) {
-	values := url.Values{
-		"token": {api.token},
-		"name":  {group},
-	}
-
-	response, err := api.groupRequest(ctx, "groups.create", values)
+	channel, err := api.CreateConversationContext(ctx, group, true)
 	if err != nil {
 		return nil, err
 	}
-	return &response.Group, nil
+	return groupFromChannel(channel), nil
 }
 
 // CreateChildGroup creates a new private group archiving the old one
@@ -100,6 +91,7 @@ func (api *Client) CreateChildGroup(group string) (*Group, error) {
 
 // CreateChildGroupContext creates a new private group archiving the old one with a custom context
 // For more information see CreateChildGroup
+// conversations has no equivalent of groups.createChild so this method still uses the groups api.
 func (api *Client) CreateChildGroupContext(ctx context.Context, group string) (*Group, error) {
 	values := url.Values{
 		"token":   {api.token},
@@ -120,39 +112,7 @@ func (api *Client) GetGroupHistory(group string, params HistoryParameters) (*His
 
 // GetGroupHistoryContext fetches all the history for a private group with a custom context
 func (api *Client) GetGroupHistoryContext(ctx context.Context, group string, params HistoryParameters) (*History, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {group},
-	}
-	if params.Latest != DEFAULT_HISTORY_LATEST {
-		values.Add("latest", params.Latest)
-	}
-	if params.Oldest != DEFAULT_HISTORY_OLDEST {
-		values.Add("oldest", params.Oldest)
-	}
-	if params.Count != DEFAULT_HISTORY_COUNT {
-		values.Add("count", strconv.Itoa(params.Count))
-	}
-	if params.Inclusive != DEFAULT_HISTORY_INCLUSIVE {
-		if params.Inclusive {
-			values.Add("inclusive", "1")
-		} else {
-			values.Add("inclusive", "0")
-		}
-	}
-	if params.Unreads != DEFAULT_HISTORY_UNREADS {
-		if params.Unreads {
-			values.Add("unreads", "1")
-		} else {
-			values.Add("unreads", "0")
-		}
-	}
-
-	response, err := api.groupRequest(ctx, "groups.history", values)
-	if err != nil {
-		return nil, err
-	}
-	return &response.History, nil
+	return api.conversationHistory(ctx, group, params)
 }
 
 // InviteUserToGroup invites a specific user to a private group
@@ -162,17 +122,16 @@ func (api *Client) InviteUserToGroup(group, user string) (*Group, bool, error) {
 
 // InviteUserToGroupContext invites a specific user to a private group with a custom context
 func (api *Client) InviteUserToGroupContext(ctx context.Context, group, user string) (*Group, bool, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {group},
-		"user":    {user},
+	channel, err := api.InviteUsersToConversationContext(ctx, group, user)
+	if isSlackError(err, "already_in_channel") {
+		channel, err = api.GetConversationInfoContext(ctx, group, false)
+		return groupFromChannel(channel), true, err
 	}
 
-	response, err := api.groupRequest(ctx, "groups.invite", values)
 	if err != nil {
 		return nil, false, err
 	}
-	return &response.Group, response.AlreadyInGroup, nil
+	return groupFromChannel(channel), false, nil
 }
 
 // LeaveGroup makes authenticated user leave the group
@@ -182,12 +141,7 @@ func (api *Client) LeaveGroup(group string) error {
 
 // LeaveGroupContext makes authenticated user leave the group with a custom context
 func (api *Client) LeaveGroupContext(ctx context.Context, group string) (err error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {group},
-	}
-
-	_, err = api.groupRequest(ctx, "groups.leave", values)
+	_, err = api.LeaveConversationContext(ctx, group)
 	return err
 }
 
@@ -198,14 +152,7 @@ func (api *Client) KickUserFromGroup(group, user string) error {
 
 // KickUserFromGroupContext kicks a user from a group with a custom context
 func (api *Client) KickUserFromGroupContext(ctx context.Context, group, user string) (err error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {group},
-		"user":    {user},
-	}
-
-	_, err = api.groupRequest(ctx, "groups.kick", values)
-	return err
+	return api.KickUserFromConversationContext(ctx, group, user)
 }
 
 // GetGroups retrieves all groups
@@ -213,20 +160,28 @@ func (api *Client) GetGroups(excludeArchived bool) ([]Group, error) {
 	return api.GetGroupsContext(context.Background(), excludeArchived)
 }
 
-// GetGroupsContext retrieves all groups with a custom context
+// GetGroupsContext retrieves all groups with a custom context,
+// following the pagination cursors of conversations.list.
 func (api *Client) GetGroupsContext(ctx context.Context, excludeArchived bool) ([]Group, error) {
 	values := url.Values{
 		"token": {api.token},
+		"types": {ConversationTypePrivateChannel},
 	}
 	if excludeArchived {
-		values.Add("exclude_archived", "1")
+		values.Add("exclude_archived", "true")
 	}
 
-	response, err := api.groupRequest(ctx, "groups.list", values)
+	conversations, err := api.listConversations(ctx, values)
 	if err != nil {
 		return nil, err
 	}
-	return response.Groups, nil
+
+	groups := make([]Group, 0, len(conversations))
+	for _, c := range conversations {
+		groups = append(groups, *groupFromChannel(&c.Channel))
+	}
+
+	return groups, nil
 }
 
 // GetGroupInfo retrieves the given group
@@ -236,17 +191,11 @@ func (api *Client) GetGroupInfo(group string) (*Group, error) {
 
 // GetGroupInfoContext retrieves the given group with a custom context
 func (api *Client) GetGroupInfoContext(ctx context.Context, group string) (*Group, error) {
-	values := url.Values{
-		"token":          {api.token},
-		"channel":        {group},
-		"include_locale": {strconv.FormatBool(true)},
-	}
-
-	response, err := api.groupRequest(ctx, "groups.info", values)
+	channel, err := api.GetConversationInfoContext(ctx, group, true)
 	if err != nil {
 		return nil, err
 	}
-	return &response.Group, nil
+	return groupFromChannel(channel), nil
 }
 
 // SetGroupReadMark sets the read mark on a private group
@@ -261,14 +210,7 @@ func (api *Client) SetGroupReadMark(group, ts string) error {
 // SetGroupReadMarkContext sets the read mark on a private group with a custom context
 // For more details see SetGroupReadMark
 func (api *Client) SetGroupReadMarkContext(ctx context.Context, group, ts string) (err error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {group},
-		"ts":      {ts},
-	}
-
-	_, err = api.groupRequest(ctx, "groups.mark", values)
-	return err
+	return api.MarkConversationContext(ctx, group, ts)
 }
 
 // OpenGroup opens a private group
@@ -278,78 +220,59 @@ func (api *Client) OpenGroup(group string) (bool, bool, error) {
 
 // OpenGroupContext opens a private group with a custom context
 func (api *Client) OpenGroupContext(ctx context.Context, group string) (bool, bool, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {group},
-	}
-
-	response, err := api.groupRequest(ctx, "groups.open", values)
+	_, noOp, alreadyOpen, err := api.OpenConversationContext(ctx, &OpenConversationParameters{ChannelID: group})
 	if err != nil {
 		return false, false, err
 	}
-	return response.NoOp, response.AlreadyOpen, nil
+	return noOp, alreadyOpen, nil
 }
 
 // RenameGroup renames a group
-// XXX: They return a channel, not a group. What is this crap? :(
-// Inconsistent api it seems.
+//
+// Deprecated: use RenameConversation.
 func (api *Client) RenameGroup(group, name string) (*Channel, error) {
 	return api.RenameGroupContext(context.Background(), group, name)
 }
 
 // RenameGroupContext renames a group with a custom context
+//
+// Deprecated: use RenameConversationContext.
 func (api *Client) RenameGroupContext(ctx context.Context, group, name string) (*Channel, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {group},
-		"name":    {name},
-	}
-
-	// XXX: the created entry in this call returns a string instead of a number
-	// so I may have to do some workaround to solve it.
-	response, err := api.groupRequest(ctx, "groups.rename", values)
-	if err != nil {
-		return nil, err
-	}
-	return &response.Channel, nil
+	return api.RenameConversationContext(ctx, group, name)
 }
 
 // SetGroupPurpose sets the group purpose
+//
+// Deprecated: use SetConversationPurpose.
 func (api *Client) SetGroupPurpose(group, purpose string) (string, error) {
 	return api.SetGroupPurposeContext(context.Background(), group, purpose)
 }
 
 // SetGroupPurposeContext sets the group purpose with a custom context
+//
+// Deprecated: use SetConversationPurposeContext.
 func (api *Client) SetGroupPurposeContext(ctx context.Context, group, purpose string) (string, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {group},
-		"purpose": {purpose},
-	}
-
-	response, err := api.groupRequest(ctx, "groups.setPurpose", values)
+	channel, err := api.SetConversationPurposeContext(ctx, group, purpose)
 	if err != nil {
 		return "", err
 	}
-	return response.Purpose, nil
+	return channel.Purpose.Value, nil
 }
 
 // SetGroupTopic sets the group topic
+//
+// Deprecated: use SetConversationTopic.
 func (api *Client) SetGroupTopic(group, topic string) (string, error) {
 	return api.SetGroupTopicContext(context.Background(), group, topic)
 }
 
 // SetGroupTopicContext sets the group topic with a custom context
+//
+// Deprecated: use SetConversationTopicContext.
 func (api *Client) SetGroupTopicContext(ctx context.Context, group, topic string) (string, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {group},
-		"topic":   {topic},
-	}
-
-	response, err := api.groupRequest(ctx, "groups.setTopic", values)
+	channel, err := api.SetConversationTopicContext(ctx, group, topic)
 	if err != nil {
 		return "", err
 	}
-	return response.Topic, nil
+	return channel.Topic.Value, nil
 }
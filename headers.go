@@ -0,0 +1,27 @@
+package slack
+
+import "net/http"
+
+// Version of the library, reported in the User-Agent of requests, see OptionUserAgent.
+const Version = "0.6.0"
+
+// LibraryUserAgent identifies the library in the User-Agent of requests.
+const LibraryUserAgent = "nlopes-slack/" + Version
+
+// headerClient attaches static headers to every request.
+type headerClient struct {
+	httpClient
+	headers http.Header
+}
+
+func (t headerClient) Do(req *http.Request) (*http.Response, error) {
+	applyHeaders(req.Header, t.headers)
+	return t.httpClient.Do(req)
+}
+
+// applyHeaders copies the headers into dst, replacing any existing values.
+func applyHeaders(dst, headers http.Header) {
+	for k, v := range headers {
+		dst[http.CanonicalHeaderKey(k)] = append([]string(nil), v...)
+	}
+}
@@ -0,0 +1,51 @@
+package slack
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionHeaders(t *testing.T) {
+	var (
+		seen []string
+	)
+
+	record := func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get("X-Egress-Auth"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true}`))
+	}
+	http.HandleFunc("/headers/auth.test", record)
+	http.HandleFunc("/headers/upload", record)
+
+	once.Do(startServer)
+	api := New(
+		"testing-token",
+		OptionAPIURL("http://"+serverAddr+"/headers/"),
+		OptionHeaders(http.Header{"x-egress-auth": {"secret"}}),
+	)
+
+	_, err := api.AuthTest()
+	assert.Nil(t, err)
+	assert.Nil(t, api.UploadToURL("http://"+serverAddr+"/headers/upload", 5, strings.NewReader("hello")))
+	assert.Equal(t, []string{"secret", "secret"}, seen)
+}
+
+func TestOptionUserAgent(t *testing.T) {
+	var seen string
+	http.HandleFunc("/useragent/auth.test", func(w http.ResponseWriter, r *http.Request) {
+		seen = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/useragent/"), OptionUserAgent("my-app/1.2"))
+
+	_, err := api.AuthTest()
+	assert.Nil(t, err)
+	assert.Equal(t, "my-app/1.2 "+LibraryUserAgent, seen)
+}
@@ -1,5 +1,7 @@
 package slack
 
+import "context"
+
 const (
 	DEFAULT_HISTORY_LATEST    = ""
 	DEFAULT_HISTORY_OLDEST    = "0"
@@ -34,3 +36,33 @@ func NewHistoryParameters() HistoryParameters {
 		Unreads:   DEFAULT_HISTORY_UNREADS,
 	}
 }
+
+// conversationHistory retrieves history using conversations.history on behalf of the
+// legacy channel, group, and im methods. Unreads is not supported by conversations.history
+// and is ignored, Count maps to the page limit.
+func (api *Client) conversationHistory(ctx context.Context, channelID string, params HistoryParameters) (*History, error) {
+	cparams := GetConversationHistoryParameters{
+		ChannelID: channelID,
+		Inclusive: params.Inclusive,
+	}
+	if params.Latest != DEFAULT_HISTORY_LATEST {
+		cparams.Latest = params.Latest
+	}
+	if params.Oldest != DEFAULT_HISTORY_OLDEST {
+		cparams.Oldest = params.Oldest
+	}
+	if params.Count != DEFAULT_HISTORY_COUNT {
+		cparams.Limit = params.Count
+	}
+
+	response, err := api.GetConversationHistoryContext(ctx, &cparams)
+	if err != nil {
+		return nil, err
+	}
+
+	return &History{
+		Latest:   response.Latest,
+		Messages: response.Messages,
+		HasMore:  response.HasMore,
+	}, nil
+}
@@ -0,0 +1,226 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// HistoryStreamOption options for streaming conversation history.
+type HistoryStreamOption func(*HistoryStream)
+
+// HistoryStreamOptionBuffer number of messages buffered by the stream, defaults to 0.
+func HistoryStreamOptionBuffer(n int) HistoryStreamOption {
+	return func(t *HistoryStream) {
+		t.buffer = n
+	}
+}
+
+// HistoryStreamOptionCheckpoint invoked with the cursor of the next page once every
+// message of the current page has been received from the stream. persisting the cursor
+// allows an export to be resumed by providing it as the Cursor parameter. the cursor is
+// empty once the history is exhausted. returning an error stops the stream.
+func HistoryStreamOptionCheckpoint(fn func(cursor string) error) HistoryStreamOption {
+	return func(t *HistoryStream) {
+		t.checkpoint = fn
+	}
+}
+
+// HistoryStream streams the messages of a conversation one page at a time,
+// see StreamConversationHistoryContext.
+type HistoryStream struct {
+	// C receives the messages of the conversation, closed once the history is
+	// exhausted, the context is cancelled, or an error occurs.
+	C <-chan Message
+
+	buffer     int
+	checkpoint func(cursor string) error
+	m          sync.Mutex
+	cursor     string
+	err        error
+}
+
+// Err returns the error which stopped the stream, only valid once C is closed.
+func (t *HistoryStream) Err() error {
+	t.m.Lock()
+	defer t.m.Unlock()
+	return t.err
+}
+
+// Cursor returns the cursor of the page currently being streamed, resuming from
+// this cursor may redeliver messages which were already received.
+func (t *HistoryStream) Cursor() string {
+	t.m.Lock()
+	defer t.m.Unlock()
+	return t.cursor
+}
+
+func (t *HistoryStream) update(cursor string, err error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.cursor = cursor
+	t.err = err
+}
+
+// StreamConversationHistory streams the messages of a conversation, see StreamConversationHistoryContext.
+func (api *Client) StreamConversationHistory(params GetConversationHistoryParameters, options ...HistoryStreamOption) *HistoryStream {
+	return api.StreamConversationHistoryContext(context.Background(), params, options...)
+}
+
+// StreamConversationHistoryContext streams the messages of a conversation with a custom context.
+// messages are decoded from the response one at a time as they're received from the stream, page by
+// page. Suitable for exporting large conversations without holding the entire history in memory.
+func (api *Client) StreamConversationHistoryContext(ctx context.Context, params GetConversationHistoryParameters, options ...HistoryStreamOption) *HistoryStream {
+	stream := &HistoryStream{
+		cursor: params.Cursor,
+	}
+
+	for _, opt := range options {
+		opt(stream)
+	}
+
+	messages := make(chan Message, stream.buffer)
+	stream.C = messages
+
+	go func() {
+		defer close(messages)
+		stream.update(api.streamHistory(ctx, stream, params, messages))
+	}()
+
+	return stream
+}
+
+func (api *Client) streamHistory(ctx context.Context, stream *HistoryStream, params GetConversationHistoryParameters, messages chan<- Message) (string, error) {
+	for {
+		stream.update(params.Cursor, nil)
+
+		var next string
+		err := api.retryRateLimited(ctx, func() (err error) {
+			next, err = api.streamHistoryPage(ctx, params, func(m Message) error {
+				select {
+				case messages <- m:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+			return err
+		})
+		if err != nil {
+			return params.Cursor, err
+		}
+
+		if stream.checkpoint != nil {
+			if err = stream.checkpoint(next); err != nil {
+				return next, err
+			}
+		}
+
+		if next == "" {
+			return "", nil
+		}
+
+		params.Cursor = next
+	}
+}
+
+// streamHistoryPage requests a single page of conversations.history decoding
+// the messages incrementally, returns the cursor of the next page.
+func (api *Client) streamHistoryPage(ctx context.Context, params GetConversationHistoryParameters, fn func(Message) error) (string, error) {
+	req, err := formReq(api.endpoint+"conversations.history", params.values(api.token))
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := api.httpclient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if err = checkStatusCode(resp, api); err != nil {
+		return "", err
+	}
+
+	return decodeHistoryPage(resp.Body, fn)
+}
+
+// decodeHistoryPage decodes a conversations.history response invoking fn for each
+// message as it is decoded, returns the cursor of the next page.
+func decodeHistoryPage(r io.Reader, fn func(Message) error) (string, error) {
+	var (
+		response struct {
+			SlackResponse
+			ResponseMetaData responseMetaData `json:"response_metadata"`
+		}
+	)
+
+	d := json.NewDecoder(r)
+	if err := expectDelim(d, '{'); err != nil {
+		return "", err
+	}
+
+	for d.More() {
+		token, err := d.Token()
+		if err != nil {
+			return "", err
+		}
+
+		switch token {
+		case "ok":
+			err = d.Decode(&response.Ok)
+		case "error":
+			err = d.Decode(&response.Error)
+		case "response_metadata":
+			err = d.Decode(&response.ResponseMetaData)
+		case "messages":
+			err = decodeHistoryMessages(d, fn)
+		default:
+			err = d.Decode(&json.RawMessage{})
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if err := response.Err(); err != nil {
+		return "", err
+	}
+
+	return response.ResponseMetaData.NextCursor, nil
+}
+
+func decodeHistoryMessages(d *json.Decoder, fn func(Message) error) error {
+	if err := expectDelim(d, '['); err != nil {
+		return err
+	}
+
+	for d.More() {
+		m := Message{}
+		if err := d.Decode(&m); err != nil {
+			return err
+		}
+
+		if err := fn(m); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(d, ']')
+}
+
+func expectDelim(d *json.Decoder, delim json.Delim) error {
+	token, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("unexpected json token %v, expected %v", token, delim)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamConversationHistory(t *testing.T) {
+	http.HandleFunc("/stream/conversations.history", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "C1", r.FormValue("channel"))
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cursor") {
+		case "":
+			rw.Write([]byte(`{"ok":true,"messages":[{"type":"message","ts":"3.0","text":"c"},{"type":"message","ts":"2.0","text":"b"}],"has_more":true,"pin_count":0,"response_metadata":{"next_cursor":"page2"}}`))
+		case "page2":
+			rw.Write([]byte(`{"ok":true,"messages":[{"type":"message","ts":"1.0","text":"a"}],"has_more":false}`))
+		case "broken":
+			rw.Write([]byte(`{"ok":false,"error":"invalid_cursor"}`))
+		}
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/stream/"))
+
+	var checkpoints []string
+	stream := api.StreamConversationHistory(
+		GetConversationHistoryParameters{ChannelID: "C1"},
+		HistoryStreamOptionCheckpoint(func(cursor string) error {
+			checkpoints = append(checkpoints, cursor)
+			return nil
+		}),
+	)
+
+	var texts []string
+	for m := range stream.C {
+		texts = append(texts, m.Text)
+	}
+
+	assert.Nil(t, stream.Err())
+	assert.Equal(t, []string{"c", "b", "a"}, texts)
+	assert.Equal(t, []string{"page2", ""}, checkpoints)
+	assert.Equal(t, "", stream.Cursor())
+
+	stream = api.StreamConversationHistory(GetConversationHistoryParameters{ChannelID: "C1", Cursor: "broken"})
+	for range stream.C {
+	}
+	assert.EqualError(t, stream.Err(), "invalid_cursor")
+	assert.Equal(t, "broken", stream.Cursor())
+}
@@ -3,39 +3,14 @@ package slack
 import (
 	"context"
 	"net/url"
-	"strconv"
 )
 
-type imChannel struct {
-	ID string `json:"id"`
-}
-
-type imResponseFull struct {
-	NoOp          bool      `json:"no_op"`
-	AlreadyClosed bool      `json:"already_closed"`
-	AlreadyOpen   bool      `json:"already_open"`
-	Channel       imChannel `json:"channel"`
-	IMs           []IM      `json:"ims"`
-	History
-	SlackResponse
-}
-
 // IM contains information related to the Direct Message channel
 type IM struct {
 	Conversation
 	IsUserDeleted bool `json:"is_user_deleted"`
 }
 
-func (api *Client) imRequest(ctx context.Context, path string, values url.Values) (*imResponseFull, error) {
-	response := &imResponseFull{}
-	err := api.postMethod(ctx, path, values, response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, response.Err()
-}
-
 // CloseIMChannel closes the direct message channel
 func (api *Client) CloseIMChannel(channel string) (bool, bool, error) {
 	return api.CloseIMChannelContext(context.Background(), channel)
@@ -43,16 +18,7 @@ func (api *Client) CloseIMChannel(channel string) (bool, bool, error) {
 
 // CloseIMChannelContext closes the direct message channel with a custom context
 func (api *Client) CloseIMChannelContext(ctx context.Context, channel string) (bool, bool, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channel},
-	}
-
-	response, err := api.imRequest(ctx, "im.close", values)
-	if err != nil {
-		return false, false, err
-	}
-	return response.NoOp, response.AlreadyClosed, nil
+	return api.CloseConversationContext(ctx, channel)
 }
 
 // OpenIMChannel opens a direct message channel to the user provided as argument
@@ -64,16 +30,16 @@ func (api *Client) OpenIMChannel(user string) (bool, bool, string, error) {
 // OpenIMChannelContext opens a direct message channel to the user provided as argument with a custom context
 // Returns some status and the channel ID
 func (api *Client) OpenIMChannelContext(ctx context.Context, user string) (bool, bool, string, error) {
-	values := url.Values{
-		"token": {api.token},
-		"user":  {user},
-	}
-
-	response, err := api.imRequest(ctx, "im.open", values)
+	channel, noOp, alreadyOpen, err := api.OpenConversationContext(ctx, &OpenConversationParameters{Users: []string{user}})
 	if err != nil {
 		return false, false, "", err
 	}
-	return response.NoOp, response.AlreadyOpen, response.Channel.ID, nil
+
+	if channel == nil {
+		return noOp, alreadyOpen, "", nil
+	}
+
+	return noOp, alreadyOpen, channel.ID, nil
 }
 
 // MarkIMChannel sets the read mark of a direct message channel to a specific point
@@ -83,14 +49,7 @@ func (api *Client) MarkIMChannel(channel, ts string) (err error) {
 
 // MarkIMChannelContext sets the read mark of a direct message channel to a specific point with a custom context
 func (api *Client) MarkIMChannelContext(ctx context.Context, channel, ts string) error {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channel},
-		"ts":      {ts},
-	}
-
-	_, err := api.imRequest(ctx, "im.mark", values)
-	return err
+	return api.MarkConversationContext(ctx, channel, ts)
 }
 
 // GetIMHistory retrieves the direct message channel history
@@ -100,39 +59,7 @@ func (api *Client) GetIMHistory(channel string, params HistoryParameters) (*Hist
 
 // GetIMHistoryContext retrieves the direct message channel history with a custom context
 func (api *Client) GetIMHistoryContext(ctx context.Context, channel string, params HistoryParameters) (*History, error) {
-	values := url.Values{
-		"token":   {api.token},
-		"channel": {channel},
-	}
-	if params.Latest != DEFAULT_HISTORY_LATEST {
-		values.Add("latest", params.Latest)
-	}
-	if params.Oldest != DEFAULT_HISTORY_OLDEST {
-		values.Add("oldest", params.Oldest)
-	}
-	if params.Count != DEFAULT_HISTORY_COUNT {
-		values.Add("count", strconv.Itoa(params.Count))
-	}
-	if params.Inclusive != DEFAULT_HISTORY_INCLUSIVE {
-		if params.Inclusive {
-			values.Add("inclusive", "1")
-		} else {
-			values.Add("inclusive", "0")
-		}
-	}
-	if params.Unreads != DEFAULT_HISTORY_UNREADS {
-		if params.Unreads {
-			values.Add("unreads", "1")
-		} else {
-			values.Add("unreads", "0")
-		}
-	}
-
-	response, err := api.imRequest(ctx, "im.history", values)
-	if err != nil {
-		return nil, err
-	}
-	return &response.History, nil
+	return api.conversationHistory(ctx, channel, params)
 }
 
 // GetIMChannels returns the list of direct message channels
@@ -140,15 +67,23 @@ func (api *Client) GetIMChannels() ([]IM, error) {
 	return api.GetIMChannelsContext(context.Background())
 }
 
-// GetIMChannelsContext returns the list of direct message channels with a custom context
+// GetIMChannelsContext returns the list of direct message channels with a custom context,
+// following the pagination cursors of conversations.list.
 func (api *Client) GetIMChannelsContext(ctx context.Context) ([]IM, error) {
 	values := url.Values{
 		"token": {api.token},
+		"types": {ConversationTypeIM},
 	}
 
-	response, err := api.imRequest(ctx, "im.list", values)
+	conversations, err := api.listConversations(ctx, values)
 	if err != nil {
 		return nil, err
 	}
-	return response.IMs, nil
+
+	ims := make([]IM, 0, len(conversations))
+	for _, c := range conversations {
+		ims = append(ims, IM{Conversation: c.Conversation, IsUserDeleted: c.IsUserDeleted})
+	}
+
+	return ims, nil
 }
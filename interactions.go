@@ -23,6 +23,9 @@ const (
 	InteractionTypeInteractionMessage = InteractionType("interactive_message")
 	InteractionTypeMessageAction      = InteractionType("message_action")
 	InteractionTypeBlockActions       = InteractionType("block_actions")
+	InteractionTypeBlockSuggestion    = InteractionType("block_suggestion")
+	InteractionTypeViewSubmission     = InteractionType("view_submission")
+	InteractionTypeViewClosed         = InteractionType("view_closed")
 )
 
 // InteractionCallback is sent from slack when a user interactions with a button or dialog.
@@ -42,7 +45,10 @@ type InteractionCallback struct {
 	Value           string          `json:"value"`
 	MessageTs       string          `json:"message_ts"`
 	AttachmentID    string          `json:"attachment_id"`
+	ActionID        string          `json:"action_id"`
+	BlockID         string          `json:"block_id"`
 	ActionCallback  ActionCallbacks `json:"actions"`
+	View            View            `json:"view"`
 	DialogSubmissionCallback
 }
 
@@ -76,7 +82,7 @@ func (a *ActionCallbacks) UnmarshalJSON(data []byte) error {
 			}
 
 			a.BlockActions = append(a.BlockActions, action.(*BlockAction))
-			return nil
+			continue
 		}
 
 		action, err := unmarshalAction(r, &AttachmentAction{})
@@ -0,0 +1,117 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+type interactionContextKey int
+
+const (
+	rawInteractionKey interactionContextKey = iota
+)
+
+// InteractionHandlerFunc handles a parsed interaction callback. a non-nil response is sent as the
+// json body of the reply, e.g. a *ViewSubmissionResponse or the OptionsResponse of a block_suggestion.
+type InteractionHandlerFunc func(ctx context.Context, cb InteractionCallback) (interface{}, error)
+
+// InteractionHandlerOption options for NewInteractionHandler.
+type InteractionHandlerOption func(*interactionHandler)
+
+// InteractionHandlerOptionRawBody retains the raw request of every interaction, available to
+// the handler via RawInteractionFromContext. useful for re-verifying the signature, archiving
+// or forwarding the request to another service since the body is consumed by the handler.
+func InteractionHandlerOptionRawBody() InteractionHandlerOption {
+	return func(t *interactionHandler) {
+		t.raw = true
+	}
+}
+
+// RawInteraction the request which delivered an interaction, see InteractionHandlerOptionRawBody.
+type RawInteraction struct {
+	// Header the headers of the request, including the X-Slack-Signature headers.
+	Header http.Header
+	// Body the unmodified form encoded body of the request.
+	Body []byte
+	// Payload the json encoded interaction extracted from the body.
+	Payload json.RawMessage
+}
+
+// RawInteractionFromContext returns the raw request of the interaction being handled, the boolean
+// reports whether the context carries the raw request.
+func RawInteractionFromContext(ctx context.Context) (RawInteraction, bool) {
+	raw, ok := ctx.Value(rawInteractionKey).(RawInteraction)
+	return raw, ok
+}
+
+type interactionHandler struct {
+	fn  InteractionHandlerFunc
+	raw bool
+}
+
+// NewInteractionHandler builds an http.Handler receiving the interaction requests sent to the
+// request url of the app. requests which fail to parse are rejected with a 400 and errors returned
+// by fn result in a 500, except ViewValidationErrors which are sent to slack to be displayed in the
+// modal, see ViewValidationErrors.Response.
+//
+// requests should be authenticated before reaching the handler, e.g. with SecretsVerifier.
+func NewInteractionHandler(fn InteractionHandlerFunc, options ...InteractionHandlerOption) http.Handler {
+	t := interactionHandler{fn: fn}
+	for _, opt := range options {
+		opt(&t)
+	}
+
+	return t
+}
+
+func (t interactionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var cb InteractionCallback
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	payload := json.RawMessage(form.Get("payload"))
+	if err = json.Unmarshal(payload, &cb); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if t.raw {
+		ctx = context.WithValue(ctx, rawInteractionKey, RawInteraction{
+			Header:  r.Header.Clone(),
+			Body:    body,
+			Payload: payload,
+		})
+	}
+
+	resp, err := t.fn(ctx, cb)
+	if verr, ok := err.(ViewValidationErrors); ok {
+		resp, err = verr.Response(), nil
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if resp == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,85 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestInteractionHandlerRawBody(t *testing.T) {
+	var (
+		raw RawInteraction
+		ok  bool
+		cb  InteractionCallback
+	)
+
+	payload := `{"type":"block_actions","callback_id":"cb1","team":{"id":"T1"}}`
+	body := url.Values{"payload": {payload}}.Encode()
+
+	handler := NewInteractionHandler(func(ctx context.Context, c InteractionCallback) (interface{}, error) {
+		cb = c
+		raw, ok = RawInteractionFromContext(ctx)
+		return nil, nil
+	}, InteractionHandlerOptionRawBody())
+
+	req := httptest.NewRequest(http.MethodPost, "/interactions", strings.NewReader(body))
+	req.Header.Set("X-Slack-Signature", "v0=abc")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK || cb.Type != InteractionTypeBlockActions || cb.CallbackID != "cb1" {
+		t.Fatalf("unexpected response %d %#v", rec.Code, cb)
+	}
+
+	if !ok || string(raw.Body) != body || string(raw.Payload) != payload || raw.Header.Get("X-Slack-Signature") != "v0=abc" {
+		t.Errorf("unexpected raw interaction %v %#v", ok, raw)
+	}
+}
+
+func TestInteractionHandler(t *testing.T) {
+	handler := NewInteractionHandler(func(ctx context.Context, c InteractionCallback) (interface{}, error) {
+		if _, ok := RawInteractionFromContext(ctx); ok {
+			t.Error("expected the raw body to be discarded")
+		}
+		return nil, errors.New("failed")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/interactions", strings.NewReader(url.Values{"payload": {`{"type":"block_actions"}`}}.Encode()))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected a 500, got %d", rec.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/interactions", strings.NewReader("payload=not-json"))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected a 400, got %d", rec.Code)
+	}
+}
+
+func TestInteractionHandlerResponse(t *testing.T) {
+	handler := NewInteractionHandler(func(ctx context.Context, c InteractionCallback) (interface{}, error) {
+		if c.View.CallbackID == "invalid" {
+			return nil, ViewValidationErrors{"name": "required"}
+		}
+		return NewClearViewSubmissionResponse(), nil
+	})
+
+	for payload, expected := range map[string]string{
+		`{"type":"view_submission","view":{"callback_id":"valid"}}`:   `{"response_action":"clear"}`,
+		`{"type":"view_submission","view":{"callback_id":"invalid"}}`: `{"response_action":"errors","errors":{"name":"required"}}`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/interactions", strings.NewReader(url.Values{"payload": {payload}}.Encode()))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK || strings.TrimSpace(rec.Body.String()) != expected || rec.Header().Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected response %d %s", rec.Code, rec.Body.String())
+		}
+	}
+}
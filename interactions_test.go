@@ -83,3 +83,33 @@ func TestDialogCallback(t *testing.T) {
 func TestActionCallback(t *testing.T) {
 	assertInteractionCallback(t, InteractionCallback{}, actionCallback)
 }
+
+func TestOverflowActionCallback(t *testing.T) {
+	const encoded = `{
+  "type": "block_actions",
+  "actions": [
+    {
+      "type": "overflow",
+      "action_id": "menu",
+      "block_id": "b1",
+      "selected_option": {"text": {"type": "plain_text", "text": "Docs"}, "value": "docs", "url": "https://api.slack.com"},
+      "action_ts": "1548426417.840180"
+    },
+    {
+      "type": "button",
+      "action_id": "ack",
+      "block_id": "b2",
+      "value": "ack",
+      "action_ts": "1548426417.840181"
+    }
+  ]
+}`
+	var decoded InteractionCallback
+	assert.Nil(t, json.Unmarshal([]byte(encoded), &decoded))
+	assert.Equal(t, 2, len(decoded.ActionCallback.BlockActions))
+	overflow := decoded.ActionCallback.BlockActions[0]
+	assert.Equal(t, actionType("overflow"), overflow.Type)
+	assert.Equal(t, "docs", overflow.SelectedOption.Value)
+	assert.Equal(t, "https://api.slack.com", overflow.SelectedOption.URL)
+	assert.Equal(t, "ack", decoded.ActionCallback.BlockActions[1].Value)
+}
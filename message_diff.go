@@ -0,0 +1,218 @@
+package slack
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// DiffOp the kind of change within a TextEdit.
+type DiffOp int
+
+// Text diff operations.
+const (
+	DiffEqual DiffOp = iota
+	DiffInsert
+	DiffDelete
+)
+
+// TextEdit a run of text that was kept, inserted, or deleted.
+type TextEdit struct {
+	Op   DiffOp
+	Text string
+}
+
+// MessageEditedEvent is emitted by the RTM after a message_changed event when
+// the previous version of the message is known, see RTMOptionMessageStore.
+type MessageEditedEvent struct {
+	Channel  string
+	Previous Msg
+	Current  Msg
+	// word level diff between the previous and current text.
+	TextDiff []TextEdit
+	// blocks which were added or modified by the edit.
+	ChangedBlocks []Block
+	// blocks which were removed by the edit.
+	RemovedBlocks []Block
+}
+
+// TextChanged reports whether the text of the message changed.
+func (t MessageEditedEvent) TextChanged() bool {
+	return t.Previous.Text != t.Current.Text
+}
+
+// NewMessageEditedEvent computes the changes between two versions of a message.
+func NewMessageEditedEvent(channelID string, previous, current Msg) *MessageEditedEvent {
+	changed, removed := diffBlocks(previous.Blocks.BlockSet, current.Blocks.BlockSet)
+	return &MessageEditedEvent{
+		Channel:       channelID,
+		Previous:      previous,
+		Current:       current,
+		TextDiff:      DiffText(previous.Text, current.Text),
+		ChangedBlocks: changed,
+		RemovedBlocks: removed,
+	}
+}
+
+// maxTextDiffCells bounds the size of the table used to diff the changed tokens, larger
+// changes are reported as a deletion of the previous text followed by an insertion.
+const maxTextDiffCells = 1 << 20
+
+// DiffText computes a word level diff between before and after. Concatenating the
+// text of the DiffEqual and DiffDelete edits reproduces before, while DiffEqual and
+// DiffInsert reproduces after. Large rewrites are reported as a single replacement
+// of the changed text to bound the memory used.
+func DiffText(before, after string) []TextEdit {
+	a, b := tokenize(before), tokenize(after)
+
+	edits := []TextEdit(nil)
+	push := func(op DiffOp, s string) {
+		if n := len(edits); n > 0 && edits[n-1].Op == op {
+			edits[n-1].Text += s
+			return
+		}
+		edits = append(edits, TextEdit{Op: op, Text: s})
+	}
+
+	// the common prefix and suffix are unchanged.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		push(DiffEqual, a[prefix])
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	common := a[len(a)-suffix:]
+	a, b = a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	if (len(a)+1)*(len(b)+1) > maxTextDiffCells {
+		if len(a) > 0 {
+			push(DiffDelete, strings.Join(a, ""))
+		}
+		if len(b) > 0 {
+			push(DiffInsert, strings.Join(b, ""))
+		}
+	} else {
+		diffTokens(a, b, push)
+	}
+
+	if len(common) > 0 {
+		push(DiffEqual, strings.Join(common, ""))
+	}
+
+	return edits
+}
+
+// diffTokens pushes the edits transforming a into b using their longest common subsequence.
+func diffTokens(a, b []string, push func(DiffOp, string)) {
+	// longest common subsequence table of the suffixes.
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			push(DiffEqual, a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			push(DiffDelete, a[i])
+			i++
+		default:
+			push(DiffInsert, b[j])
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		push(DiffDelete, a[i])
+	}
+
+	for ; j < len(b); j++ {
+		push(DiffInsert, b[j])
+	}
+}
+
+// tokenize splits the text into alternating runs of whitespace and non-whitespace.
+func tokenize(s string) []string {
+	var (
+		tokens []string
+		start  int
+	)
+
+	runes := []rune(s)
+	for idx := 1; idx <= len(runes); idx++ {
+		if idx == len(runes) || unicode.IsSpace(runes[idx]) != unicode.IsSpace(runes[start]) {
+			tokens = append(tokens, string(runes[start:idx]))
+			start = idx
+		}
+	}
+
+	return tokens
+}
+
+// diffBlocks matches blocks by block id, falling back to their position when
+// the block has no id.
+func diffBlocks(previous, current []Block) (changed, removed []Block) {
+	type encodedBlock struct {
+		block   Block
+		encoded string
+	}
+
+	index := func(blocks []Block) (keys []string, m map[string]encodedBlock) {
+		m = make(map[string]encodedBlock, len(blocks))
+		for idx, b := range blocks {
+			encoded, _ := json.Marshal(b)
+			id := struct {
+				BlockID string `json:"block_id"`
+			}{}
+			_ = json.Unmarshal(encoded, &id)
+
+			key := id.BlockID
+			if key == "" {
+				key = "\x00" + strconv.Itoa(idx)
+			}
+
+			keys = append(keys, key)
+			m[key] = encodedBlock{block: b, encoded: string(encoded)}
+		}
+		return keys, m
+	}
+
+	pkeys, prev := index(previous)
+	ckeys, cur := index(current)
+
+	for _, key := range ckeys {
+		if p, ok := prev[key]; !ok || p.encoded != cur[key].encoded {
+			changed = append(changed, cur[key].block)
+		}
+	}
+
+	for _, key := range pkeys {
+		if _, ok := cur[key]; !ok {
+			removed = append(removed, prev[key].block)
+		}
+	}
+
+	return changed, removed
+}
@@ -0,0 +1,59 @@
+package slack
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffText(t *testing.T) {
+	edits := DiffText("the quick brown fox", "the slow brown fox jumps")
+	assert.Equal(t, []TextEdit{
+		{Op: DiffEqual, Text: "the "},
+		{Op: DiffDelete, Text: "quick"},
+		{Op: DiffInsert, Text: "slow"},
+		{Op: DiffEqual, Text: " brown fox"},
+		{Op: DiffInsert, Text: " jumps"},
+	}, edits)
+
+	assert.Nil(t, DiffText("", ""))
+	assert.Equal(t, []TextEdit{{Op: DiffInsert, Text: "hello"}}, DiffText("", "hello"))
+}
+
+func TestDiffTextLargeRewrite(t *testing.T) {
+	before := "start " + strings.Repeat("old ", 10000) + "end"
+	after := "start " + strings.Repeat("new ", 10000) + "end"
+
+	// rewrites too large to diff are replaced as a whole, keeping the common prefix and suffix.
+	assert.Equal(t, []TextEdit{
+		{Op: DiffEqual, Text: "start "},
+		{Op: DiffDelete, Text: strings.TrimSpace(strings.Repeat("old ", 10000))},
+		{Op: DiffInsert, Text: strings.TrimSpace(strings.Repeat("new ", 10000))},
+		{Op: DiffEqual, Text: " end"},
+	}, DiffText(before, after))
+}
+
+func TestNewMessageEditedEvent(t *testing.T) {
+	previous := Msg{
+		Text: "hello",
+		Blocks: Blocks{BlockSet: []Block{
+			NewSectionBlock(NewTextBlockObject(MarkdownType, "one", false, false), nil, nil, SectionBlockOptionBlockID("s1")),
+			NewSectionBlock(NewTextBlockObject(MarkdownType, "two", false, false), nil, nil, SectionBlockOptionBlockID("s2")),
+		}},
+	}
+	current := Msg{
+		Text: "hello",
+		Blocks: Blocks{BlockSet: []Block{
+			NewSectionBlock(NewTextBlockObject(MarkdownType, "one", false, false), nil, nil, SectionBlockOptionBlockID("s1")),
+			NewSectionBlock(NewTextBlockObject(MarkdownType, "three", false, false), nil, nil, SectionBlockOptionBlockID("s3")),
+		}},
+	}
+
+	edited := NewMessageEditedEvent("C1", previous, current)
+	assert.False(t, edited.TextChanged())
+	assert.Equal(t, 1, len(edited.ChangedBlocks))
+	assert.Equal(t, "s3", edited.ChangedBlocks[0].(*SectionBlock).BlockID)
+	assert.Equal(t, 1, len(edited.RemovedBlocks))
+	assert.Equal(t, "s2", edited.RemovedBlocks[0].(*SectionBlock).BlockID)
+}
@@ -0,0 +1,142 @@
+package slack
+
+import "sync"
+
+// DefaultMessageStoreCapacity number of messages retained per channel by the MemoryMessageStore.
+const DefaultMessageStoreCapacity = 100
+
+// MessageStore retains recently observed messages, providing short term context
+// such as the previous version of an edited message. Appending a message with
+// the same channel and timestamp as an existing message replaces it, deleted
+// messages are removed.
+type MessageStore interface {
+	Append(m Message) error
+	Get(channelID, timestamp string) (Message, bool, error)
+	Latest(channelID string) (Message, bool, error)
+	Delete(channelID, timestamp string) error
+}
+
+// NewMemoryMessageStore retains the most recent messages of each channel in memory,
+// when capacity is less than 1 DefaultMessageStoreCapacity is used.
+func NewMemoryMessageStore(capacity int) *MemoryMessageStore {
+	if capacity < 1 {
+		capacity = DefaultMessageStoreCapacity
+	}
+
+	return &MemoryMessageStore{
+		capacity: capacity,
+		channels: make(map[string]*messageRing),
+	}
+}
+
+// MemoryMessageStore in memory implementation of the MessageStore backed by
+// a fixed size ring per channel.
+type MemoryMessageStore struct {
+	m        sync.Mutex
+	capacity int
+	channels map[string]*messageRing
+}
+
+// Append implements the MessageStore interface.
+func (t *MemoryMessageStore) Append(m Message) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	ring, ok := t.channels[m.Channel]
+	if !ok {
+		ring = &messageRing{messages: make([]Message, 0, t.capacity)}
+		t.channels[m.Channel] = ring
+	}
+
+	ring.append(m)
+	return nil
+}
+
+// Get implements the MessageStore interface.
+func (t *MemoryMessageStore) Get(channelID, timestamp string) (Message, bool, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if ring, ok := t.channels[channelID]; ok {
+		if idx := ring.index(timestamp); idx != -1 {
+			return ring.messages[idx], true, nil
+		}
+	}
+
+	return Message{}, false, nil
+}
+
+// Latest implements the MessageStore interface.
+func (t *MemoryMessageStore) Latest(channelID string) (Message, bool, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if ring, ok := t.channels[channelID]; ok && len(ring.messages) > 0 {
+		return ring.messages[ring.latest], true, nil
+	}
+
+	return Message{}, false, nil
+}
+
+// Delete implements the MessageStore interface.
+func (t *MemoryMessageStore) Delete(channelID, timestamp string) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if ring, ok := t.channels[channelID]; ok {
+		ring.remove(timestamp)
+	}
+
+	return nil
+}
+
+type messageRing struct {
+	messages []Message
+	latest   int
+}
+
+func (t *messageRing) append(m Message) {
+	if idx := t.index(m.Timestamp); idx != -1 {
+		t.messages[idx] = m
+		return
+	}
+
+	if len(t.messages) < cap(t.messages) {
+		t.messages = append(t.messages, m)
+		t.latest = len(t.messages) - 1
+		return
+	}
+
+	t.latest = (t.latest + 1) % len(t.messages)
+	t.messages[t.latest] = m
+}
+
+// remove the message, the remaining messages retain their order.
+func (t *messageRing) remove(timestamp string) {
+	idx := t.index(timestamp)
+	if idx == -1 {
+		return
+	}
+
+	// unroll the ring so the oldest message is first.
+	n := len(t.messages)
+	ordered := make([]Message, 0, cap(t.messages))
+	for i := 1; i <= n; i++ {
+		if j := (t.latest + i) % n; j != idx {
+			ordered = append(ordered, t.messages[j])
+		}
+	}
+
+	t.messages = ordered
+	t.latest = len(ordered) - 1
+}
+
+func (t *messageRing) index(timestamp string) int {
+	for idx := range t.messages {
+		if t.messages[idx].Timestamp == timestamp {
+			return idx
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,89 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func storedMessage(channel, ts, text string) Message {
+	return Message{Msg: Msg{Channel: channel, Timestamp: ts, Text: text}}
+}
+
+func TestMemoryMessageStore(t *testing.T) {
+	store := NewMemoryMessageStore(2)
+
+	_, ok, err := store.Latest("C1")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	assert.Nil(t, store.Append(storedMessage("C1", "1.0", "one")))
+	assert.Nil(t, store.Append(storedMessage("C1", "2.0", "two")))
+	assert.Nil(t, store.Append(storedMessage("C2", "2.5", "other")))
+	assert.Nil(t, store.Append(storedMessage("C1", "3.0", "three")))
+
+	// the oldest message is evicted once capacity is reached.
+	_, ok, _ = store.Get("C1", "1.0")
+	assert.False(t, ok)
+
+	m, ok, _ := store.Latest("C1")
+	assert.True(t, ok)
+	assert.Equal(t, "three", m.Text)
+
+	assert.Nil(t, store.Append(storedMessage("C1", "2.0", "two edited")))
+	m, ok, _ = store.Get("C1", "2.0")
+	assert.True(t, ok)
+	assert.Equal(t, "two edited", m.Text)
+
+	m, _, _ = store.Latest("C1")
+	assert.Equal(t, "three", m.Text)
+
+	// deleting the latest message leaves the previous message as the latest.
+	assert.Nil(t, store.Delete("C1", "3.0"))
+	_, ok, _ = store.Get("C1", "3.0")
+	assert.False(t, ok)
+	m, _, _ = store.Latest("C1")
+	assert.Equal(t, "two edited", m.Text)
+
+	assert.Nil(t, store.Append(storedMessage("C1", "4.0", "four")))
+	assert.Nil(t, store.Append(storedMessage("C1", "5.0", "five")))
+	_, ok, _ = store.Get("C1", "2.0")
+	assert.False(t, ok)
+	m, _, _ = store.Latest("C1")
+	assert.Equal(t, "five", m.Text)
+}
+
+func TestRTMRecordsMessages(t *testing.T) {
+	store := NewMemoryMessageStore(0)
+	rtm := New("testing-token").NewRTM(RTMOptionMessageStore(store))
+
+	rtm.handleEvent("message", json.RawMessage(`{"type":"message","channel":"C1","user":"U1","text":"hello","ts":"1.0"}`))
+	rtm.handleEvent("message", json.RawMessage(`{"type":"message","subtype":"message_changed","channel":"C1","hidden":true,"ts":"2.0","message":{"type":"message","user":"U1","text":"hello world","ts":"1.0"}}`))
+	assert.Equal(t, 3, len(rtm.IncomingEvents))
+
+	m, ok, err := store.Get("C1", "1.0")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "hello world", m.Text)
+
+	<-rtm.IncomingEvents
+	<-rtm.IncomingEvents
+	e := <-rtm.IncomingEvents
+	assert.Equal(t, "message_edited", e.Type)
+	edited := e.Data.(*MessageEditedEvent)
+	assert.Equal(t, "hello", edited.Previous.Text)
+	assert.Equal(t, []TextEdit{{Op: DiffEqual, Text: "hello"}, {Op: DiffInsert, Text: " world"}}, edited.TextDiff)
+}
+
+func TestRTMRemovesDeletedMessages(t *testing.T) {
+	store := NewMemoryMessageStore(0)
+	rtm := New("testing-token").NewRTM(RTMOptionMessageStore(store))
+
+	rtm.handleEvent("message", json.RawMessage(`{"type":"message","channel":"C1","user":"U1","text":"hello","ts":"1.0"}`))
+	rtm.handleEvent("message", json.RawMessage(`{"type":"message","subtype":"message_deleted","channel":"C1","hidden":true,"ts":"2.0","deleted_ts":"1.0"}`))
+
+	_, ok, err := store.Get("C1", "1.0")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
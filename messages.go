@@ -82,6 +82,9 @@ type Msg struct {
 	// pinned_item
 	ItemType string `json:"item_type,omitempty"`
 
+	// huddle_thread
+	Room *Room `json:"room,omitempty"`
+
 	// https://api.slack.com/rtm
 	ReplyTo int    `json:"reply_to,omitempty"`
 	Team    string `json:"team,omitempty"`
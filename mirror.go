@@ -0,0 +1,161 @@
+package slack
+
+import (
+	"context"
+	"sync"
+)
+
+// MirrorStore records the timestamp of the mirrored copy of each source message.
+// keys are opaque strings composed by the Mirror.
+type MirrorStore interface {
+	Save(ctx context.Context, key, timestamp string) error
+	Load(ctx context.Context, key string) (string, bool, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// NewMirror mirrors messages, their thread replies, edits and deletions from
+// the source channel into the destination channel.
+func NewMirror(api *Client, sourceID, destinationID string, store MirrorStore) Mirror {
+	return Mirror{
+		api:         api,
+		source:      sourceID,
+		destination: destinationID,
+		store:       store,
+	}
+}
+
+// Mirror cross posts messages between channels, pass it the message events
+// received from the RTM or the events api.
+type Mirror struct {
+	api         *Client
+	source      string
+	destination string
+	store       MirrorStore
+}
+
+// HandleMessage see HandleMessageContext.
+func (t Mirror) HandleMessage(ev *MessageEvent) error {
+	return t.HandleMessageContext(context.Background(), ev)
+}
+
+// HandleMessageContext propagates a message event from the source channel with a custom context.
+// events from other channels are ignored.
+func (t Mirror) HandleMessageContext(ctx context.Context, ev *MessageEvent) error {
+	if ev.Channel != t.source || t.source == t.destination {
+		return nil
+	}
+
+	switch ev.SubType {
+	case "message_changed":
+		if ev.SubMessage == nil {
+			return nil
+		}
+		return t.update(ctx, *ev.SubMessage)
+	case "message_deleted":
+		return t.delete(ctx, ev.DeletedTimestamp)
+	case "", "bot_message", "thread_broadcast", "file_share", "me_message":
+		return t.post(ctx, ev.Msg)
+	default:
+		return nil
+	}
+}
+
+func (t Mirror) key(timestamp string) string {
+	return t.source + ":" + timestamp + ":" + t.destination
+}
+
+func (t Mirror) post(ctx context.Context, m Msg) error {
+	options := []MsgOption{mirrorContent(m)}
+
+	if m.ThreadTimestamp != "" && m.ThreadTimestamp != m.Timestamp {
+		parent, ok, err := t.store.Load(ctx, t.key(m.ThreadTimestamp))
+		if err != nil {
+			return err
+		}
+
+		// the parent was never mirrored, so neither is the reply.
+		if !ok {
+			return nil
+		}
+
+		options = append(options, MsgOptionTS(parent))
+	}
+
+	_, ts, err := t.api.PostMessageContext(ctx, t.destination, options...)
+	if err != nil {
+		return err
+	}
+
+	return t.store.Save(ctx, t.key(m.Timestamp), ts)
+}
+
+func (t Mirror) update(ctx context.Context, m Msg) error {
+	ts, ok, err := t.store.Load(ctx, t.key(m.Timestamp))
+	if err != nil || !ok {
+		return err
+	}
+
+	_, _, _, err = t.api.UpdateMessageContext(ctx, t.destination, ts, mirrorContent(m))
+	return err
+}
+
+func (t Mirror) delete(ctx context.Context, timestamp string) error {
+	ts, ok, err := t.store.Load(ctx, t.key(timestamp))
+	if err != nil || !ok {
+		return err
+	}
+
+	if _, _, err = t.api.DeleteMessageContext(ctx, t.destination, ts); err != nil {
+		return err
+	}
+
+	return t.store.Delete(ctx, t.key(timestamp))
+}
+
+func mirrorContent(m Msg) MsgOption {
+	return MsgOptionCompose(
+		MsgOptionText(m.Text, false),
+		MsgOptionAttachments(m.Attachments...),
+		MsgOptionBlocks(m.Blocks.BlockSet...),
+	)
+}
+
+// NewMemoryMirrorStore in memory MirrorStore.
+func NewMemoryMirrorStore() *MemoryMirrorStore {
+	return &MemoryMirrorStore{
+		timestamps: make(map[string]string),
+	}
+}
+
+// MemoryMirrorStore in memory implementation of the MirrorStore.
+type MemoryMirrorStore struct {
+	m          sync.Mutex
+	timestamps map[string]string
+}
+
+// Save implements the MirrorStore interface.
+func (t *MemoryMirrorStore) Save(ctx context.Context, key, timestamp string) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.timestamps[key] = timestamp
+	return nil
+}
+
+// Load implements the MirrorStore interface.
+func (t *MemoryMirrorStore) Load(ctx context.Context, key string) (string, bool, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	ts, ok := t.timestamps[key]
+	return ts, ok, nil
+}
+
+// Delete implements the MirrorStore interface.
+func (t *MemoryMirrorStore) Delete(ctx context.Context, key string) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	delete(t.timestamps, key)
+	return nil
+}
@@ -0,0 +1,64 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMirror(t *testing.T) {
+	var (
+		counter int
+		calls   []string
+	)
+
+	respond := func(w http.ResponseWriter, ts string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C2","ts":"` + ts + `"}`))
+	}
+
+	http.HandleFunc("/mirror/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+		calls = append(calls, "post "+r.FormValue("channel")+" "+r.FormValue("thread_ts")+" "+r.FormValue("text"))
+		respond(w, "9."+strconv.Itoa(counter))
+	})
+	http.HandleFunc("/mirror/chat.update", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "update "+r.FormValue("ts")+" "+r.FormValue("text"))
+		respond(w, r.FormValue("ts"))
+	})
+	http.HandleFunc("/mirror/chat.delete", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "delete "+r.FormValue("ts"))
+		respond(w, r.FormValue("ts"))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/mirror/"))
+	store := NewMemoryMirrorStore()
+	m := NewMirror(api, "C1", "C2", store)
+
+	event := func(raw string) *MessageEvent {
+		ev := &MessageEvent{}
+		assert.Nil(t, json.Unmarshal([]byte(raw), ev))
+		return ev
+	}
+
+	assert.Nil(t, m.HandleMessage(event(`{"type":"message","channel":"C1","text":"deploy started","ts":"1.0"}`)))
+	assert.Nil(t, m.HandleMessage(event(`{"type":"message","channel":"C1","text":"step 1 done","ts":"2.0","thread_ts":"1.0"}`)))
+	assert.Nil(t, m.HandleMessage(event(`{"type":"message","channel":"C3","text":"ignored","ts":"3.0"}`)))
+	assert.Nil(t, m.HandleMessage(event(`{"type":"message","subtype":"message_changed","channel":"C1","ts":"4.0","message":{"type":"message","text":"deploy finished","ts":"1.0"}}`)))
+	assert.Nil(t, m.HandleMessage(event(`{"type":"message","subtype":"message_deleted","channel":"C1","ts":"5.0","deleted_ts":"2.0"}`)))
+
+	assert.Equal(t, []string{
+		"post C2  deploy started",
+		"post C2 9.1 step 1 done",
+		"update 9.1 deploy finished",
+		"delete 9.2",
+	}, calls)
+
+	_, ok, _ := store.Load(context.Background(), m.key("2.0"))
+	assert.False(t, ok)
+}
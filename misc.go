@@ -38,7 +38,32 @@ func (t SlackResponse) Err() error {
 		return nil
 	}
 
-	return errors.New(t.Error)
+	return SlackErrorResponse{Err: t.Error}
+}
+
+// SlackErrorResponse the error reported by slack in the error field of a response.
+type SlackErrorResponse struct {
+	Err string
+}
+
+func (t SlackErrorResponse) Error() string {
+	return t.Err
+}
+
+// isSlackError reports whether err is one of the errors reported by slack.
+func isSlackError(err error, codes ...string) bool {
+	var serr SlackErrorResponse
+	if !errors.As(err, &serr) {
+		return false
+	}
+
+	for _, code := range codes {
+		if serr.Err == code {
+			return true
+		}
+	}
+
+	return false
 }
 
 // StatusCodeError represents an http response error.
@@ -76,6 +101,25 @@ func (e *RateLimitedError) Retryable() bool {
 	return true
 }
 
+// retryRateLimited invokes fn until it returns an error other than a RateLimitedError,
+// sleeping for the duration requested by slack between attempts.
+func (api *Client) retryRateLimited(ctx context.Context, fn func() error) error {
+	for {
+		err := fn()
+		rateLimitedError, ok := err.(*RateLimitedError)
+		if !ok {
+			return err
+		}
+
+		api.Debugf("%v", rateLimitedError)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(rateLimitedError.RetryAfter):
+		}
+	}
+}
+
 func fileUploadReq(ctx context.Context, path string, values url.Values, r io.Reader) (*http.Request, error) {
 	req, err := http.NewRequest("POST", path, r)
 	if err != nil {
@@ -87,35 +131,77 @@ func fileUploadReq(ctx context.Context, path string, values url.Values, r io.Rea
 	return req, nil
 }
 
-func downloadFile(client httpClient, token string, downloadURL string, writer io.Writer, d debug) error {
-	if downloadURL == "" {
-		return fmt.Errorf("received empty download URL")
-	}
+// maxDownloadRedirects the maximum number of redirects followed when downloading a file.
+const maxDownloadRedirects = 10
 
-	req, err := http.NewRequest("GET", downloadURL, &bytes.Buffer{})
+func downloadFile(ctx context.Context, client httpClient, token string, downloadURL string, writer io.Writer, d debug) error {
+	resp, err := openDownload(ctx, client, token, downloadURL, d)
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
-	var bearer = "Bearer " + token
-	req.Header.Add("Authorization", bearer)
-	req.WithContext(context.Background())
+	_, err = io.Copy(writer, resp.Body)
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return err
-	}
+	return err
+}
 
-	defer resp.Body.Close()
+// openDownload requests the download URL authenticated by the token. redirects are followed
+// for http clients that do not follow them, the token is only forwarded to the original host
+// and slack.com.
+func openDownload(ctx context.Context, client httpClient, token string, downloadURL string, d debug) (*http.Response, error) {
+	if downloadURL == "" {
+		return nil, fmt.Errorf("received empty download URL")
+	}
 
-	err = checkStatusCode(resp, d)
+	target, err := url.Parse(downloadURL)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = io.Copy(writer, resp.Body)
+	origin := target.Hostname()
+	for redirects := 0; ; redirects++ {
+		req, err := http.NewRequest("GET", target.String(), nil)
+		if err != nil {
+			return nil, err
+		}
 
-	return err
+		if trustedDownloadHost(origin, target.Hostname()) {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		switch resp.StatusCode {
+		case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+			resp.Body.Close()
+
+			if redirects >= maxDownloadRedirects {
+				return nil, fmt.Errorf("stopped after %d redirects", maxDownloadRedirects)
+			}
+
+			if target, err = target.Parse(resp.Header.Get("Location")); err != nil {
+				return nil, err
+			}
+
+			d.Debugf("following download redirect to %s", target)
+			continue
+		}
+
+		if err = checkStatusCode(resp, d); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+func trustedDownloadHost(origin, host string) bool {
+	return host == origin || host == "slack.com" || strings.HasSuffix(host, ".slack.com")
 }
 
 func formReq(endpoint string, values url.Values) (req *http.Request, err error) {
@@ -168,33 +254,63 @@ func postLocalWithMultipartResponse(ctx context.Context, client httpClient, meth
 	return postWithMultipartResponse(ctx, client, method, filepath.Base(fpath), fieldname, values, file, intf, d)
 }
 
+// postWithMultipartResponse streams the reader to slack as a multipart form, the
+// form is written to the request body as it is sent so memory usage is constant
+// regardless of the size of the upload.
 func postWithMultipartResponse(ctx context.Context, client httpClient, path, name, fieldname string, values url.Values, r io.Reader, intf interface{}, d debug) error {
-	pipeReader, pipeWriter := io.Pipe()
-	wr := multipart.NewWriter(pipeWriter)
-	errc := make(chan error)
-	go func() {
-		defer pipeWriter.Close()
-		ioWriter, err := wr.CreateFormFile(fieldname, name)
-		if err != nil {
-			errc <- err
-			return
-		}
-		_, err = io.Copy(ioWriter, r)
-		if err != nil {
-			errc <- err
-			return
-		}
-		if err = wr.Close(); err != nil {
-			errc <- err
-			return
-		}
-	}()
-	req, err := fileUploadReq(ctx, path, values, pipeReader)
+	var (
+		pipeReader *io.PipeReader
+		errc       chan error
+	)
+
+	boundary := multipart.NewWriter(ioutil.Discard)
+
+	// the offset is recorded before the first attempt starts reading.
+	rewind := rewinder(r)
+
+	open := func() io.ReadCloser {
+		pr, pipeWriter := io.Pipe()
+		wr := multipart.NewWriter(pipeWriter)
+		wr.SetBoundary(boundary.Boundary())
+		done := make(chan error, 1)
+
+		go func() {
+			err := writeMultipartFile(wr, fieldname, name, r)
+			// closing with the error aborts the request instead of
+			// sending a truncated upload.
+			pipeWriter.CloseWithError(err)
+			done <- err
+		}()
+
+		pipeReader, errc = pr, done
+		return pr
+	}
+
+	// closing the reader unblocks the writer when the request fails
+	// before the body has been consumed.
+	defer func() { pipeReader.Close() }()
+
+	req, err := fileUploadReq(ctx, path, values, open())
 	if err != nil {
 		return err
 	}
-	req.Header.Add("Content-Type", wr.FormDataContentType())
-	req = req.WithContext(ctx)
+	req.Header.Add("Content-Type", boundary.FormDataContentType())
+
+	// seekable sources are rewound and the body rebuilt when the request is retried.
+	if rewind != nil {
+		req.GetBody = func() (io.ReadCloser, error) {
+			// the previous attempt must stop reading before the source is rewound.
+			pipeReader.Close()
+			<-errc
+
+			if err := rewind(); err != nil {
+				return nil, err
+			}
+
+			return open(), nil
+		}
+	}
+
 	resp, err := client.Do(req)
 
 	if err != nil {
@@ -209,10 +325,69 @@ func postWithMultipartResponse(ctx context.Context, client httpClient, path, nam
 
 	select {
 	case err = <-errc:
-		return err
+		if err != nil {
+			return err
+		}
 	default:
-		return newJSONParser(intf)(resp)
 	}
+
+	return newJSONParser(intf)(resp)
+}
+
+// sections returns a function opening an independent reader of the size bytes following the
+// current offset of the reader, nil when the reader does not implement io.Seeker. concurrent
+// readers do not interfere, allowing a retry to start before the previous attempt stops reading.
+func sections(r io.Reader, size int64) func() io.Reader {
+	s, ok := r.(io.Seeker)
+	if !ok {
+		return nil
+	}
+
+	start, err := s.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil
+	}
+
+	ra, ok := r.(io.ReaderAt)
+	if !ok {
+		ra = &readSeekerAt{ReadSeeker: r.(io.ReadSeeker)}
+	}
+
+	return func() io.Reader {
+		return io.NewSectionReader(ra, start, size)
+	}
+}
+
+// rewinder returns a function rewinding the reader to its current offset, nil when the
+// reader does not implement io.Seeker and cannot be replayed.
+func rewinder(r io.Reader) func() error {
+	s, ok := r.(io.Seeker)
+	if !ok {
+		return nil
+	}
+
+	start, err := s.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil
+	}
+
+	return func() error {
+		_, err := s.Seek(start, io.SeekStart)
+		return err
+	}
+}
+
+func writeMultipartFile(wr *multipart.Writer, fieldname, name string, r io.Reader) error {
+	ioWriter, err := wr.CreateFormFile(fieldname, name)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(ioWriter, r); err != nil {
+		return err
+	}
+
+	return wr.Close()
 }
 
 func doPost(ctx context.Context, client httpClient, req *http.Request, parser responseParser, d debug) error {
@@ -2,6 +2,8 @@ package slack
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"net/url"
@@ -89,6 +91,21 @@ func TestParseResponseInvalidToken(t *testing.T) {
 	}
 }
 
+func TestIsSlackError(t *testing.T) {
+	err := SlackResponse{Error: "channel_not_found"}.Err()
+	if !isSlackError(err, "not_in_channel", "channel_not_found") {
+		t.Errorf("expected %v to be reported by slack", err)
+	}
+
+	if !isSlackError(fmt.Errorf("join failed: %w", err), "channel_not_found") {
+		t.Errorf("expected the wrapped error to be reported by slack")
+	}
+
+	if isSlackError(errors.New("channel_not_found"), "channel_not_found") || isSlackError(nil, "channel_not_found") {
+		t.Errorf("unexpected slack error")
+	}
+}
+
 func TestRetryable(t *testing.T) {
 	for _, e := range []error{
 		&RateLimitedError{},
@@ -0,0 +1,161 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ModeratorOption options for the Moderator.
+type ModeratorOption func(*Moderator)
+
+// ModeratorOptionLogChannel records every moderation action in the provided channel.
+func ModeratorOptionLogChannel(channelID string) ModeratorOption {
+	return func(m *Moderator) {
+		m.logChannel = channelID
+	}
+}
+
+// ModeratorOptionStrikes removes users from a channel once they've had threshold
+// messages removed within the window, defaults to 3 strikes within 24 hours.
+// a threshold of 0 disables removing users.
+func ModeratorOptionStrikes(threshold int, window time.Duration) ModeratorOption {
+	return func(m *Moderator) {
+		m.threshold = threshold
+		m.window = window
+	}
+}
+
+// NewModerator builds a Moderator, the client requires the chat:write and
+// channels:manage scopes, deleting messages of other users requires a user token.
+func NewModerator(api *Client, options ...ModeratorOption) *Moderator {
+	m := &Moderator{
+		api:       api,
+		threshold: 3,
+		window:    24 * time.Hour,
+		strikes:   make(map[string][]time.Time),
+		now:       time.Now,
+	}
+
+	for _, opt := range options {
+		opt(m)
+	}
+
+	return m
+}
+
+// Moderator composes common channel moderation actions.
+type Moderator struct {
+	api        *Client
+	logChannel string
+	threshold  int
+	window     time.Duration
+	m          sync.Mutex
+	strikes    map[string][]time.Time
+	now        func() time.Time
+}
+
+// ModerationAction describes the actions taken by the Moderator.
+type ModerationAction struct {
+	Channel   string
+	Timestamp string
+	User      string
+	Reason    string
+	Strikes   int
+	Kicked    bool
+}
+
+// RemoveMessage see RemoveMessageContext.
+func (t *Moderator) RemoveMessage(channelID, timestamp, userID, reason string) (ModerationAction, error) {
+	return t.RemoveMessageContext(context.Background(), channelID, timestamp, userID, reason)
+}
+
+// RemoveMessageContext deletes the message and sends its author a direct message explaining why
+// with a custom context. The author is removed from the channel once they reach the strike threshold.
+func (t *Moderator) RemoveMessageContext(ctx context.Context, channelID, timestamp, userID, reason string) (action ModerationAction, err error) {
+	action = ModerationAction{Channel: channelID, Timestamp: timestamp, User: userID, Reason: reason}
+
+	if _, _, err = t.api.DeleteMessageContext(ctx, channelID, timestamp); err != nil {
+		return action, err
+	}
+
+	explanation := fmt.Sprintf("Your message in <#%s> was removed: %s", channelID, reason)
+	if _, _, err = t.api.PostMessageContext(ctx, userID, MsgOptionText(explanation, false)); err != nil {
+		return action, err
+	}
+
+	action.Strikes = t.strike(channelID, userID)
+	if t.threshold > 0 && action.Strikes >= t.threshold {
+		if err = t.api.KickUserFromConversationContext(ctx, channelID, userID); err != nil {
+			return action, err
+		}
+		action.Kicked = true
+		t.reset(channelID, userID)
+	}
+
+	return action, t.log(ctx, action)
+}
+
+// Kick see KickContext.
+func (t *Moderator) Kick(channelID, userID, reason string) (ModerationAction, error) {
+	return t.KickContext(context.Background(), channelID, userID, reason)
+}
+
+// KickContext removes the user from the channel with a custom context.
+func (t *Moderator) KickContext(ctx context.Context, channelID, userID, reason string) (action ModerationAction, err error) {
+	action = ModerationAction{Channel: channelID, User: userID, Reason: reason}
+
+	if err = t.api.KickUserFromConversationContext(ctx, channelID, userID); err != nil {
+		return action, err
+	}
+
+	action.Kicked = true
+	t.reset(channelID, userID)
+
+	return action, t.log(ctx, action)
+}
+
+// strike records a strike against the user and returns the number of strikes within the window.
+func (t *Moderator) strike(channelID, userID string) int {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	key := channelID + ":" + userID
+	now := t.now()
+	recent := t.strikes[key][:0]
+	for _, ts := range t.strikes[key] {
+		if now.Sub(ts) < t.window {
+			recent = append(recent, ts)
+		}
+	}
+
+	t.strikes[key] = append(recent, now)
+	return len(t.strikes[key])
+}
+
+func (t *Moderator) reset(channelID, userID string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	delete(t.strikes, channelID+":"+userID)
+}
+
+func (t *Moderator) log(ctx context.Context, action ModerationAction) error {
+	if t.logChannel == "" {
+		return nil
+	}
+
+	var text string
+	switch {
+	case action.Timestamp != "" && action.Kicked:
+		text = fmt.Sprintf("Removed a message by <@%s> in <#%s> and removed them from the channel after %d strikes: %s", action.User, action.Channel, action.Strikes, action.Reason)
+	case action.Timestamp != "":
+		text = fmt.Sprintf("Removed a message by <@%s> in <#%s> (strike %d): %s", action.User, action.Channel, action.Strikes, action.Reason)
+	default:
+		text = fmt.Sprintf("Removed <@%s> from <#%s>: %s", action.User, action.Channel, action.Reason)
+	}
+
+	_, _, err := t.api.PostMessageContext(ctx, t.logChannel, MsgOptionText(text, false))
+	return err
+}
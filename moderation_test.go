@@ -0,0 +1,58 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModeratorRemoveMessage(t *testing.T) {
+	var (
+		deleted int
+		kicked  int
+		posted  = map[string][]string{}
+	)
+
+	http.HandleFunc("/moderation/chat.delete", func(w http.ResponseWriter, r *http.Request) {
+		deleted++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"` + r.FormValue("ts") + `"}`))
+	})
+	http.HandleFunc("/moderation/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		channel := r.FormValue("channel")
+		posted[channel] = append(posted[channel], r.FormValue("text"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"` + channel + `","ts":"1.0"}`))
+	})
+	http.HandleFunc("/moderation/conversations.kick", func(w http.ResponseWriter, r *http.Request) {
+		kicked++
+		assert.Equal(t, "U1", r.FormValue("user"))
+		okJSONHandler(w, r)
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/moderation/"))
+	m := NewModerator(api, ModeratorOptionLogChannel("CMOD"), ModeratorOptionStrikes(2, time.Hour))
+
+	action, err := m.RemoveMessage("C1", "1.0", "U1", "spam")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, action.Strikes)
+	assert.False(t, action.Kicked)
+
+	action, err = m.RemoveMessage("C1", "2.0", "U1", "spam")
+	assert.Nil(t, err)
+	assert.True(t, action.Kicked)
+
+	assert.Equal(t, 2, deleted)
+	assert.Equal(t, 1, kicked)
+	assert.Equal(t, 2, len(posted["U1"]))
+	assert.Contains(t, posted["U1"][0], "spam")
+	assert.Equal(t, 2, len(posted["CMOD"]))
+
+	// strikes are reset once the user is removed.
+	action, err = m.RemoveMessage("C1", "3.0", "U1", "spam")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, action.Strikes)
+}
@@ -0,0 +1,88 @@
+package slack
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultNotifierWindow is the period identical notifications are suppressed for by default.
+const DefaultNotifierWindow = 10 * time.Minute
+
+// NotifierOption options for the Notifier.
+type NotifierOption func(*Notifier)
+
+// NotifierOptionWindow suppress identical notifications for the given duration, defaults to DefaultNotifierWindow.
+func NotifierOptionWindow(d time.Duration) NotifierOption {
+	return func(n *Notifier) {
+		n.window = d
+	}
+}
+
+// NotifierOptionStore records the notifications that have been sent in the provided store,
+// by default an in memory store is used which only de-duplicates within a single process.
+// notifications which fail to post are only retried when the store implements NonceForgetter.
+func NotifierOptionStore(store NonceStore) NotifierOption {
+	return func(n *Notifier) {
+		n.store = store
+	}
+}
+
+// NewNotifier builds a Notifier which suppresses identical notifications to the same target.
+func NewNotifier(api *Client, options ...NotifierOption) Notifier {
+	n := Notifier{
+		api:    api,
+		window: DefaultNotifierWindow,
+		store:  NewMemoryNonceStore(),
+		now:    time.Now,
+	}
+
+	for _, opt := range options {
+		opt(&n)
+	}
+
+	return n
+}
+
+// Notifier posts messages while suppressing repeats, notifications are considered
+// identical when they share a target channel and a caller provided fingerprint.
+type Notifier struct {
+	api    *Client
+	window time.Duration
+	store  NonceStore
+	now    func() time.Time
+}
+
+// Notify posts a message to the channel unless an identical notification was recently sent.
+// see NotifyContext.
+func (t Notifier) Notify(channelID, fingerprint string, options ...MsgOption) (string, string, error) {
+	return t.NotifyContext(context.Background(), channelID, fingerprint, options...)
+}
+
+// NotifyContext posts a message to the channel with a custom context unless a notification
+// with the same fingerprint was sent to the channel within the window, in which case
+// ErrDuplicateNotification is returned. notifications which fail to post are not recorded.
+func (t Notifier) NotifyContext(ctx context.Context, channelID, fingerprint string, options ...MsgOption) (string, string, error) {
+	key := channelID + "\x00" + fingerprint
+
+	// the fingerprint is reserved while posting, suppressing concurrent duplicates.
+	seen, err := t.store.Seen(key, t.now().Add(t.window))
+	if err != nil {
+		return "", "", err
+	}
+
+	if seen {
+		t.api.Debugf("suppressed duplicate notification %s %s", channelID, fingerprint)
+		return "", "", ErrDuplicateNotification
+	}
+
+	channel, ts, err := t.api.PostMessageContext(ctx, channelID, options...)
+	if err != nil {
+		if ferr := forgetNonce(t.store, key); ferr != nil {
+			t.api.Debugf("failed to release notification %s %s: %v", channelID, fingerprint, ferr)
+		}
+
+		return "", "", err
+	}
+
+	return channel, ts, nil
+}
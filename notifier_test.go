@@ -0,0 +1,64 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifierSuppressesDuplicates(t *testing.T) {
+	posted := 0
+	http.HandleFunc("/notifier/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		posted++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"` + r.FormValue("channel") + `","ts":"123.456"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/notifier/"))
+	n := NewNotifier(api)
+
+	_, ts, err := n.Notify("C1", "disk-full", MsgOptionText("disk full", false))
+	assert.Nil(t, err)
+	assert.Equal(t, "123.456", ts)
+
+	_, _, err = n.Notify("C1", "disk-full", MsgOptionText("disk full", false))
+	assert.Equal(t, ErrDuplicateNotification, err)
+
+	_, _, err = n.Notify("C2", "disk-full", MsgOptionText("disk full", false))
+	assert.Nil(t, err)
+
+	_, _, err = n.Notify("C1", "cpu-high", MsgOptionText("cpu high", false))
+	assert.Nil(t, err)
+
+	assert.Equal(t, 3, posted)
+}
+
+func TestNotifierRetriesFailedPosts(t *testing.T) {
+	posted := 0
+	http.HandleFunc("/notifierretry/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		posted++
+		w.Header().Set("Content-Type", "application/json")
+		if posted == 1 {
+			w.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"123.456"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/notifierretry/"))
+	n := NewNotifier(api)
+
+	_, _, err := n.Notify("C1", "disk-full", MsgOptionText("disk full", false))
+	assert.EqualError(t, err, "channel_not_found")
+
+	_, ts, err := n.Notify("C1", "disk-full", MsgOptionText("disk full", false))
+	assert.Nil(t, err)
+	assert.Equal(t, "123.456", ts)
+
+	_, _, err = n.Notify("C1", "disk-full", MsgOptionText("disk full", false))
+	assert.Equal(t, ErrDuplicateNotification, err)
+	assert.Equal(t, 2, posted)
+}
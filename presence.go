@@ -0,0 +1,145 @@
+package slack
+
+import (
+	"sort"
+	"sync"
+)
+
+// UserAvailability the last observed presence and do not disturb status of a user.
+type UserAvailability struct {
+	User     string
+	Presence string
+	DND      DNDStatus
+}
+
+// NewPresenceTracker tracks the availability of users from presence_change and
+// dnd_updated events.
+func NewPresenceTracker() *PresenceTracker {
+	return &PresenceTracker{
+		subscribed: make(map[string]bool),
+		users:      make(map[string]UserAvailability),
+	}
+}
+
+// PresenceTracker maintains the availability of users observed by the RTM connection
+// along with the set of users whose presence is subscribed to, suitable for availability
+// dashboards. Safe for concurrent use.
+type PresenceTracker struct {
+	m          sync.RWMutex
+	subscribed map[string]bool
+	users      map[string]UserAvailability
+}
+
+// Subscribe adds the users to the presence subscription. The RTM connection subscribes
+// to the presence of every user when it connects, changes made while connected take effect
+// once rtm.NewSubscribeUserPresence(tracker.Users()) is sent.
+func (t *PresenceTracker) Subscribe(ids ...string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	for _, id := range ids {
+		t.subscribed[id] = true
+	}
+}
+
+// subscribeAtMost adds the users to the presence subscription unless the subscription
+// would exceed max users, reports whether the users were added.
+func (t *PresenceTracker) subscribeAtMost(max int, ids ...string) bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	added := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if !t.subscribed[id] {
+			added[id] = true
+		}
+	}
+
+	if len(t.subscribed)+len(added) > max {
+		return false
+	}
+
+	for _, id := range ids {
+		t.subscribed[id] = true
+	}
+
+	return true
+}
+
+// Unsubscribe removes the users from the presence subscription.
+func (t *PresenceTracker) Unsubscribe(ids ...string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	for _, id := range ids {
+		delete(t.subscribed, id)
+	}
+}
+
+// Users returns the subscribed user IDs.
+func (t *PresenceTracker) Users() []string {
+	t.m.RLock()
+	defer t.m.RUnlock()
+
+	ids := make([]string, 0, len(t.subscribed))
+	for id := range t.subscribed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// Get the availability of the user, the boolean reports whether any availability
+// has been observed for the user.
+func (t *PresenceTracker) Get(userID string) (UserAvailability, bool) {
+	t.m.RLock()
+	defer t.m.RUnlock()
+
+	a, ok := t.users[userID]
+	return a, ok
+}
+
+// SetPresence records the presence of the user.
+func (t *PresenceTracker) SetPresence(userID, presence string) {
+	t.update(userID, func(a *UserAvailability) {
+		a.Presence = presence
+	})
+}
+
+// SetDND records the do not disturb status of the user.
+func (t *PresenceTracker) SetDND(userID string, status DNDStatus) {
+	t.update(userID, func(a *UserAvailability) {
+		a.DND = status
+	})
+}
+
+// Handle updates the availability from presence_change, dnd_updated, and dnd_updated_user events,
+// other events are ignored.
+func (t *PresenceTracker) Handle(event interface{}) {
+	switch ev := event.(type) {
+	case *PresenceChangeEvent:
+		if ev.User != "" {
+			t.SetPresence(ev.User, ev.Presence)
+		}
+
+		// batched presence changes list the users instead.
+		for _, id := range ev.Users {
+			t.SetPresence(id, ev.Presence)
+		}
+	case *DNDUpdatedEvent:
+		t.SetDND(ev.User, ev.Status)
+	case *DNDUpdatedUserEvent:
+		t.SetDND(ev.User, ev.Status)
+	}
+}
+
+func (t *PresenceTracker) update(userID string, fn func(*UserAvailability)) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	a := t.users[userID]
+	a.User = userID
+	fn(&a)
+	t.users[userID] = a
+}
@@ -0,0 +1,49 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresenceTracker(t *testing.T) {
+	tracker := NewPresenceTracker()
+	tracker.Subscribe("U2", "U1", "U3")
+	tracker.Unsubscribe("U3")
+	assert.Equal(t, []string{"U1", "U2"}, tracker.Users())
+
+	_, ok := tracker.Get("U1")
+	assert.False(t, ok)
+
+	tracker.Handle(&PresenceChangeEvent{Type: "presence_change", Presence: "active", Users: []string{"U1", "U2"}})
+	tracker.Handle(&PresenceChangeEvent{Type: "presence_change", Presence: "away", User: "U2"})
+	tracker.Handle(&DNDUpdatedUserEvent{Type: "dnd_updated_user", User: "U1", Status: DNDStatus{Enabled: true, NextEndTimestamp: 10}})
+
+	a, ok := tracker.Get("U1")
+	assert.True(t, ok)
+	assert.Equal(t, UserAvailability{User: "U1", Presence: "active", DND: DNDStatus{Enabled: true, NextEndTimestamp: 10}}, a)
+
+	a, _ = tracker.Get("U2")
+	assert.Equal(t, "away", a.Presence)
+	assert.False(t, a.DND.Enabled)
+}
+
+func TestRTMDNDUpdatedUserEvents(t *testing.T) {
+	raw := json.RawMessage(`{"type":"dnd_updated_user","user":"U1","dnd_status":{"dnd_enabled":true}}`)
+
+	tracker := NewPresenceTracker()
+	rtm := New("testing-token").NewRTM(RTMOptionPresenceTracker(tracker))
+	rtm.handleEvent("dnd_updated_user", raw)
+	e := <-rtm.IncomingEvents
+	assert.IsType(t, &DNDUpdatedEvent{}, e.Data)
+
+	a, _ := tracker.Get("U1")
+	assert.True(t, a.DND.Enabled)
+
+	// opting into the distinct type.
+	rtm = New("testing-token").NewRTM(RTMOptionEvents(map[string]interface{}{"dnd_updated_user": DNDUpdatedUserEvent{}}))
+	rtm.handleEvent("dnd_updated_user", raw)
+	e = <-rtm.IncomingEvents
+	assert.IsType(t, &DNDUpdatedUserEvent{}, e.Data)
+}
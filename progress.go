@@ -0,0 +1,276 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Progress defaults.
+const (
+	DefaultProgressInterval = 3 * time.Second
+	DefaultProgressWidth    = 20
+)
+
+// ProgressOption options for the ProgressReporter.
+type ProgressOption func(*ProgressReporter)
+
+// ProgressOptionInterval minimum duration between edits of the progress message, updates
+// received in between are coalesced. defaults to DefaultProgressInterval.
+func ProgressOptionInterval(d time.Duration) ProgressOption {
+	return func(t *ProgressReporter) {
+		t.interval = d
+	}
+}
+
+// ProgressOptionWidth number of characters in the progress bar, defaults to DefaultProgressWidth.
+func ProgressOptionWidth(n int) ProgressOption {
+	return func(t *ProgressReporter) {
+		t.width = n
+	}
+}
+
+// ProgressOptionThread posts the progress message as a reply to the thread.
+func ProgressOptionThread(ts string) ProgressOption {
+	return func(t *ProgressReporter) {
+		t.thread = ts
+	}
+}
+
+// NewProgressReporter builds a ProgressReporter for a job with the given title.
+func NewProgressReporter(api *Client, channelID, title string, options ...ProgressOption) *ProgressReporter {
+	t := &ProgressReporter{
+		api:      api,
+		channel:  channelID,
+		title:    title,
+		interval: DefaultProgressInterval,
+		width:    DefaultProgressWidth,
+		now:      time.Now,
+	}
+
+	for _, opt := range options {
+		opt(t)
+	}
+
+	return t
+}
+
+// ProgressReporter posts a message for a long running job and edits it as the job progresses,
+// rendering a progress bar and percentage. Edits are rate limited to avoid flooding the channel
+// with updates, coalesced updates are rendered once the interval elapses or by Close, and the
+// final state is always rendered by Succeed or Fail. Safe for concurrent use.
+type ProgressReporter struct {
+	api      *Client
+	channel  string
+	title    string
+	thread   string
+	interval time.Duration
+	width    int
+	now      func() time.Time
+	m        sync.Mutex
+	ts       string
+	edited   time.Time
+	done     int64
+	total    int64
+	status   string
+	pending  bool        // an update was coalesced and has not been rendered.
+	flush    *time.Timer // renders the coalesced update once the interval elapses.
+	finished bool
+}
+
+// Timestamp of the progress message, empty until the message is posted.
+func (t *ProgressReporter) Timestamp() string {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	return t.ts
+}
+
+// Start see StartContext.
+func (t *ProgressReporter) Start() error {
+	return t.StartContext(context.Background())
+}
+
+// StartContext posts the progress message with a custom context.
+func (t *ProgressReporter) StartContext(ctx context.Context) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	return t.send(ctx, t.progress())
+}
+
+// Update see UpdateContext.
+func (t *ProgressReporter) Update(done, total int64, status string) error {
+	return t.UpdateContext(context.Background(), done, total, status)
+}
+
+// UpdateContext records the progress of the job with a custom context, the message is only
+// edited if the interval has elapsed since the previous edit. the message is posted if
+// it has not been started.
+func (t *ProgressReporter) UpdateContext(ctx context.Context, done, total int64, status string) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.done, t.total, t.status = done, total, status
+	if elapsed := t.now().Sub(t.edited); t.ts != "" && elapsed < t.interval {
+		t.pending = true
+		if t.flush == nil {
+			t.flush = time.AfterFunc(t.interval-elapsed, t.flushPending)
+		}
+		return nil
+	}
+
+	return t.send(ctx, t.progress())
+}
+
+// Close see CloseContext.
+func (t *ProgressReporter) Close() error {
+	return t.CloseContext(context.Background())
+}
+
+// CloseContext renders any coalesced update with a custom context, use when the job
+// stops reporting progress without calling Succeed or Fail.
+func (t *ProgressReporter) CloseContext(ctx context.Context) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.stopFlush()
+	if !t.pending || t.finished {
+		return nil
+	}
+
+	return t.send(ctx, t.progress())
+}
+
+// flushPending renders the coalesced update once the interval has elapsed.
+func (t *ProgressReporter) flushPending() {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.flush = nil
+	if !t.pending || t.finished {
+		return
+	}
+
+	if err := t.send(context.Background(), t.progress()); err != nil {
+		t.api.Debugf("failed to render the progress of %s: %s", t.title, err)
+	}
+}
+
+// stopFlush cancels the pending render of a coalesced update, must be called while holding the lock.
+func (t *ProgressReporter) stopFlush() {
+	if t.flush != nil {
+		t.flush.Stop()
+		t.flush = nil
+	}
+}
+
+// Succeed see SucceedContext.
+func (t *ProgressReporter) Succeed(text string) error {
+	return t.SucceedContext(context.Background(), text)
+}
+
+// SucceedContext finalizes the progress message as completed with a custom context.
+func (t *ProgressReporter) SucceedContext(ctx context.Context, text string) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.finish()
+	return t.send(ctx, t.final(":white_check_mark:", "completed", text))
+}
+
+// Fail see FailContext.
+func (t *ProgressReporter) Fail(cause error) error {
+	return t.FailContext(context.Background(), cause)
+}
+
+// FailContext finalizes the progress message as failed with the cause with a custom context.
+func (t *ProgressReporter) FailContext(ctx context.Context, cause error) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	var text string
+	if cause != nil {
+		text = cause.Error()
+	}
+
+	t.finish()
+	return t.send(ctx, t.final(":x:", "failed", text))
+}
+
+// send posts or edits the progress message, must be called while holding the lock.
+func (t *ProgressReporter) send(ctx context.Context, text string) (err error) {
+	options := []MsgOption{
+		MsgOptionText(text, false),
+		MsgOptionBlocks(NewSectionBlock(NewTextBlockObject(MarkdownType, Truncate(text, SectionTextMaxLength), false, false), nil, nil)),
+	}
+
+	if t.ts == "" {
+		if t.thread != "" {
+			options = append(options, MsgOptionTS(t.thread))
+		}
+
+		_, t.ts, err = t.api.PostMessageContext(ctx, t.channel, options...)
+	} else {
+		_, _, _, err = t.api.UpdateMessageContext(ctx, t.channel, t.ts, options...)
+	}
+
+	if err == nil {
+		t.edited = t.now()
+		t.pending = false
+		t.stopFlush()
+	}
+
+	return err
+}
+
+// finish discards any coalesced update, the final state replaces it. must be called while holding the lock.
+func (t *ProgressReporter) finish() {
+	t.finished = true
+	t.pending = false
+	t.stopFlush()
+}
+
+func (t *ProgressReporter) progress() string {
+	text := fmt.Sprintf("*%s*\n`%s` %d%%", t.title, ProgressBar(t.done, t.total, t.width), percentage(t.done, t.total))
+	if t.status != "" {
+		text += " " + t.status
+	}
+
+	return text
+}
+
+func (t *ProgressReporter) final(emoji, state, text string) string {
+	msg := fmt.Sprintf("%s *%s* %s", emoji, t.title, state)
+	if text != "" {
+		msg += "\n" + text
+	}
+
+	return msg
+}
+
+// ProgressBar renders a progress bar of the given width, e.g. ████░░░░░░. a negative
+// width renders an empty bar.
+func ProgressBar(done, total int64, width int) string {
+	if width < 0 {
+		width = 0
+	}
+
+	filled := int(int64(width) * int64(percentage(done, total)) / 100)
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
+
+// percentage of the job completed, clamped between 0 and 100.
+func percentage(done, total int64) int {
+	if total <= 0 || done <= 0 {
+		return 0
+	}
+
+	if done >= total {
+		return 100
+	}
+
+	return int(done * 100 / total)
+}
@@ -0,0 +1,111 @@
+package slack
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressReporter(t *testing.T) {
+	var (
+		posted  []string
+		updated []string
+	)
+
+	http.HandleFunc("/progress/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		posted = append(posted, r.FormValue("text"))
+		assert.Equal(t, "100.000", r.FormValue("thread_ts"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"123.456"}`))
+	})
+	http.HandleFunc("/progress/chat.update", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "123.456", r.FormValue("ts"))
+		updated = append(updated, r.FormValue("text"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"123.456","text":""}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/progress/"))
+
+	now := time.Unix(0, 0)
+	p := NewProgressReporter(api, "C1", "backup", ProgressOptionWidth(10), ProgressOptionThread("100.000"))
+	p.now = func() time.Time { return now }
+
+	assert.Nil(t, p.Start())
+	assert.Equal(t, "123.456", p.Timestamp())
+	assert.Equal(t, []string{"*backup*\n`░░░░░░░░░░` 0%"}, posted)
+
+	// rate limited, within the interval.
+	assert.Nil(t, p.Update(1, 10, "copying"))
+	assert.Len(t, updated, 0)
+
+	now = now.Add(DefaultProgressInterval)
+	assert.Nil(t, p.Update(4, 10, "copying"))
+	assert.Equal(t, []string{"*backup*\n`████░░░░░░` 40% copying"}, updated)
+
+	// final states are never rate limited.
+	assert.Nil(t, p.Succeed("3 files"))
+	assert.Nil(t, p.Fail(errors.New("disk full")))
+	assert.Equal(t, ":white_check_mark: *backup* completed\n3 files", updated[1])
+	assert.Equal(t, ":x: *backup* failed\ndisk full", updated[2])
+	assert.Len(t, posted, 1)
+}
+
+func TestProgressReporterCoalesced(t *testing.T) {
+	updated := make(chan string, 10)
+	http.HandleFunc("/progresscoalesced/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"123.456"}`))
+	})
+	http.HandleFunc("/progresscoalesced/chat.update", func(w http.ResponseWriter, r *http.Request) {
+		updated <- r.FormValue("text")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"C1","ts":"123.456","text":""}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/progresscoalesced/"))
+	now := time.Unix(0, 0)
+
+	// the coalesced update is rendered once the interval elapses.
+	p := NewProgressReporter(api, "C1", "backup", ProgressOptionWidth(10), ProgressOptionInterval(10*time.Millisecond))
+	p.now = func() time.Time { return now }
+	assert.Nil(t, p.Start())
+	assert.Nil(t, p.Update(1, 10, "copying"))
+	assert.Nil(t, p.Update(5, 10, "copying"))
+
+	select {
+	case text := <-updated:
+		assert.Equal(t, "*backup*\n`█████░░░░░` 50% copying", text)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the coalesced update")
+	}
+
+	// closing renders the coalesced update without waiting for the interval.
+	p = NewProgressReporter(api, "C1", "backup", ProgressOptionWidth(10), ProgressOptionInterval(time.Hour))
+	p.now = func() time.Time { return now }
+	assert.Nil(t, p.Start())
+	assert.Nil(t, p.Update(7, 10, "copying"))
+	assert.Len(t, updated, 0)
+	assert.Nil(t, p.Close())
+	assert.Equal(t, "*backup*\n`███████░░░` 70% copying", <-updated)
+
+	// nothing is pending once closed or finished.
+	assert.Nil(t, p.Close())
+	assert.Nil(t, p.Update(8, 10, "copying"))
+	assert.Nil(t, p.Succeed(""))
+	assert.Equal(t, ":white_check_mark: *backup* completed", <-updated)
+	assert.Nil(t, p.Close())
+	assert.Len(t, updated, 0)
+}
+
+func TestProgressBar(t *testing.T) {
+	assert.Equal(t, "░░░░", ProgressBar(0, 0, 4))
+	assert.Equal(t, "██░░", ProgressBar(1, 2, 4))
+	assert.Equal(t, "████", ProgressBar(3, 2, 4))
+	assert.Equal(t, "", ProgressBar(1, 2, -4))
+}
@@ -0,0 +1,138 @@
+package slack
+
+import (
+	"sync"
+)
+
+// ReactionWatchOption options for a watch registered with a ReactionTracker.
+type ReactionWatchOption func(*reactionWatch)
+
+// ReactionWatchOptionUsers only resolve the watch when one of the provided users reacts,
+// by default any user resolves the watch.
+func ReactionWatchOptionUsers(userIDs ...string) ReactionWatchOption {
+	return func(w *reactionWatch) {
+		w.users = userIDs
+	}
+}
+
+// NewReactionTracker builds a ReactionTracker, reaction_added events must be
+// passed to its Handle method, typically from the RTM IncomingEvents loop.
+func NewReactionTracker() *ReactionTracker {
+	return &ReactionTracker{
+		watches: make(map[string][]*reactionWatch),
+	}
+}
+
+// ReactionTracker resolves acknowledgements made by reacting to a message
+// with one of a set of emoji, used by approval and escalation flows.
+type ReactionTracker struct {
+	m       sync.Mutex
+	watches map[string][]*reactionWatch
+}
+
+// ReactionAck is resolved once an acceptable reaction is added to the watched
+// message, the reaction is delivered on C exactly once.
+type ReactionAck struct {
+	C     <-chan ReactionAddedEvent
+	watch *reactionWatch
+	t     *ReactionTracker
+}
+
+// Stop no longer watch the message, returns false if the watch was already resolved or stopped.
+func (t ReactionAck) Stop() bool {
+	return t.t.remove(t.watch)
+}
+
+type reactionWatch struct {
+	key       string
+	reactions []string
+	users     []string
+	c         chan ReactionAddedEvent
+}
+
+func (t *reactionWatch) accepts(ev ReactionAddedEvent) bool {
+	if len(t.reactions) > 0 && !containsString(t.reactions, ev.Reaction) {
+		return false
+	}
+
+	if len(t.users) > 0 && !containsString(t.users, ev.User) {
+		return false
+	}
+
+	return true
+}
+
+// Watch the message for any of the provided reactions, when no reactions are
+// provided any reaction resolves the watch.
+func (t *ReactionTracker) Watch(channelID, timestamp string, reactions []string, options ...ReactionWatchOption) ReactionAck {
+	w := &reactionWatch{
+		key:       channelID + ":" + timestamp,
+		reactions: reactions,
+		c:         make(chan ReactionAddedEvent, 1),
+	}
+
+	for _, opt := range options {
+		opt(w)
+	}
+
+	t.m.Lock()
+	t.watches[w.key] = append(t.watches[w.key], w)
+	t.m.Unlock()
+
+	return ReactionAck{C: w.c, watch: w, t: t}
+}
+
+// Handle a reaction_added event, resolving any watches it satisfies.
+// returns true if at least one watch was resolved.
+func (t *ReactionTracker) Handle(ev ReactionAddedEvent) bool {
+	if ev.Item.Type != "" && ev.Item.Type != TYPE_MESSAGE {
+		return false
+	}
+
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	key := ev.Item.Channel + ":" + ev.Item.Timestamp
+	resolved := false
+	remaining := t.watches[key][:0]
+	for _, w := range t.watches[key] {
+		if !w.accepts(ev) {
+			remaining = append(remaining, w)
+			continue
+		}
+
+		w.c <- ev
+		resolved = true
+	}
+
+	if len(remaining) == 0 {
+		delete(t.watches, key)
+	} else {
+		t.watches[key] = remaining
+	}
+
+	return resolved
+}
+
+func (t *ReactionTracker) remove(w *reactionWatch) bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	watches := t.watches[w.key]
+	for idx, candidate := range watches {
+		if candidate != w {
+			continue
+		}
+
+		watches = append(watches[:idx], watches[idx+1:]...)
+		if len(watches) == 0 {
+			delete(t.watches, w.key)
+		} else {
+			t.watches[w.key] = watches
+		}
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,40 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func reactionAdded(user, reaction, channel, ts string) ReactionAddedEvent {
+	ev := ReactionAddedEvent{Type: "reaction_added", User: user, Reaction: reaction}
+	ev.Item.Type = TYPE_MESSAGE
+	ev.Item.Channel = channel
+	ev.Item.Timestamp = ts
+	return ev
+}
+
+func TestReactionTracker(t *testing.T) {
+	tracker := NewReactionTracker()
+	ack := tracker.Watch("C1", "123.456", []string{"white_check_mark"}, ReactionWatchOptionUsers("U1"))
+
+	assert.False(t, tracker.Handle(reactionAdded("U1", "eyes", "C1", "123.456")))
+	assert.False(t, tracker.Handle(reactionAdded("U2", "white_check_mark", "C1", "123.456")))
+	assert.False(t, tracker.Handle(reactionAdded("U1", "white_check_mark", "C1", "999.999")))
+	assert.True(t, tracker.Handle(reactionAdded("U1", "white_check_mark", "C1", "123.456")))
+
+	ev := <-ack.C
+	assert.Equal(t, "U1", ev.User)
+
+	// resolved watches are removed.
+	assert.False(t, ack.Stop())
+	assert.False(t, tracker.Handle(reactionAdded("U1", "white_check_mark", "C1", "123.456")))
+}
+
+func TestReactionTrackerStop(t *testing.T) {
+	tracker := NewReactionTracker()
+	ack := tracker.Watch("C1", "123.456", nil)
+
+	assert.True(t, ack.Stop())
+	assert.False(t, tracker.Handle(reactionAdded("U1", "tada", "C1", "123.456")))
+}
@@ -0,0 +1,93 @@
+package slack
+
+import (
+	"net/http"
+	"path"
+)
+
+// readOnlyClient rejects requests to api methods which modify slack.
+type readOnlyClient struct {
+	httpClient
+}
+
+func (t readOnlyClient) Do(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || readOnlyMethod(path.Base(req.URL.Path)) {
+		return t.httpClient.Do(req)
+	}
+
+	// match the behavior of http.Client which always closes the body.
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	return nil, ErrReadOnly
+}
+
+// readOnlyMethods the api methods which only read data.
+var readOnlyMethods = map[string]bool{
+	"admin.conversations.search":       true,
+	"admin.users.list":                 true,
+	"api.test":                         true,
+	"auth.test":                        true,
+	"bots.info":                        true,
+	"channels.history":                 true,
+	"channels.info":                    true,
+	"channels.list":                    true,
+	"channels.replies":                 true,
+	"chat.getPermalink":                true,
+	"conversations.history":            true,
+	"conversations.info":               true,
+	"conversations.list":               true,
+	"conversations.listConnectInvites": true,
+	"conversations.members":            true,
+	"conversations.replies":            true,
+	"dnd.info":                         true,
+	"dnd.teamInfo":                     true,
+	"emoji.list":                       true,
+	"files.comments.list":              true,
+	"files.info":                       true,
+	"files.list":                       true,
+	"files.remote.info":                true,
+	"files.remote.list":                true,
+	"groups.history":                   true,
+	"groups.info":                      true,
+	"groups.list":                      true,
+	"groups.replies":                   true,
+	"im.history":                       true,
+	"im.list":                          true,
+	"im.replies":                       true,
+	"mpim.history":                     true,
+	"mpim.list":                        true,
+	"mpim.replies":                     true,
+	"pins.list":                        true,
+	"reactions.get":                    true,
+	"reactions.list":                   true,
+	"reminders.info":                   true,
+	"reminders.list":                   true,
+	"rtm.connect":                      true,
+	"rtm.start":                        true,
+	"search.all":                       true,
+	"search.files":                     true,
+	"search.messages":                  true,
+	"stars.list":                       true,
+	"team.accessLogs":                  true,
+	"team.billableInfo":                true,
+	"team.info":                        true,
+	"team.integrationLogs":             true,
+	"team.profile.get":                 true,
+	"usergroups.list":                  true,
+	"usergroups.users.list":            true,
+	"users.conversations":              true,
+	"users.getPresence":                true,
+	"users.identity":                   true,
+	"users.info":                       true,
+	"users.list":                       true,
+	"users.lookupByEmail":              true,
+	"users.profile.get":                true,
+}
+
+// readOnlyMethod reports whether the api method only reads data, methods missing from
+// readOnlyMethods are assumed to modify slack.
+func readOnlyMethod(method string) bool {
+	return readOnlyMethods[method]
+}
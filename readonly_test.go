@@ -0,0 +1,39 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionReadOnly(t *testing.T) {
+	http.HandleFunc("/readonly/conversations.history", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"messages":[]}`))
+	})
+	http.HandleFunc("/readonly/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		t.Error("read only client modified slack")
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionReadOnly(), OptionAPIURL("http://"+serverAddr+"/readonly/"))
+
+	_, err := api.GetConversationHistory(&GetConversationHistoryParameters{ChannelID: "C1"})
+	assert.Nil(t, err)
+
+	_, _, err = api.PostMessage("C1", MsgOptionText("hello", false))
+	assert.Equal(t, ErrReadOnly, err)
+
+	_, err = api.InviteUsersToConversation("C1", "U1")
+	assert.Equal(t, ErrReadOnly, err)
+}
+
+func TestReadOnlyMethod(t *testing.T) {
+	for _, method := range []string{"conversations.list", "users.info", "users.profile.get", "users.getPresence", "dnd.teamInfo", "auth.test", "rtm.connect", "search.messages", "users.conversations", "admin.conversations.search", "team.profile.get"} {
+		assert.True(t, readOnlyMethod(method), method)
+	}
+
+	for _, method := range []string{"chat.postMessage", "chat.update", "chat.delete", "conversations.invite", "conversations.archive", "auth.revoke", "files.upload", "dialog.open", "conversations.listen", "users.lister"} {
+		assert.False(t, readOnlyMethod(method), method)
+	}
+}
@@ -0,0 +1,236 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sync"
+)
+
+// redactedParams request parameters which are never recorded.
+var redactedParams = []string{"token", "client_secret", "refresh_token"}
+
+// Interaction a single recorded api request and its response.
+type Interaction struct {
+	Method     string `json:"method"`
+	Params     string `json:"params"`
+	StatusCode int    `json:"status_code"`
+	RetryAfter string `json:"retry_after,omitempty"`
+	Body       string `json:"body"`
+}
+
+func (t Interaction) key() string {
+	return t.Method + "?" + t.Params
+}
+
+// NewRecorder records the interactions performed by the client, use
+// OptionHTTPClient to install it and Save to write the fixtures.
+func NewRecorder(client httpClient) *Recorder {
+	return &Recorder{
+		client: client,
+	}
+}
+
+// Recorder http client which records api interactions for later replay. tokens are never
+// recorded, request headers (including Authorization) are discarded, the token and client
+// secret parameters are dropped, and tokens within the remaining parameters and the
+// response bodies are redacted, see RedactTokens. the Replayer redacts its requests the same way.
+type Recorder struct {
+	client       httpClient
+	m            sync.Mutex
+	interactions []Interaction
+}
+
+// Do implements the httpClient interface.
+func (t *Recorder) Do(req *http.Request) (*http.Response, error) {
+	params, err := normalizeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	t.m.Lock()
+	t.interactions = append(t.interactions, Interaction{
+		Method:     path.Base(req.URL.Path),
+		Params:     params,
+		StatusCode: resp.StatusCode,
+		RetryAfter: resp.Header.Get("Retry-After"),
+		Body:       RedactTokens(string(body)),
+	})
+	t.m.Unlock()
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// Interactions returns the interactions recorded so far.
+func (t *Recorder) Interactions() []Interaction {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	return append([]Interaction(nil), t.interactions...)
+}
+
+// Save writes the recorded interactions as JSON.
+func (t *Recorder) Save(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(t.Interactions())
+}
+
+// SaveFile writes the recorded interactions to the file at path.
+func (t *Recorder) SaveFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err = t.Save(f); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// NewReplayer replays the provided interactions.
+func NewReplayer(interactions ...Interaction) *Replayer {
+	return &Replayer{
+		interactions: interactions,
+		used:         make([]bool, len(interactions)),
+	}
+}
+
+// LoadReplayer replays the interactions saved by a Recorder.
+func LoadReplayer(r io.Reader) (*Replayer, error) {
+	var interactions []Interaction
+	if err := json.NewDecoder(r).Decode(&interactions); err != nil {
+		return nil, err
+	}
+
+	return NewReplayer(interactions...), nil
+}
+
+// LoadReplayerFile replays the interactions saved to the file at path.
+func LoadReplayerFile(path string) (*Replayer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadReplayer(f)
+}
+
+// Replayer http client which responds to api requests using recorded interactions,
+// allowing integration paths to be exercised without a token or network access.
+// Requests are matched by method and parameters, repeated requests are answered by
+// the recorded interactions in order, the final interaction is reused once exhausted.
+type Replayer struct {
+	m            sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// Do implements the httpClient interface.
+func (t *Replayer) Do(req *http.Request) (*http.Response, error) {
+	params, err := normalizeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	key := Interaction{Method: path.Base(req.URL.Path), Params: params}.key()
+
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	last := -1
+	for idx, interaction := range t.interactions {
+		if interaction.key() != key {
+			continue
+		}
+
+		last = idx
+		if !t.used[idx] {
+			t.used[idx] = true
+			return interaction.response(req), nil
+		}
+	}
+
+	if last == -1 {
+		return nil, fmt.Errorf("no recorded interaction for %s", key)
+	}
+
+	return t.interactions[last].response(req), nil
+}
+
+func (t Interaction) response(req *http.Request) *http.Response {
+	header := http.Header{"Content-Type": {"application/json"}}
+	if t.RetryAfter != "" {
+		header.Set("Retry-After", t.RetryAfter)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", t.StatusCode, http.StatusText(t.StatusCode)),
+		StatusCode:    t.StatusCode,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewBufferString(t.Body)),
+		ContentLength: int64(len(t.Body)),
+		Request:       req,
+	}
+}
+
+// normalizeRequest returns the parameters of the request in a stable form without tokens,
+// the body of the request is preserved for the underlying client.
+func normalizeRequest(req *http.Request) (string, error) {
+	values := req.URL.Query()
+
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return "", err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		mediatype, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		switch mediatype {
+		case "application/x-www-form-urlencoded":
+			form, err := url.ParseQuery(string(body))
+			if err != nil {
+				return "", err
+			}
+			for k, v := range form {
+				values[k] = v
+			}
+		case "application/json":
+			compacted := bytes.NewBuffer(nil)
+			if err = json.Compact(compacted, body); err == nil {
+				values.Set("json", compacted.String())
+			}
+		}
+	}
+
+	for _, k := range redactedParams {
+		values.Del(k)
+	}
+
+	return RedactTokens(values.Encode()), nil
+}
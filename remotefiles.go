@@ -0,0 +1,258 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RemoteFile a file hosted outside of slack, registered using files.remote.add.
+type RemoteFile struct {
+	ID              string   `json:"id"`
+	Created         JSONTime `json:"created"`
+	Timestamp       JSONTime `json:"timestamp"`
+	Name            string   `json:"name"`
+	Title           string   `json:"title"`
+	Mimetype        string   `json:"mimetype"`
+	Filetype        string   `json:"filetype"`
+	PrettyType      string   `json:"pretty_type"`
+	User            string   `json:"user"`
+	Editable        bool     `json:"editable"`
+	Size            int      `json:"size"`
+	Mode            string   `json:"mode"`
+	IsExternal      bool     `json:"is_external"`
+	ExternalType    string   `json:"external_type"`
+	IsPublic        bool     `json:"is_public"`
+	PublicURLShared bool     `json:"public_url_shared"`
+	DisplayAsBot    bool     `json:"display_as_bot"`
+	Username        string   `json:"username"`
+	URLPrivate      string   `json:"url_private"`
+	Permalink       string   `json:"permalink"`
+	CommentsCount   int      `json:"comments_count"`
+	IsStarred       bool     `json:"is_starred"`
+	Shares          Share    `json:"shares"`
+	Channels        []string `json:"channels"`
+	Groups          []string `json:"groups"`
+	IMs             []string `json:"ims"`
+	ExternalID      string   `json:"external_id"`
+	ExternalURL     string   `json:"external_url"`
+	HasRichPreview  bool     `json:"has_rich_preview"`
+}
+
+// RemoteFileParameters contains the parameters for adding or updating a remote file.
+//
+// ExternalID, ExternalURL, and Title are required when adding a file. The optional preview
+// image is uploaded from a local path in PreviewImage or from PreviewImageReader.
+type RemoteFileParameters struct {
+	ExternalID            string
+	ExternalURL           string
+	Title                 string
+	Filetype              string
+	IndexableFileContents string
+	PreviewImage          string
+	PreviewImageReader    io.Reader
+}
+
+// ListRemoteFilesParameters contains the optional parameters for listing remote files.
+type ListRemoteFilesParameters struct {
+	Channel       string
+	Cursor        string
+	Limit         int
+	TimestampFrom JSONTime
+	TimestampTo   JSONTime
+}
+
+type remoteFileResponseFull struct {
+	RemoteFile `json:"file"`
+	SlackResponse
+}
+
+type remoteFilesResponseFull struct {
+	Files            []RemoteFile     `json:"files"`
+	ResponseMetaData responseMetaData `json:"response_metadata"`
+	SlackResponse
+}
+
+func (api *Client) remoteFileRequest(ctx context.Context, path string, values url.Values, params RemoteFileParameters) (*RemoteFile, error) {
+	var (
+		err error
+	)
+
+	response := &remoteFileResponseFull{}
+	switch {
+	case params.PreviewImage != "":
+		err = postLocalWithMultipartResponse(ctx, api.httpclient, api.endpoint+path, params.PreviewImage, "preview_image", values, response, api)
+	case params.PreviewImageReader != nil:
+		err = postWithMultipartResponse(ctx, api.httpclient, api.endpoint+path, "preview.png", "preview_image", values, params.PreviewImageReader, response, api)
+	default:
+		err = api.postMethod(ctx, path, values, response)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.RemoteFile, response.Err()
+}
+
+// remoteFileIdentity selects a remote file by either its external id or file id.
+func remoteFileIdentity(token, externalID, fileID string) url.Values {
+	values := url.Values{
+		"token": {token},
+	}
+
+	if externalID != "" {
+		values.Add("external_id", externalID)
+	}
+
+	if fileID != "" {
+		values.Add("file", fileID)
+	}
+
+	return values
+}
+
+func (t RemoteFileParameters) values(values url.Values) url.Values {
+	if t.ExternalURL != "" {
+		values.Add("external_url", t.ExternalURL)
+	}
+
+	if t.Title != "" {
+		values.Add("title", t.Title)
+	}
+
+	if t.Filetype != "" {
+		values.Add("filetype", t.Filetype)
+	}
+
+	if t.IndexableFileContents != "" {
+		values.Add("indexable_file_contents", t.IndexableFileContents)
+	}
+
+	return values
+}
+
+// AddRemoteFile registers an externally hosted file, see AddRemoteFileContext.
+func (api *Client) AddRemoteFile(params RemoteFileParameters) (*RemoteFile, error) {
+	return api.AddRemoteFileContext(context.Background(), params)
+}
+
+// AddRemoteFileContext registers an externally hosted file with slack using files.remote.add
+// with a custom context, allowing the file to be shared and unfurled natively.
+func (api *Client) AddRemoteFileContext(ctx context.Context, params RemoteFileParameters) (*RemoteFile, error) {
+	if params.ExternalID == "" || params.ExternalURL == "" || params.Title == "" {
+		return nil, fmt.Errorf("files.remote.add: RemoteFileParameters.ExternalID, ExternalURL, and Title are mandatory")
+	}
+
+	values := params.values(remoteFileIdentity(api.token, params.ExternalID, ""))
+	return api.remoteFileRequest(ctx, "files.remote.add", values, params)
+}
+
+// UpdateRemoteFile updates a remote file, see UpdateRemoteFileContext.
+func (api *Client) UpdateRemoteFile(fileID string, params RemoteFileParameters) (*RemoteFile, error) {
+	return api.UpdateRemoteFileContext(context.Background(), fileID, params)
+}
+
+// UpdateRemoteFileContext updates a remote file identified by either its file id or
+// params.ExternalID using files.remote.update with a custom context. only the provided
+// parameters are updated.
+func (api *Client) UpdateRemoteFileContext(ctx context.Context, fileID string, params RemoteFileParameters) (*RemoteFile, error) {
+	if fileID == "" && params.ExternalID == "" {
+		return nil, ErrParametersMissing
+	}
+
+	values := params.values(remoteFileIdentity(api.token, params.ExternalID, fileID))
+	return api.remoteFileRequest(ctx, "files.remote.update", values, params)
+}
+
+// GetRemoteFileInfo retrieves a remote file, see GetRemoteFileInfoContext.
+func (api *Client) GetRemoteFileInfo(externalID, fileID string) (*RemoteFile, error) {
+	return api.GetRemoteFileInfoContext(context.Background(), externalID, fileID)
+}
+
+// GetRemoteFileInfoContext retrieves a remote file identified by either its external id or
+// file id using files.remote.info with a custom context.
+func (api *Client) GetRemoteFileInfoContext(ctx context.Context, externalID, fileID string) (*RemoteFile, error) {
+	if externalID == "" && fileID == "" {
+		return nil, ErrParametersMissing
+	}
+
+	return api.remoteFileRequest(ctx, "files.remote.info", remoteFileIdentity(api.token, externalID, fileID), RemoteFileParameters{})
+}
+
+// ShareRemoteFile shares a remote file to channels, see ShareRemoteFileContext.
+func (api *Client) ShareRemoteFile(channels []string, externalID, fileID string) (*RemoteFile, error) {
+	return api.ShareRemoteFileContext(context.Background(), channels, externalID, fileID)
+}
+
+// ShareRemoteFileContext shares a remote file identified by either its external id or
+// file id to the channels using files.remote.share with a custom context.
+func (api *Client) ShareRemoteFileContext(ctx context.Context, channels []string, externalID, fileID string) (*RemoteFile, error) {
+	if len(channels) == 0 || (externalID == "" && fileID == "") {
+		return nil, ErrParametersMissing
+	}
+
+	values := remoteFileIdentity(api.token, externalID, fileID)
+	values.Add("channels", strings.Join(channels, ","))
+
+	return api.remoteFileRequest(ctx, "files.remote.share", values, RemoteFileParameters{})
+}
+
+// RemoveRemoteFile removes a remote file, see RemoveRemoteFileContext.
+func (api *Client) RemoveRemoteFile(externalID, fileID string) error {
+	return api.RemoveRemoteFileContext(context.Background(), externalID, fileID)
+}
+
+// RemoveRemoteFileContext removes a remote file identified by either its external id or
+// file id using files.remote.remove with a custom context.
+func (api *Client) RemoveRemoteFileContext(ctx context.Context, externalID, fileID string) error {
+	if externalID == "" && fileID == "" {
+		return ErrParametersMissing
+	}
+
+	_, err := api.remoteFileRequest(ctx, "files.remote.remove", remoteFileIdentity(api.token, externalID, fileID), RemoteFileParameters{})
+	return err
+}
+
+// ListRemoteFiles lists remote files, see ListRemoteFilesContext.
+func (api *Client) ListRemoteFiles(params ListRemoteFilesParameters) ([]RemoteFile, string, error) {
+	return api.ListRemoteFilesContext(context.Background(), params)
+}
+
+// ListRemoteFilesContext lists the remote files visible to the token using files.remote.list
+// with a custom context, returns the cursor of the next page.
+func (api *Client) ListRemoteFilesContext(ctx context.Context, params ListRemoteFilesParameters) ([]RemoteFile, string, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+
+	if params.Channel != "" {
+		values.Add("channel", params.Channel)
+	}
+
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+
+	if params.Limit > 0 {
+		values.Add("limit", strconv.Itoa(params.Limit))
+	}
+
+	if params.TimestampFrom != 0 {
+		values.Add("ts_from", strconv.FormatInt(int64(params.TimestampFrom), 10))
+	}
+
+	if params.TimestampTo != 0 {
+		values.Add("ts_to", strconv.FormatInt(int64(params.TimestampTo), 10))
+	}
+
+	response := &remoteFilesResponseFull{}
+	if err := api.postMethod(ctx, "files.remote.list", values, response); err != nil {
+		return nil, "", err
+	}
+
+	return response.Files, response.ResponseMetaData.NextCursor, response.Err()
+}
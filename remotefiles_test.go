@@ -0,0 +1,69 @@
+package slack
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddRemoteFileWithPreview(t *testing.T) {
+	http.HandleFunc("/remote/files.remote.add", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "ext-1", r.URL.Query().Get("external_id"))
+		assert.Equal(t, "https://example.com/doc", r.URL.Query().Get("external_url"))
+
+		file, _, err := r.FormFile("preview_image")
+		assert.Nil(t, err)
+		preview, _ := ioutil.ReadAll(file)
+		assert.Equal(t, "png-bytes", string(preview))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"file":{"id":"F1","title":"Doc","external_id":"ext-1","external_url":"https://example.com/doc","is_external":true}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/remote/"))
+
+	_, err := api.AddRemoteFile(RemoteFileParameters{ExternalID: "ext-1"})
+	assert.NotNil(t, err)
+
+	file, err := api.AddRemoteFile(RemoteFileParameters{
+		ExternalID:         "ext-1",
+		ExternalURL:        "https://example.com/doc",
+		Title:              "Doc",
+		PreviewImageReader: strings.NewReader("png-bytes"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "F1", file.ID)
+	assert.Equal(t, "ext-1", file.ExternalID)
+	assert.True(t, file.IsExternal)
+}
+
+func TestListRemoteFiles(t *testing.T) {
+	http.HandleFunc("/remote/files.remote.list", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "C1", r.FormValue("channel"))
+		assert.Equal(t, "10", r.FormValue("limit"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"files":[{"id":"F1"},{"id":"F2"}],"response_metadata":{"next_cursor":"abc"}}`))
+	})
+	http.HandleFunc("/remote/files.remote.share", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "C1,C2", r.FormValue("channels"))
+		assert.Equal(t, "F1", r.FormValue("file"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"file":{"id":"F1","channels":["C1","C2"]}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/remote/"))
+
+	files, cursor, err := api.ListRemoteFiles(ListRemoteFilesParameters{Channel: "C1", Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", cursor)
+	assert.Equal(t, 2, len(files))
+
+	file, err := api.ShareRemoteFile([]string{"C1", "C2"}, "", "F1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C1", "C2"}, file.Channels)
+}
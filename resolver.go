@@ -0,0 +1,194 @@
+package slack
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ChannelResolverOption options for the ChannelResolver.
+type ChannelResolverOption func(*ChannelResolver)
+
+// ChannelResolverOptionTTL duration the name to ID mapping is cached for, defaults to 15 minutes.
+func ChannelResolverOptionTTL(d time.Duration) ChannelResolverOption {
+	return func(r *ChannelResolver) {
+		r.ttl = d
+	}
+}
+
+// ChannelResolverOptionMissInterval minimum duration between the refreshes triggered by
+// names missing from the cached mapping, defaults to 1 minute.
+func ChannelResolverOptionMissInterval(d time.Duration) ChannelResolverOption {
+	return func(r *ChannelResolver) {
+		r.missInterval = d
+	}
+}
+
+// ChannelResolverOptionTypes the conversation types considered when resolving names,
+// defaults to public and private channels.
+func ChannelResolverOptionTypes(types ...string) ChannelResolverOption {
+	return func(r *ChannelResolver) {
+		r.types = types
+	}
+}
+
+// NewChannelResolver resolves channel names to their IDs using conversations.list.
+func NewChannelResolver(api *Client, options ...ChannelResolverOption) *ChannelResolver {
+	r := &ChannelResolver{
+		api:          api,
+		ttl:          15 * time.Minute,
+		missInterval: time.Minute,
+		types:        []string{"public_channel", "private_channel"},
+		now:          time.Now,
+		teams:        make(map[string]*channelNames),
+	}
+
+	for _, opt := range options {
+		opt(r)
+	}
+
+	return r
+}
+
+// ChannelResolver maps channel names ("#alerts") to their IDs, the mapping of each
+// workspace is cached separately and refreshed once it has expired or a name is missing
+// from it. lookups of fresh names are not blocked by an in flight refresh. Safe for concurrent use.
+type ChannelResolver struct {
+	api          *Client
+	ttl          time.Duration
+	missInterval time.Duration
+	types        []string
+	now          func() time.Time
+	m            sync.Mutex
+	teams        map[string]*channelNames
+}
+
+// channelNames the cached name to ID mapping of a single workspace.
+type channelNames struct {
+	m          sync.Mutex
+	ids        map[string]string
+	refreshed  time.Time
+	expires    time.Time
+	refreshing chan struct{} // closed once the in flight refresh completes.
+}
+
+// ResolveChannelID returns the ID of the channel with the given name, the leading '#' is optional.
+// returns ErrChannelNotFound when no channel matches the name. see ResolveTeamChannelID.
+func (t *ChannelResolver) ResolveChannelID(ctx context.Context, name string) (string, error) {
+	return t.ResolveTeamChannelID(ctx, "", name)
+}
+
+// ResolveTeamChannelID returns the ID of the channel with the given name within the workspace, required
+// when using an org wide token as channel names are only unique within a workspace. returns
+// ErrChannelNotFound when no channel matches the name.
+func (t *ChannelResolver) ResolveTeamChannelID(ctx context.Context, teamID, name string) (string, error) {
+	names := t.team(teamID)
+	name = normalizeChannelName(name)
+
+	for {
+		names.m.Lock()
+		id, ok := names.ids[name]
+		now := t.now()
+		// channels created since the last refresh are picked up by refreshing on a miss,
+		// at most once per miss interval.
+		stale := !now.Before(names.expires) || (!ok && !now.Before(names.refreshed.Add(t.missInterval)))
+		if !stale {
+			names.m.Unlock()
+			return resolved(id, ok)
+		}
+
+		// another caller is already refreshing the mapping, wait for it to complete.
+		if refreshing := names.refreshing; refreshing != nil {
+			names.m.Unlock()
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-refreshing:
+				continue
+			}
+		}
+
+		refreshing := make(chan struct{})
+		names.refreshing = refreshing
+		names.m.Unlock()
+
+		ids, err := t.load(ctx, teamID)
+
+		names.m.Lock()
+		names.refreshing = nil
+		if err == nil {
+			names.ids = ids
+			names.refreshed = t.now()
+			names.expires = names.refreshed.Add(t.ttl)
+		}
+		names.m.Unlock()
+		close(refreshing)
+
+		if err != nil {
+			return "", err
+		}
+
+		id, ok = ids[name]
+		return resolved(id, ok)
+	}
+}
+
+// Invalidate discards the cached mappings of the workspaces, every workspace when
+// none are provided. the next lookup will refresh the mapping.
+func (t *ChannelResolver) Invalidate(teamIDs ...string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if len(teamIDs) == 0 {
+		t.teams = make(map[string]*channelNames)
+		return
+	}
+
+	for _, teamID := range teamIDs {
+		delete(t.teams, teamID)
+	}
+}
+
+func (t *ChannelResolver) team(teamID string) *channelNames {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	names, ok := t.teams[teamID]
+	if !ok {
+		names = &channelNames{}
+		t.teams[teamID] = names
+	}
+
+	return names
+}
+
+// load the name to ID mapping of the workspace.
+func (t *ChannelResolver) load(ctx context.Context, teamID string) (map[string]string, error) {
+	ids := make(map[string]string)
+	params := GetConversationsParameters{
+		ExcludeArchived: "true",
+		Types:           t.types,
+		Limit:           1000,
+		TeamID:          teamID,
+	}
+
+	err := t.api.ForEachConversationContext(ctx, params, func(c Channel) error {
+		ids[normalizeChannelName(c.Name)] = c.ID
+		return nil
+	})
+
+	return ids, err
+}
+
+func resolved(id string, ok bool) (string, error) {
+	if !ok {
+		return "", ErrChannelNotFound
+	}
+
+	return id, nil
+}
+
+func normalizeChannelName(name string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), "#"))
+}
@@ -0,0 +1,158 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelResolver(t *testing.T) {
+	var (
+		calls int
+	)
+
+	http.HandleFunc("/resolver/conversations.list", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("cursor") == "" {
+			w.Write([]byte(`{"ok":true,"channels":[{"id":"C1","name":"general"}],"response_metadata":{"next_cursor":"page2"}}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"channels":[{"id":"C2","name":"alerts"}],"response_metadata":{"next_cursor":""}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/resolver/"))
+	ctx := context.Background()
+	now := time.Now()
+	r := NewChannelResolver(api, ChannelResolverOptionTTL(time.Minute))
+	r.now = func() time.Time { return now }
+
+	id, err := r.ResolveChannelID(ctx, "#alerts")
+	assert.Nil(t, err)
+	assert.Equal(t, "C2", id)
+	assert.Equal(t, 2, calls)
+
+	id, err = r.ResolveChannelID(ctx, "General")
+	assert.Nil(t, err)
+	assert.Equal(t, "C1", id)
+
+	_, err = r.ResolveChannelID(ctx, "#missing")
+	assert.Equal(t, ErrChannelNotFound, err)
+	assert.Equal(t, 2, calls)
+
+	now = now.Add(2 * time.Minute)
+	id, err = r.ResolveChannelID(ctx, "alerts")
+	assert.Nil(t, err)
+	assert.Equal(t, "C2", id)
+	assert.Equal(t, 4, calls)
+}
+
+func TestChannelResolverTeams(t *testing.T) {
+	http.HandleFunc("/resolverteams/conversations.list", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("team_id") {
+		case "T1":
+			w.Write([]byte(`{"ok":true,"channels":[{"id":"C1","name":"alerts"}]}`))
+		case "T2":
+			w.Write([]byte(`{"ok":true,"channels":[{"id":"C2","name":"alerts"}]}`))
+		}
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/resolverteams/"))
+	ctx := context.Background()
+	r := NewChannelResolver(api)
+
+	id, err := r.ResolveTeamChannelID(ctx, "T1", "#alerts")
+	assert.Nil(t, err)
+	assert.Equal(t, "C1", id)
+
+	id, err = r.ResolveTeamChannelID(ctx, "T2", "#alerts")
+	assert.Nil(t, err)
+	assert.Equal(t, "C2", id)
+}
+
+func TestChannelResolverMiss(t *testing.T) {
+	var (
+		calls int32
+	)
+
+	http.HandleFunc("/resolvermiss/conversations.list", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Write([]byte(`{"ok":true,"channels":[{"id":"C1","name":"general"}]}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"channels":[{"id":"C1","name":"general"},{"id":"C2","name":"created"}]}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/resolvermiss/"))
+	ctx := context.Background()
+	now := time.Now()
+	r := NewChannelResolver(api, ChannelResolverOptionTTL(time.Hour), ChannelResolverOptionMissInterval(time.Minute))
+	r.now = func() time.Time { return now }
+
+	_, err := r.ResolveChannelID(ctx, "#created")
+	assert.Equal(t, ErrChannelNotFound, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// misses within the interval are served from the cache.
+	_, err = r.ResolveChannelID(ctx, "#created")
+	assert.Equal(t, ErrChannelNotFound, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	now = now.Add(2 * time.Minute)
+	id, err := r.ResolveChannelID(ctx, "#created")
+	assert.Nil(t, err)
+	assert.Equal(t, "C2", id)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestChannelResolverConcurrentRefresh(t *testing.T) {
+	var (
+		calls int32
+		wg    sync.WaitGroup
+	)
+
+	release := make(chan struct{})
+	http.HandleFunc("/resolverconcurrent/conversations.list", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channels":[{"id":"C1","name":"general"}]}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/resolverconcurrent/"))
+	r := NewChannelResolver(api)
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := r.ResolveChannelID(context.Background(), "#general")
+			assert.Nil(t, err)
+			assert.Equal(t, "C1", id)
+		}()
+	}
+
+	// callers waiting on the refresh respect their context.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	for atomic.LoadInt32(&calls) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	_, err := r.ResolveChannelID(ctx, "#general")
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,138 @@
+package slack
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+// OptionRetry retries requests up to n times. rate limited requests are always retried
+// after the duration requested by slack. network and server errors are only retried for
+// idempotent requests, methods which read data (e.g. conversations.history) or requests with
+// an Idempotency-Key header, since methods like chat.postMessage may have been applied and
+// would be duplicated. other failures back off exponentially. Requests are replayed from the
+// start, uploads from readers which do not implement io.Seeker cannot be replayed and fail
+// with a NotReplayableError instead of being retried.
+func OptionRetry(n int) func(*Client) {
+	return func(c *Client) {
+		c.retries = n
+	}
+}
+
+// NotReplayableError a failed request could have been retried but its body could not be
+// rewound, e.g. a file upload from a reader which does not implement io.Seeker.
+type NotReplayableError struct {
+	Cause error
+}
+
+func (t NotReplayableError) Error() string {
+	return fmt.Sprintf("request cannot be retried, the body is not replayable: %v", t.Cause)
+}
+
+// Unwrap returns the failure which prompted the retry.
+func (t NotReplayableError) Unwrap() error {
+	return t.Cause
+}
+
+// retryClient retries failed requests.
+type retryClient struct {
+	httpClient
+	retries int
+	d       debug
+}
+
+func (t retryClient) Do(req *http.Request) (*http.Response, error) {
+	b := backoff{Initial: 500 * time.Millisecond, Jitter: 250 * time.Millisecond}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.httpClient.Do(req)
+
+		delay, retry := t.retryable(req, resp, err, &b)
+		if !retry || attempt >= t.retries {
+			return resp, err
+		}
+
+		cause := err
+		if resp != nil {
+			cause = checkStatusCode(resp, t.d)
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if req, err = rewind(req); err != nil {
+			return nil, NotReplayableError{Cause: cause}
+		}
+
+		t.d.Debugf("%s %s failed, retrying in %s: %v", req.Method, req.URL.Path, delay, cause)
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryable determines whether the request should be retried and how long to wait.
+func (t retryClient) retryable(req *http.Request, resp *http.Response, err error, b *backoff) (time.Duration, bool) {
+	if err != nil {
+		return b.Duration(), req.Context().Err() == nil && idempotent(req)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		// rate limited requests were not processed and are safe to retry.
+		if retry, cause := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64); cause == nil {
+			return time.Duration(retry) * time.Second, true
+		}
+
+		return b.Duration(), true
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return b.Duration(), idempotent(req)
+	default:
+		return 0, false
+	}
+}
+
+// idempotent reports whether the request can be repeated without side effects, following
+// the conventions of http.Transport for the Idempotency-Key headers.
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	if _, ok := req.Header["Idempotency-Key"]; ok {
+		return true
+	}
+
+	if _, ok := req.Header["X-Idempotency-Key"]; ok {
+		return true
+	}
+
+	return readOnlyMethod(path.Base(req.URL.Path))
+}
+
+// rewind returns a copy of the request with a fresh body.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("request body cannot be rewound")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	replay := req.Clone(req.Context())
+	replay.Body = body
+	return replay, nil
+}
@@ -0,0 +1,153 @@
+package slack
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRetryRewindsSeekableUpload(t *testing.T) {
+	var attempts int
+
+	http.HandleFunc("/retry/auth.test", authTestHandler)
+	http.HandleFunc("/retry/files.upload", func(rw http.ResponseWriter, r *http.Request) {
+		attempts++
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("unexpected error reading the upload: %v", err)
+			return
+		}
+		defer file.Close()
+
+		content, _ := ioutil.ReadAll(file)
+		if string(content) != "retried content" {
+			t.Errorf("attempt %d: expected the full content, got %q", attempts, content)
+		}
+
+		if attempts == 1 {
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		uploadFileHandler(rw, r)
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/retry/"), OptionRetry(2))
+
+	_, err := api.UploadFile(FileUploadParameters{
+		Filename: "test.txt",
+		Reader:   strings.NewReader("retried content"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryNotReplayableUpload(t *testing.T) {
+	var attempts int
+
+	http.HandleFunc("/retrynoseek/auth.test", authTestHandler)
+	http.HandleFunc("/retrynoseek/files.upload", func(rw http.ResponseWriter, r *http.Request) {
+		attempts++
+		io.Copy(ioutil.Discard, r.Body)
+		rw.Header().Set("Retry-After", "0")
+		rw.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/retrynoseek/"), OptionRetry(2))
+
+	_, err := api.UploadFile(FileUploadParameters{
+		Filename: "test.txt",
+		Reader:   struct{ io.Reader }{strings.NewReader("content")},
+	})
+
+	var replay NotReplayableError
+	if !errors.As(err, &replay) {
+		t.Fatalf("expected a NotReplayableError, got %v", err)
+	}
+
+	var limited *RateLimitedError
+	if !errors.As(err, &limited) {
+		t.Errorf("expected the rate limit to be the cause, got %v", replay.Cause)
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestRetryOnlyIdempotentServerErrors(t *testing.T) {
+	var posts, reads int
+
+	http.HandleFunc("/retryidempotent/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		posts++
+		rw.WriteHeader(http.StatusInternalServerError)
+	})
+	http.HandleFunc("/retryidempotent/conversations.history", func(rw http.ResponseWriter, r *http.Request) {
+		reads++
+		if reads == 1 {
+			rw.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"messages":[]}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/retryidempotent/"), OptionRetry(2))
+
+	if _, _, err := api.PostMessage("C1", MsgOptionText("hello", false)); err == nil {
+		t.Error("expected the server error to be returned")
+	}
+
+	if posts != 1 {
+		t.Errorf("expected chat.postMessage to not be retried, got %d attempts", posts)
+	}
+
+	if _, err := api.GetConversationHistory(&GetConversationHistoryParameters{ChannelID: "C1"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if reads != 2 {
+		t.Errorf("expected conversations.history to be retried, got %d attempts", reads)
+	}
+}
+
+func TestRetryUploadToURLRereadsSections(t *testing.T) {
+	var bodies []string
+
+	http.HandleFunc("/retrysections/upload", func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+		}
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionRetry(2))
+
+	// the upload starts from the current offset of the reader.
+	r := strings.NewReader("skip:content")
+	r.Seek(5, io.SeekStart)
+
+	if err := api.UploadToURL("http://"+serverAddr+"/retrysections/upload", 7, r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(bodies) != 2 || bodies[0] != "content" || bodies[1] != "content" {
+		t.Errorf("expected the content to be resent, got %q", bodies)
+	}
+}
@@ -2,7 +2,9 @@ package slack
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"net/http"
 	"net/url"
 	"sync"
 	"time"
@@ -15,17 +17,33 @@ const (
 	defaultPingInterval     = 30 * time.Second
 )
 
+// Reconnection backoff defaults, see RTMOptionReconnectBackoff.
+const (
+	DefaultReconnectInitial = 100 * time.Millisecond
+	DefaultReconnectMax     = 5 * time.Minute
+)
+
+// Outgoing message rate limit defaults, slack allows roughly a message per second with
+// short bursts. see RTMOptionRateLimit.
+const (
+	DefaultOutgoingInterval = time.Second
+	DefaultOutgoingBurst    = 5
+)
+
 const (
 	rtmEventTypeAck                 = ""
 	rtmEventTypeHello               = "hello"
 	rtmEventTypeGoodbye             = "goodbye"
 	rtmEventTypePong                = "pong"
 	rtmEventTypeDesktopNotification = "desktop_notification"
+	rtmEventTypeTeamMigration       = "team_migration_started"
 )
 
 // StartRTM calls the "rtm.start" endpoint and returns the provided URL and the full Info block.
 //
 // To have a fully managed Websocket connection, use `NewRTM`, and call `ManageConnection()` on it.
+//
+// Deprecated: rtm.start is deprecated by slack, use ConnectRTM.
 func (api *Client) StartRTM() (info *Info, websocketURL string, err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), websocketDefaultTimeout)
 	defer cancel()
@@ -36,6 +54,8 @@ func (api *Client) StartRTM() (info *Info, websocketURL string, err error) {
 // StartRTMContext calls the "rtm.start" endpoint and returns the provided URL and the full Info block with a custom context.
 //
 // To have a fully managed Websocket connection, use `NewRTM`, and call `ManageConnection()` on it.
+//
+// Deprecated: rtm.start is deprecated by slack, use ConnectRTMContext.
 func (api *Client) StartRTMContext(ctx context.Context) (info *Info, websocketURL string, err error) {
 	response := &infoResponseFull{}
 	err = api.postMethod(ctx, "rtm.start", url.Values{"token": {api.token}}, response)
@@ -78,6 +98,8 @@ type RTMOption func(*RTM)
 
 // RTMOptionUseStart as of 11th July 2017 you should prefer setting this to false, see:
 // https://api.slack.com/changelog/2017-04-start-using-rtm-connect-and-stop-using-rtm-start
+//
+// Deprecated: the managed connection uses rtm.connect by default, rtm.start is deprecated by slack.
 func RTMOptionUseStart(b bool) RTMOption {
 	return func(rtm *RTM) {
 		rtm.useRTMStart = b
@@ -92,6 +114,23 @@ func RTMOptionDialer(d *websocket.Dialer) RTMOption {
 	}
 }
 
+// RTMOptionProxy connects to the websocket through the proxy returned by the function,
+// e.g. http.ProxyURL. By default the proxy is determined by the environment, see
+// http.ProxyFromEnvironment.
+func RTMOptionProxy(proxy func(*http.Request) (*url.URL, error)) RTMOption {
+	return func(rtm *RTM) {
+		rtm.proxy = proxy
+	}
+}
+
+// RTMOptionTLSConfig the tls configuration of the websocket connection, e.g. to trust the
+// certificate authority of an intercepting egress proxy.
+func RTMOptionTLSConfig(config *tls.Config) RTMOption {
+	return func(rtm *RTM) {
+		rtm.tlsConfig = config
+	}
+}
+
 // RTMOptionPingInterval determines how often to deliver a ping message to slack.
 func RTMOptionPingInterval(d time.Duration) RTMOption {
 	return func(rtm *RTM) {
@@ -100,6 +139,70 @@ func RTMOptionPingInterval(d time.Duration) RTMOption {
 	}
 }
 
+// RTMOptionReconnectBackoff the exponential backoff between connection attempts, starting at
+// initial and capped at max. a random jitter of up to initial is added to every attempt,
+// defaults to DefaultReconnectInitial and DefaultReconnectMax.
+func RTMOptionReconnectBackoff(initial, max time.Duration) RTMOption {
+	return func(rtm *RTM) {
+		rtm.reconnect = backoff{Initial: initial, Jitter: initial, Max: max}
+	}
+}
+
+// RTMOptionPongDeadline determines how long to wait for a pong before the connection is
+// considered dead and is reconnected, defaults to four times the ping interval. Increase it
+// when proxies between the app and slack delay the websocket traffic.
+func RTMOptionPongDeadline(d time.Duration) RTMOption {
+	return func(rtm *RTM) {
+		rtm.pongDeadline = d
+		rtm.resetDeadman()
+	}
+}
+
+// RTMOptionRateLimit limits the messages sent over the websocket to bursts of up to burst
+// messages, replenished at a message per interval. messages exceeding the limit are queued
+// until they can be sent rather than flooding slack, which disconnects the client. an interval
+// of zero disables the limit. defaults to DefaultOutgoingInterval and DefaultOutgoingBurst.
+func RTMOptionRateLimit(interval time.Duration, burst int) RTMOption {
+	return func(rtm *RTM) {
+		rtm.outgoingLimit = newTokenBucket(interval, burst)
+	}
+}
+
+// RTMOptionEvents decodes the events of the types into the provided structs, extending or
+// overriding EventMapping for the RTM without modifying it. events of unknown types are
+// reported as UnmarshallingErrorEvents.
+//
+//	rtm := api.NewRTM(slack.RTMOptionEvents(map[string]interface{}{"custom_event": CustomEvent{}}))
+func RTMOptionEvents(mapping map[string]interface{}) RTMOption {
+	return func(rtm *RTM) {
+		if rtm.events == nil {
+			rtm.events = make(map[string]interface{}, len(mapping))
+		}
+
+		for typeStr, v := range mapping {
+			rtm.events[typeStr] = v
+		}
+	}
+}
+
+// RTMOptionAckTimeout tracks the messages sent over the websocket, emitting an AckErrorEvent
+// with ErrAckTimeout when slack does not acknowledge a message within the timeout. Only
+// messages of the message type are acknowledged by slack and tracked.
+func RTMOptionAckTimeout(d time.Duration) RTMOption {
+	return func(rtm *RTM) {
+		rtm.ackTimeout = d
+	}
+}
+
+// RTMOptionRawEvents delivers events of unknown types as a RawEvent carrying the original json,
+// allowing new event types to be handled before the library supports them. by default unknown
+// events are reported as an UnmarshallingErrorEvent.
+func RTMOptionRawEvents() RTMOption {
+	return func(rtm *RTM) {
+		rtm.rawUnknown = true
+	}
+}
+
 // RTMOptionConnParams installs parameters to embed into the connection URL.
 func RTMOptionConnParams(connParams url.Values) RTMOption {
 	return func(rtm *RTM) {
@@ -107,8 +210,43 @@ func RTMOptionConnParams(connParams url.Values) RTMOption {
 	}
 }
 
+// RTMOptionMessageStore records every message observed by the RTM connection in the store.
+func RTMOptionMessageStore(store MessageStore) RTMOption {
+	return func(rtm *RTM) {
+		rtm.messages = store
+	}
+}
+
+// RTMOptionUserCache caches users observed by user_change events, emitting a user_changed
+// event with the changed fields when the previous version of the user is cached.
+// seed the cache with the users of the workspace, e.g. the result of GetUsers.
+func RTMOptionUserCache(cache *UserCache) RTMOption {
+	return func(rtm *RTM) {
+		rtm.users = cache
+	}
+}
+
+// RTMOptionTopicTracker tracks the topic and purpose of conversations using the provided
+// tracker, allowing it to be seeded before connecting, see TopicTracker.Seed.
+func RTMOptionTopicTracker(tracker *TopicTracker) RTMOption {
+	return func(rtm *RTM) {
+		rtm.topics = tracker
+	}
+}
+
+// RTMOptionPresenceTracker tracks the presence and do not disturb status of users, subscribing
+// to the presence of the tracker's users whenever the connection is established.
+func RTMOptionPresenceTracker(tracker *PresenceTracker) RTMOption {
+	return func(rtm *RTM) {
+		rtm.presence = tracker
+		rtm.subscriptions = tracker
+	}
+}
+
 // NewRTM returns a RTM, which provides a fully managed connection to
-// Slack's websocket-based Real-Time Messaging protocol.
+// Slack's websocket-based Real-Time Messaging protocol. the connection is
+// established via rtm.connect once ManageConnection is called, events are
+// delivered on IncomingEvents and messages are sent with SendMessage.
 func (api *Client) NewRTM(options ...RTMOption) *RTM {
 	result := &RTM{
 		Client:           *api,
@@ -119,10 +257,24 @@ func (api *Client) NewRTM(options ...RTMOption) *RTM {
 		killChannel:      make(chan bool),
 		disconnected:     make(chan struct{}),
 		disconnectedm:    &sync.Once{},
+		managed:          &sync.Once{},
 		forcePing:        make(chan bool),
 		rawEvents:        make(chan json.RawMessage),
 		idGen:            NewSafeID(1),
 		mu:               &sync.Mutex{},
+		readers:          &sync.WaitGroup{},
+		topics:           NewTopicTracker(),
+		subscriptions:    NewPresenceTracker(),
+		presenceSub:      make(chan struct{}, 1),
+		handlers:         newRTMHandlers(),
+		state:            newRTMState(),
+		pendingAcks:      make(map[int]time.Time),
+		outgoingLimit:    newTokenBucket(DefaultOutgoingInterval, DefaultOutgoingBurst),
+		reconnect: backoff{
+			Initial: DefaultReconnectInitial,
+			Jitter:  DefaultReconnectInitial,
+			Max:     DefaultReconnectMax,
+		},
 	}
 
 	for _, opt := range options {
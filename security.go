@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -18,10 +19,61 @@ const (
 	hTimestamp = "X-Slack-Request-Timestamp"
 )
 
+// DefaultSecretsVerifierTolerance is the maximum age of a request accepted by the SecretsVerifier.
+const DefaultSecretsVerifierTolerance = 5 * time.Minute
+
+// NonceStore records the requests seen by a SecretsVerifier to provide strict
+// replay protection. Seen must atomically report whether the nonce was
+// previously recorded and record it otherwise. The nonce only needs to be
+// retained until expires.
+type NonceStore interface {
+	Seen(nonce string, expires time.Time) (bool, error)
+}
+
+// NonceForgetter is implemented by NonceStores able to remove a recorded nonce, allowing
+// notifications which failed to send to be retried, see Notifier and Welcomer.
+type NonceForgetter interface {
+	Forget(nonce string) error
+}
+
+// forgetNonce removes the nonce from the store when the store supports it.
+func forgetNonce(store NonceStore, nonce string) error {
+	if f, ok := store.(NonceForgetter); ok {
+		return f.Forget(nonce)
+	}
+
+	return nil
+}
+
+// SecretsVerifierOption options for the SecretsVerifier.
+type SecretsVerifierOption func(*secretsVerifierConfig)
+
+// SecretsVerifierOptionTolerance set the maximum age of a request, defaults to DefaultSecretsVerifierTolerance.
+func SecretsVerifierOptionTolerance(d time.Duration) SecretsVerifierOption {
+	return func(c *secretsVerifierConfig) {
+		c.tolerance = d
+	}
+}
+
+// SecretsVerifierOptionNonceStore rejects any request that has already been verified.
+func SecretsVerifierOptionNonceStore(store NonceStore) SecretsVerifierOption {
+	return func(c *secretsVerifierConfig) {
+		c.nonces = store
+	}
+}
+
+type secretsVerifierConfig struct {
+	tolerance time.Duration
+	nonces    NonceStore
+}
+
 // SecretsVerifier contains the information needed to verify that the request comes from Slack
 type SecretsVerifier struct {
 	signature []byte
 	hmac      hash.Hash
+	nonce     string
+	expires   time.Time
+	nonces    NonceStore
 }
 
 func unsafeSignatureVerifier(header http.Header, secret string) (_ SecretsVerifier, err error) {
@@ -52,11 +104,19 @@ func unsafeSignatureVerifier(header http.Header, secret string) (_ SecretsVerifi
 }
 
 // NewSecretsVerifier returns a SecretsVerifier object in exchange for an http.Header object and signing secret
-func NewSecretsVerifier(header http.Header, secret string) (sv SecretsVerifier, err error) {
+func NewSecretsVerifier(header http.Header, secret string, options ...SecretsVerifierOption) (sv SecretsVerifier, err error) {
 	var (
 		timestamp int64
 	)
 
+	config := secretsVerifierConfig{
+		tolerance: DefaultSecretsVerifierTolerance,
+	}
+
+	for _, opt := range options {
+		opt(&config)
+	}
+
 	stimestamp := header.Get(hTimestamp)
 
 	if sv, err = unsafeSignatureVerifier(header, secret); err != nil {
@@ -68,10 +128,17 @@ func NewSecretsVerifier(header http.Header, secret string) (sv SecretsVerifier,
 	}
 
 	diff := absDuration(time.Since(time.Unix(timestamp, 0)))
-	if diff > 5*time.Minute {
+	if diff > config.tolerance {
 		return SecretsVerifier{}, ErrExpiredTimestamp
 	}
 
+	if config.nonces != nil {
+		// the signature is unique to the timestamp and body of the request.
+		sv.nonce = stimestamp + ":" + header.Get(hSignature)
+		sv.expires = time.Unix(timestamp, 0).Add(config.tolerance)
+		sv.nonces = config.nonces
+	}
+
 	return sv, err
 }
 
@@ -84,12 +151,73 @@ func (v SecretsVerifier) Ensure() error {
 	computed := v.hmac.Sum(nil)
 	// use hmac.Equal prevent leaking timing information.
 	if hmac.Equal(computed, v.signature) {
-		return nil
+		return v.ensureNonce()
 	}
 
 	return fmt.Errorf("Expected signing signature: %s, but computed: %s", hex.EncodeToString(v.signature), hex.EncodeToString(computed))
 }
 
+// ensureNonce only records the nonce once the signature is known to be valid,
+// preventing forged requests from filling the store.
+func (v SecretsVerifier) ensureNonce() error {
+	if v.nonces == nil {
+		return nil
+	}
+
+	seen, err := v.nonces.Seen(v.nonce, v.expires)
+	if err != nil {
+		return err
+	}
+
+	if seen {
+		return ErrReplayedRequest
+	}
+
+	return nil
+}
+
+// NewMemoryNonceStore in memory NonceStore, expired nonces are pruned as new nonces are recorded.
+func NewMemoryNonceStore() *MemoryNonceStore {
+	return &MemoryNonceStore{
+		nonces: make(map[string]time.Time),
+	}
+}
+
+// MemoryNonceStore in memory implementation of the NonceStore.
+type MemoryNonceStore struct {
+	m      sync.Mutex
+	nonces map[string]time.Time
+}
+
+// Seen implements the NonceStore interface.
+func (t *MemoryNonceStore) Seen(nonce string, expires time.Time) (bool, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	now := time.Now()
+	for k, exp := range t.nonces {
+		if now.After(exp) {
+			delete(t.nonces, k)
+		}
+	}
+
+	if _, ok := t.nonces[nonce]; ok {
+		return true, nil
+	}
+
+	t.nonces[nonce] = expires
+	return false, nil
+}
+
+// Forget implements the NonceForgetter interface.
+func (t *MemoryNonceStore) Forget(nonce string) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	delete(t.nonces, nonce)
+	return nil
+}
+
 func abs64(n int64) int64 {
 	y := n >> 63
 	return (n ^ y) - y
@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 	"testing"
+	"time"
 )
 
 const (
@@ -112,3 +113,42 @@ func TestEnsure(t *testing.T) {
 	}
 
 }
+
+func TestSecretsVerifierTolerance(t *testing.T) {
+	tolerance := time.Since(time.Unix(1531431954, 0)) + time.Hour
+	if _, err := NewSecretsVerifier(newHeader(true), validSigningSecret, SecretsVerifierOptionTolerance(tolerance)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestSecretsVerifierNonceStore(t *testing.T) {
+	tolerance := time.Since(time.Unix(1531431954, 0)) + time.Hour
+	store := NewMemoryNonceStore()
+
+	verify := func(body string) error {
+		sv, err := NewSecretsVerifier(
+			newHeader(true),
+			validSigningSecret,
+			SecretsVerifierOptionTolerance(tolerance),
+			SecretsVerifierOptionNonceStore(store),
+		)
+		if err != nil {
+			return err
+		}
+		io.WriteString(&sv, body)
+		return sv.Ensure()
+	}
+
+	// forged requests must not consume the nonce.
+	if err := verify(invalidBody); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+
+	if err := verify(validBody); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := verify(validBody); err != ErrReplayedRequest {
+		t.Fatalf("expected %s but got: %v", ErrReplayedRequest, err)
+	}
+}
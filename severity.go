@@ -0,0 +1,117 @@
+package slack
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Severity the importance of an alert.
+type Severity string
+
+// Severities in ascending order of importance.
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityError    Severity = "error"
+	SeverityCritical Severity = "critical"
+)
+
+// severities in ascending order, used by SeverityPalette.Gradient.
+var severities = []Severity{SeverityInfo, SeverityWarning, SeverityError, SeverityCritical}
+
+// SeverityPalette maps severities to hex colors, e.g. #D00000.
+type SeverityPalette map[Severity]string
+
+// DefaultSeverityPalette the colors used for severities missing from a palette.
+var DefaultSeverityPalette = SeverityPalette{
+	SeverityInfo:     "#439FE0",
+	SeverityWarning:  "#DAA038",
+	SeverityError:    "#D00000",
+	SeverityCritical: "#7A0019",
+}
+
+// Color returns the color of the severity, falling back to the DefaultSeverityPalette
+// when the severity is missing from the palette.
+func (t SeverityPalette) Color(s Severity) string {
+	if c, ok := t[s]; ok {
+		return c
+	}
+
+	return DefaultSeverityPalette[s]
+}
+
+// Gradient returns a color for a score between 0 and 1 by blending the colors of the
+// severities, 0 is SeverityInfo and 1 is SeverityCritical. Useful for continuous
+// measurements like error rates. scores outside of the range are clamped, NaN is treated as 0.
+func (t SeverityPalette) Gradient(score float64) string {
+	if score <= 0 || math.IsNaN(score) {
+		return t.Color(severities[0])
+	}
+
+	if score >= 1 {
+		return t.Color(severities[len(severities)-1])
+	}
+
+	scaled := score * float64(len(severities)-1)
+	idx := int(scaled)
+
+	return ColorGradient(t.Color(severities[idx]), t.Color(severities[idx+1]), scaled-float64(idx))
+}
+
+// Colorize returns copies of the attachments with the color of the severity, the provided
+// attachments are not modified.
+func (t SeverityPalette) Colorize(s Severity, attachments ...Attachment) []Attachment {
+	color := t.Color(s)
+	colored := make([]Attachment, len(attachments))
+	for i, a := range attachments {
+		a.Color = color
+		colored[i] = a
+	}
+
+	return colored
+}
+
+// ColorGradient blends two hex colors, ratio 0 returns from and 1 returns to.
+// returns from if either color is invalid.
+func ColorGradient(from, to string, ratio float64) string {
+	a, err := parseHexColor(from)
+	if err != nil {
+		return from
+	}
+
+	b, err := parseHexColor(to)
+	if err != nil {
+		return from
+	}
+
+	if ratio < 0 {
+		ratio = 0
+	} else if ratio > 1 {
+		ratio = 1
+	}
+
+	blend := func(x, y uint8) uint8 {
+		return uint8(float64(x) + (float64(y)-float64(x))*ratio + 0.5)
+	}
+
+	return fmt.Sprintf("#%02X%02X%02X", blend(a[0], b[0]), blend(a[1], b[1]), blend(a[2], b[2]))
+}
+
+func parseHexColor(s string) (rgb [3]uint8, err error) {
+	s = strings.TrimPrefix(s, "#")
+	if len(s) != 6 {
+		return rgb, fmt.Errorf("invalid hex color %q", s)
+	}
+
+	for i := range rgb {
+		v, err := strconv.ParseUint(s[i*2:i*2+2], 16, 8)
+		if err != nil {
+			return rgb, fmt.Errorf("invalid hex color %q: %v", s, err)
+		}
+		rgb[i] = uint8(v)
+	}
+
+	return rgb, nil
+}
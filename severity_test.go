@@ -0,0 +1,45 @@
+package slack
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverityPalette(t *testing.T) {
+	palette := SeverityPalette{SeverityError: "#FF0000"}
+
+	assert.Equal(t, "#FF0000", palette.Color(SeverityError))
+	assert.Equal(t, DefaultSeverityPalette[SeverityInfo], palette.Color(SeverityInfo))
+	assert.Equal(t, "", palette.Color(Severity("unknown")))
+
+	original := []Attachment{{Text: "disk full"}, {Text: "cpu"}}
+	attachments := palette.Colorize(SeverityError, original...)
+	assert.Equal(t, "#FF0000", attachments[0].Color)
+	assert.Equal(t, "#FF0000", attachments[1].Color)
+	assert.Equal(t, "", original[0].Color)
+}
+
+func TestSeverityGradient(t *testing.T) {
+	palette := SeverityPalette{
+		SeverityInfo:     "#000000",
+		SeverityWarning:  "#000000",
+		SeverityError:    "#000000",
+		SeverityCritical: "#FFFFFF",
+	}
+
+	assert.Equal(t, "#000000", palette.Gradient(-1))
+	assert.Equal(t, "#000000", palette.Gradient(0.5))
+	assert.Equal(t, "#808080", palette.Gradient(5.0/6.0))
+	assert.Equal(t, "#FFFFFF", palette.Gradient(2))
+	assert.Equal(t, "#000000", palette.Gradient(math.NaN()))
+	assert.Equal(t, "#FFFFFF", palette.Gradient(math.Inf(1)))
+}
+
+func TestColorGradient(t *testing.T) {
+	assert.Equal(t, "#000000", ColorGradient("#000000", "#FFFFFF", 0))
+	assert.Equal(t, "#FFFFFF", ColorGradient("000000", "#ffffff", 1))
+	assert.Equal(t, "#804000", ColorGradient("#000000", "#FF8000", 0.5))
+	assert.Equal(t, "good", ColorGradient("good", "#FFFFFF", 0.5))
+}
@@ -23,7 +23,9 @@ type httpClient interface {
 
 // ResponseMetadata holds pagination metadata
 type ResponseMetadata struct {
-	Cursor string `json:"next_cursor"`
+	Cursor   string   `json:"next_cursor"`
+	Messages []string `json:"messages,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 func (t *ResponseMetadata) initialize() *ResponseMetadata {
@@ -50,15 +52,32 @@ type authTestResponseFull struct {
 	AuthTestResponse
 }
 
-// Client for the slack api.
+// ParamOption sets optional parameters of an api method.
 type ParamOption func(*url.Values)
 
+// ParamOptionTeamID the workspace to operate on, required when using an org wide token.
+func ParamOptionTeamID(teamID string) ParamOption {
+	return func(values *url.Values) {
+		values.Set("team_id", teamID)
+	}
+}
+
+// Client for the slack api.
 type Client struct {
 	token      string
 	endpoint   string
 	debug      bool
 	log        ilogger
 	httpclient httpClient
+	readOnly   bool
+	audit      AuditFunc
+	headers    http.Header
+	dial       DialContextFunc
+	cache      *cacheClient
+	retries    int
+	tokenGuard bool
+	userInfo   *userInfoCache
+	channels   *ChannelResolver
 }
 
 // Option defines an option for a Client
@@ -90,6 +109,37 @@ func OptionAPIURL(u string) func(*Client) {
 	return func(c *Client) { c.endpoint = u }
 }
 
+// OptionReadOnly prevents the client from modifying slack, methods which are not known to
+// only read data return ErrReadOnly instead. Useful for staging environments and dry runs.
+func OptionReadOnly() func(*Client) {
+	return func(c *Client) { c.readOnly = true }
+}
+
+// OptionAudit invokes the provided function with the final payload of every message
+// posted, updated, or deleted by the client. see AuditFunc.
+func OptionAudit(fn AuditFunc) func(*Client) {
+	return func(c *Client) { c.audit = fn }
+}
+
+// OptionHeaders attaches the headers to every request made by the client, including
+// file uploads and the websocket upgrade request of the RTM connection. Useful for
+// egress proxies requiring authentication and tracing headers.
+func OptionHeaders(headers http.Header) func(*Client) {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = http.Header{}
+		}
+		applyHeaders(c.headers, headers)
+	}
+}
+
+// OptionUserAgent identifies the application in the User-Agent of every request made by the client,
+// including the websocket upgrade request of the RTM connection. LibraryUserAgent is appended to the
+// provided user agent, e.g. "my-app/1.2 nlopes-slack/0.6.0".
+func OptionUserAgent(ua string) func(*Client) {
+	return OptionHeaders(http.Header{"User-Agent": {ua + " " + LibraryUserAgent}})
+}
+
 // New builds a slack client from the provided token and options.
 func New(token string, options ...Option) *Client {
 	s := &Client{
@@ -97,12 +147,43 @@ func New(token string, options ...Option) *Client {
 		endpoint:   APIURL,
 		httpclient: &http.Client{},
 		log:        log.New(os.Stderr, "nlopes/slack", log.LstdFlags|log.Lshortfile),
+		tokenGuard: true,
 	}
 
 	for _, opt := range options {
 		opt(s)
 	}
 
+	if s.dial != nil {
+		var ok bool
+		if s.httpclient, ok = dialHTTPClient(s.httpclient, s.dial); !ok {
+			s.Debugln("custom dialer ignored, the http client is not configurable")
+		}
+	}
+
+	if len(s.headers) > 0 {
+		s.httpclient = headerClient{httpClient: s.httpclient, headers: s.headers}
+	}
+
+	if s.retries > 0 {
+		s.httpclient = retryClient{httpClient: s.httpclient, retries: s.retries, d: s}
+	}
+
+	if s.cache != nil {
+		s.cache.httpClient = s.httpclient
+		s.httpclient = s.cache
+	}
+
+	if s.readOnly {
+		s.httpclient = readOnlyClient{httpClient: s.httpclient}
+	}
+
+	if s.tokenGuard {
+		s.httpclient = tokenGuardClient{httpClient: s.httpclient, endpoint: s.endpoint, token: DetectTokenType(s.token)}
+	}
+
+	s.channels = NewChannelResolver(s, ChannelResolverOptionTypes(ConversationTypePublicChannel))
+
 	return s
 }
 
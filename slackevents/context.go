@@ -0,0 +1,87 @@
+package slackevents
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type contextKey int
+
+const (
+	envelopeKey contextKey = iota
+)
+
+// Envelope metadata describing the delivery of an event.
+type Envelope struct {
+	TeamID       string
+	EnterpriseID string
+	APIAppID     string
+	EventID      string
+	EventTime    time.Time
+	// RetryNum the number of times slack has retried delivering the event, 0 for the first attempt.
+	RetryNum int
+	// RetryReason why slack retried the delivery, e.g. http_timeout.
+	RetryReason string
+	// ReceivedAt when the event was received.
+	ReceivedAt time.Time
+}
+
+// IsRetry reports whether the event is a redelivery of an event.
+func (t Envelope) IsRetry() bool {
+	return t.RetryNum > 0
+}
+
+// NewEnvelope builds the envelope of the event from the event and the headers of the
+// http request which delivered it.
+func NewEnvelope(event EventsAPIEvent, header http.Header, received time.Time) Envelope {
+	e := Envelope{
+		TeamID:      event.TeamID,
+		RetryReason: header.Get("X-Slack-Retry-Reason"),
+		ReceivedAt:  received,
+	}
+
+	e.RetryNum, _ = strconv.Atoi(header.Get("X-Slack-Retry-Num"))
+
+	if cb, ok := event.Data.(*EventsAPICallbackEvent); ok {
+		e.EnterpriseID = cb.EnterpriseID
+		e.APIAppID = cb.APIAppID
+		e.EventID = cb.EventID
+		if cb.EventTime > 0 {
+			e.EventTime = time.Unix(int64(cb.EventTime), 0)
+		}
+	}
+
+	return e
+}
+
+// WithEnvelope returns a copy of the context carrying the envelope.
+func WithEnvelope(ctx context.Context, e Envelope) context.Context {
+	return context.WithValue(ctx, envelopeKey, e)
+}
+
+// EnvelopeFromContext returns the envelope of the event being handled, the boolean reports
+// whether the context carries an envelope.
+func EnvelopeFromContext(ctx context.Context) (Envelope, bool) {
+	e, ok := ctx.Value(envelopeKey).(Envelope)
+	return e, ok
+}
+
+// TeamIDFromContext returns the team of the event being handled, empty if unknown.
+func TeamIDFromContext(ctx context.Context) string {
+	e, _ := EnvelopeFromContext(ctx)
+	return e.TeamID
+}
+
+// EnterpriseIDFromContext returns the enterprise of the event being handled, empty if unknown.
+func EnterpriseIDFromContext(ctx context.Context) string {
+	e, _ := EnvelopeFromContext(ctx)
+	return e.EnterpriseID
+}
+
+// EventIDFromContext returns the id of the event being handled, empty if unknown.
+func EventIDFromContext(ctx context.Context) string {
+	e, _ := EnvelopeFromContext(ctx)
+	return e.EventID
+}
@@ -0,0 +1,52 @@
+package slackevents
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// HandlerFunc handles a parsed events api event. the context carries the Envelope of the
+// event, see EnvelopeFromContext.
+type HandlerFunc func(ctx context.Context, event EventsAPIEvent) error
+
+// NewHandler builds an http.Handler receiving events api requests. url_verification challenges
+// are answered automatically, all other events are passed to fn with the Envelope attached to
+// the context of the request. requests which fail to parse are rejected with a 400 and errors
+// returned by fn result in a 500, prompting slack to retry the delivery.
+//
+// The options are passed to ParseEvent, requests should be authenticated before reaching the
+// handler, e.g. with slack.SecretsVerifier.
+func NewHandler(fn HandlerFunc, opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received := time.Now()
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		event, err := ParseEvent(json.RawMessage(body), opts...)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if challenge, ok := event.Data.(*EventsAPIURLVerificationEvent); ok {
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte(challenge.Challenge))
+			return
+		}
+
+		ctx := WithEnvelope(r.Context(), NewEnvelope(event, r.Header, received))
+		if err = fn(ctx, event); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,89 @@
+package slackevents
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const handlerCallbackEvent = `{
+	"token": "XXYYZZ",
+	"team_id": "T1",
+	"enterprise_id": "E1",
+	"api_app_id": "A1",
+	"event": {"type": "app_mention", "user": "U1", "text": "hi", "ts": "1.2", "channel": "C1", "event_ts": "1.2"},
+	"type": "event_callback",
+	"event_id": "Ev1",
+	"event_time": 1234567890
+}`
+
+func TestHandlerEnvelope(t *testing.T) {
+	var (
+		envelope Envelope
+		ok       bool
+		teamID   string
+	)
+
+	h := NewHandler(func(ctx context.Context, event EventsAPIEvent) error {
+		envelope, ok = EnvelopeFromContext(ctx)
+		teamID = TeamIDFromContext(ctx)
+		if _, isMention := event.InnerEvent.Data.(*AppMentionEvent); !isMention {
+			t.Errorf("unexpected inner event %T", event.InnerEvent.Data)
+		}
+		return nil
+	}, OptionVerifyToken(&TokenComparator{"XXYYZZ"}))
+
+	req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(handlerCallbackEvent))
+	req.Header.Set("X-Slack-Retry-Num", "2")
+	req.Header.Set("X-Slack-Retry-Reason", "http_timeout")
+	resp := httptest.NewRecorder()
+	h.ServeHTTP(resp, req)
+
+	if resp.Code != http.StatusOK || !ok {
+		t.Fatalf("unexpected response %d %v", resp.Code, ok)
+	}
+
+	if teamID != "T1" || envelope.EnterpriseID != "E1" || envelope.APIAppID != "A1" || envelope.EventID != "Ev1" {
+		t.Errorf("unexpected envelope %#v", envelope)
+	}
+
+	if !envelope.IsRetry() || envelope.RetryNum != 2 || envelope.RetryReason != "http_timeout" {
+		t.Errorf("unexpected retry %#v", envelope)
+	}
+
+	if !envelope.EventTime.Equal(time.Unix(1234567890, 0)) || envelope.ReceivedAt.IsZero() {
+		t.Errorf("unexpected times %#v", envelope)
+	}
+
+	if EventIDFromContext(context.Background()) != "" {
+		t.Error("expected an empty event id without an envelope")
+	}
+}
+
+func TestHandlerResponses(t *testing.T) {
+	failing := NewHandler(func(ctx context.Context, event EventsAPIEvent) error {
+		return errors.New("boom")
+	}, OptionNoVerifyToken())
+
+	resp := httptest.NewRecorder()
+	failing.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(handlerCallbackEvent)))
+	if resp.Code != http.StatusInternalServerError {
+		t.Errorf("expected a 500, got %d", resp.Code)
+	}
+
+	resp = httptest.NewRecorder()
+	failing.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(`{"token":"XXYYZZ","challenge":"abc","type":"url_verification"}`)))
+	if resp.Code != http.StatusOK || resp.Body.String() != "abc" {
+		t.Errorf("unexpected challenge response %d %q", resp.Code, resp.Body.String())
+	}
+
+	resp = httptest.NewRecorder()
+	failing.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(`{`)))
+	if resp.Code != http.StatusBadRequest {
+		t.Errorf("expected a 400, got %d", resp.Code)
+	}
+}
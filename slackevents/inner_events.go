@@ -2,7 +2,12 @@
 
 package slackevents
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nlopes/slack"
+)
 
 // EventsAPIInnerEvent the inner event of a EventsAPI event_callback Event.
 type EventsAPIInnerEvent struct {
@@ -94,6 +99,22 @@ type MessageEvent struct {
 
 	Upload bool   `json:"upload"`
 	Files  []File `json:"files"`
+
+	// channel_topic, group_topic
+	Topic string `json:"topic,omitempty"`
+
+	// channel_purpose, group_purpose
+	Purpose string `json:"purpose,omitempty"`
+}
+
+// IsTopicChange reports whether the message describes a change to the topic of the conversation.
+func (t MessageEvent) IsTopicChange() bool {
+	return t.SubType == "channel_topic" || t.SubType == "group_topic"
+}
+
+// IsPurposeChange reports whether the message describes a change to the purpose of the conversation.
+func (t MessageEvent) IsPurposeChange() bool {
+	return t.SubType == "channel_purpose" || t.SubType == "group_purpose"
 }
 
 // MemberJoinedChannelEvent A member join a channel
@@ -132,6 +153,128 @@ type TokensRevokedEvent struct {
 	Tokens tokens `json:"tokens"`
 }
 
+// FileCreatedEvent A file was created - https://api.slack.com/events/file_created
+type FileCreatedEvent struct {
+	Type           string `json:"type"`
+	FileID         string `json:"file_id"`
+	UserID         string `json:"user_id"`
+	EventTimeStamp string `json:"event_ts"`
+}
+
+// Fetch resolves the full file using files.info.
+func (e FileCreatedEvent) Fetch(ctx context.Context, api *slack.Client) (*slack.File, error) {
+	return fetchFile(ctx, api, e.FileID)
+}
+
+// FileSharedEvent A file was shared - https://api.slack.com/events/file_shared
+type FileSharedEvent struct {
+	Type           string `json:"type"`
+	FileID         string `json:"file_id"`
+	UserID         string `json:"user_id"`
+	ChannelID      string `json:"channel_id"`
+	EventTimeStamp string `json:"event_ts"`
+}
+
+// Fetch resolves the full file using files.info.
+func (e FileSharedEvent) Fetch(ctx context.Context, api *slack.Client) (*slack.File, error) {
+	return fetchFile(ctx, api, e.FileID)
+}
+
+// FileDeletedEvent A file was deleted - https://api.slack.com/events/file_deleted
+// the file no longer exists so it cannot be fetched.
+type FileDeletedEvent struct {
+	Type           string `json:"type"`
+	FileID         string `json:"file_id"`
+	EventTimeStamp string `json:"event_ts"`
+}
+
+// DNDUpdatedEvent Do not Disturb settings changed for the current user - https://api.slack.com/events/dnd_updated
+type DNDUpdatedEvent struct {
+	Type           string          `json:"type"`
+	User           string          `json:"user"`
+	Status         slack.DNDStatus `json:"dnd_status"`
+	EventTimeStamp string          `json:"event_ts"`
+}
+
+// DNDUpdatedUserEvent Do not Disturb settings changed for a member - https://api.slack.com/events/dnd_updated_user
+type DNDUpdatedUserEvent struct {
+	Type           string          `json:"type"`
+	User           string          `json:"user"`
+	Status         slack.DNDStatus `json:"dnd_status"`
+	EventTimeStamp string          `json:"event_ts"`
+}
+
+// SubteamCreatedEvent A User Group has been added to the workspace - https://api.slack.com/events/subteam_created
+type SubteamCreatedEvent struct {
+	Type           string          `json:"type"`
+	Subteam        slack.UserGroup `json:"subteam"`
+	EventTimeStamp string          `json:"event_ts"`
+}
+
+// SubteamUpdatedEvent An existing User Group has been updated or its members changed - https://api.slack.com/events/subteam_updated
+type SubteamUpdatedEvent struct {
+	Type           string          `json:"type"`
+	Subteam        slack.UserGroup `json:"subteam"`
+	EventTimeStamp string          `json:"event_ts"`
+}
+
+// SubteamMembersChangedEvent The membership of an existing User Group has changed - https://api.slack.com/events/subteam_members_changed
+// only the users added and removed since the previous update are included, allowing the
+// membership to be maintained incrementally.
+type SubteamMembersChangedEvent struct {
+	Type               string         `json:"type"`
+	SubteamID          string         `json:"subteam_id"`
+	TeamID             string         `json:"team_id"`
+	DatePreviousUpdate slack.JSONTime `json:"date_previous_update"`
+	DateUpdate         slack.JSONTime `json:"date_update"`
+	AddedUsers         []string       `json:"added_users"`
+	AddedUsersCount    json.Number    `json:"added_users_count"`
+	RemovedUsers       []string       `json:"removed_users"`
+	RemovedUsersCount  json.Number    `json:"removed_users_count"`
+	EventTimeStamp     string         `json:"event_ts"`
+}
+
+// Apply the membership change to the provided list of user IDs, returns the updated membership.
+func (e SubteamMembersChangedEvent) Apply(users []string) []string {
+	removed := make(map[string]bool, len(e.RemovedUsers)+len(e.AddedUsers))
+	for _, id := range e.RemovedUsers {
+		removed[id] = true
+	}
+
+	// added users are removed first to prevent duplicates.
+	for _, id := range e.AddedUsers {
+		removed[id] = true
+	}
+
+	updated := make([]string, 0, len(users)+len(e.AddedUsers))
+	for _, id := range users {
+		if !removed[id] {
+			updated = append(updated, id)
+		}
+	}
+
+	return append(updated, e.AddedUsers...)
+}
+
+// SubteamSelfAddedEvent You have been added to a User Group - https://api.slack.com/events/subteam_self_added
+type SubteamSelfAddedEvent struct {
+	Type           string `json:"type"`
+	SubteamID      string `json:"subteam_id"`
+	EventTimeStamp string `json:"event_ts"`
+}
+
+// SubteamSelfRemovedEvent You have been removed from a User Group - https://api.slack.com/events/subteam_self_removed
+type SubteamSelfRemovedEvent struct {
+	Type           string `json:"type"`
+	SubteamID      string `json:"subteam_id"`
+	EventTimeStamp string `json:"event_ts"`
+}
+
+func fetchFile(ctx context.Context, api *slack.Client, fileID string) (*slack.File, error) {
+	file, _, _, err := api.GetFileInfoContext(ctx, fileID, 100, 1)
+	return file, err
+}
+
 // JSONTime exists so that we can have a String method converting the date
 type JSONTime int64
 
@@ -240,6 +383,16 @@ const (
 	AppHomeOpened = "app_home_opened"
 	// AppUninstalled Your Slack app was uninstalled.
 	AppUninstalled = "app_uninstalled"
+	// DNDUpdated Do not Disturb settings changed for the current user
+	DNDUpdated = "dnd_updated"
+	// DNDUpdatedUser Do not Disturb settings changed for a member
+	DNDUpdatedUser = "dnd_updated_user"
+	// FileCreated A file was created
+	FileCreated = "file_created"
+	// FileShared A file was shared
+	FileShared = "file_shared"
+	// FileDeleted A file was deleted
+	FileDeleted = "file_deleted"
 	// GridMigrationFinished An enterprise grid migration has finished on this workspace.
 	GridMigrationFinished = "grid_migration_finished"
 	// GridMigrationStarted An enterprise grid migration has started on this workspace.
@@ -254,6 +407,16 @@ const (
 	PinAdded = "pin_added"
 	// PinRemoved An item was unpinned from a channel
 	PinRemoved = "pin_removed"
+	// SubteamCreated A User Group has been added to the workspace
+	SubteamCreated = "subteam_created"
+	// SubteamUpdated An existing User Group has been updated or its members changed
+	SubteamUpdated = "subteam_updated"
+	// SubteamMembersChanged The membership of an existing User Group has changed
+	SubteamMembersChanged = "subteam_members_changed"
+	// SubteamSelfAdded You have been added to a User Group
+	SubteamSelfAdded = "subteam_self_added"
+	// SubteamSelfRemoved You have been removed from a User Group
+	SubteamSelfRemoved = "subteam_self_removed"
 	// TokensRevoked APP's API tokes are revoked
 	TokensRevoked = "tokens_revoked"
 )
@@ -265,6 +428,11 @@ var EventsAPIInnerEventMapping = map[string]interface{}{
 	AppMention:            AppMentionEvent{},
 	AppHomeOpened:         AppHomeOpenedEvent{},
 	AppUninstalled:        AppUninstalledEvent{},
+	DNDUpdated:            DNDUpdatedEvent{},
+	DNDUpdatedUser:        DNDUpdatedUserEvent{},
+	FileCreated:           FileCreatedEvent{},
+	FileShared:            FileSharedEvent{},
+	FileDeleted:           FileDeletedEvent{},
 	GridMigrationFinished: GridMigrationFinishedEvent{},
 	GridMigrationStarted:  GridMigrationStartedEvent{},
 	LinkShared:            LinkSharedEvent{},
@@ -272,5 +440,10 @@ var EventsAPIInnerEventMapping = map[string]interface{}{
 	MemberJoinedChannel:   MemberJoinedChannelEvent{},
 	PinAdded:              PinAddedEvent{},
 	PinRemoved:            PinRemovedEvent{},
+	SubteamCreated:        SubteamCreatedEvent{},
+	SubteamUpdated:        SubteamUpdatedEvent{},
+	SubteamMembersChanged: SubteamMembersChangedEvent{},
+	SubteamSelfAdded:      SubteamSelfAddedEvent{},
+	SubteamSelfRemoved:    SubteamSelfRemovedEvent{},
 	TokensRevoked:         TokensRevokedEvent{},
 }
@@ -1,8 +1,14 @@
 package slackevents
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
+
+	"github.com/nlopes/slack"
 )
 
 func TestAppMention(t *testing.T) {
@@ -127,6 +133,30 @@ func TestMessageEvent(t *testing.T) {
 	}
 }
 
+func TestTopicMessageEvent(t *testing.T) {
+	rawE := []byte(`
+			{
+				"type": "message",
+				"subtype": "channel_topic",
+				"channel": "C024BE91L",
+				"user": "U2147483697",
+				"text": "<@U2147483697> set the channel topic: launch plans",
+				"topic": "launch plans",
+				"ts": "1355517523.000005"
+			}
+	`)
+	e := MessageEvent{}
+	if err := json.Unmarshal(rawE, &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.Topic != "launch plans" {
+		t.Errorf("expected topic to be decoded, got %q", e.Topic)
+	}
+	if !e.IsTopicChange() || e.IsPurposeChange() {
+		t.Error("expected a topic change")
+	}
+}
+
 func TestBotMessageEvent(t *testing.T) {
 	rawE := []byte(`
 			{
@@ -233,3 +263,140 @@ func TestTokensRevoked(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestFileSharedEvent(t *testing.T) {
+	rawE := []byte(`
+			{
+				"type": "file_shared",
+				"channel_id": "C1234567",
+				"file_id": "F2147483862",
+				"user_id": "U2147483697",
+				"file": {
+					"id": "F2147483862"
+				},
+				"event_ts": "1361482916.000004"
+			}
+	`)
+	e := FileSharedEvent{}
+	if err := json.Unmarshal(rawE, &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.FileID != "F2147483862" || e.ChannelID != "C1234567" || e.UserID != "U2147483697" {
+		t.Fatalf("unexpected event %#v", e)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/files.info" || r.FormValue("file") != "F2147483862" {
+			t.Errorf("unexpected request %s %s", r.URL.Path, r.FormValue("file"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"file":{"id":"F2147483862","name":"secrets.txt"}}`))
+	}))
+	defer server.Close()
+
+	api := slack.New("testing-token", slack.OptionAPIURL(server.URL+"/"))
+	file, err := e.Fetch(context.Background(), api)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Name != "secrets.txt" {
+		t.Errorf("expected file to be fetched, got %#v", file)
+	}
+}
+
+func TestFileDeletedEvent(t *testing.T) {
+	rawE := []byte(`{"type": "file_deleted", "file_id": "F2147483862", "event_ts": "1361482916.000004"}`)
+	e := FileDeletedEvent{}
+	if err := json.Unmarshal(rawE, &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.FileID != "F2147483862" {
+		t.Errorf("unexpected event %#v", e)
+	}
+}
+
+func TestSubteamMembersChangedEvent(t *testing.T) {
+	rawE := []byte(`
+			{
+				"type": "subteam_members_changed",
+				"subteam_id": "S0614TZR7",
+				"team_id": "T060RNRCH",
+				"date_previous_update": 1446670362,
+				"date_update": 1492906952,
+				"added_users": ["U060RNRCZ", "U060ULRC0"],
+				"added_users_count": "2",
+				"removed_users": ["U06129G2V"],
+				"removed_users_count": "1"
+			}
+	`)
+	e := SubteamMembersChangedEvent{}
+	if err := json.Unmarshal(rawE, &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.SubteamID != "S0614TZR7" || e.AddedUsersCount != "2" || e.RemovedUsersCount != "1" {
+		t.Errorf("unexpected event %#v", e)
+	}
+
+	members := e.Apply([]string{"U06129G2V", "U060ULRC0", "U0614TZR7"})
+	expected := []string{"U0614TZR7", "U060RNRCZ", "U060ULRC0"}
+	if !reflect.DeepEqual(members, expected) {
+		t.Errorf("expected %v, got %v", expected, members)
+	}
+}
+
+func TestSubteamCreatedEvent(t *testing.T) {
+	rawE := []byte(`
+			{
+				"token": "XXYYZZ",
+				"team_id": "T060RNRCH",
+				"api_app_id": "AXXXXXXXXX",
+				"event": {
+					"type": "subteam_created",
+					"subteam": {
+						"id": "S0615G0KT",
+						"team_id": "T060RNRCH",
+						"is_usergroup": true,
+						"name": "Marketing Team",
+						"handle": "marketing-team",
+						"user_count": 0
+					}
+				},
+				"type": "event_callback",
+				"event_id": "Ev08MFMKH6",
+				"event_time": 1234567890
+			}
+	`)
+	msg, err := ParseEvent(json.RawMessage(rawE), OptionNoVerifyToken())
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, ok := msg.InnerEvent.Data.(*SubteamCreatedEvent)
+	if !ok {
+		t.Fatalf("unexpected event type %T", msg.InnerEvent.Data)
+	}
+	if created.Subteam.ID != "S0615G0KT" || created.Subteam.Handle != "marketing-team" {
+		t.Errorf("unexpected event %#v", created)
+	}
+}
+
+func TestDNDUpdatedUserEvent(t *testing.T) {
+	rawE := []byte(`
+			{
+				"type": "dnd_updated_user",
+				"user": "U1234",
+				"dnd_status": {
+					"dnd_enabled": true,
+					"next_dnd_start_ts": 1450387800,
+					"next_dnd_end_ts": 1450423800
+				},
+				"event_ts": "1450387800.000100"
+			}
+	`)
+	e := DNDUpdatedUserEvent{}
+	if err := json.Unmarshal(rawE, &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.User != "U1234" || !e.Status.Enabled || e.Status.NextEndTimestamp != 1450423800 {
+		t.Errorf("unexpected event %#v", e)
+	}
+}
@@ -29,15 +29,16 @@ type ChallengeResponse struct {
 
 // EventsAPICallbackEvent is the main (outer) EventsAPI event.
 type EventsAPICallbackEvent struct {
-	Type        string           `json:"type"`
-	Token       string           `json:"token"`
-	TeamID      string           `json:"team_id"`
-	APIAppID    string           `json:"api_app_id"`
-	InnerEvent  *json.RawMessage `json:"event"`
-	AuthedUsers []string         `json:"authed_users"`
-	AuthedTeams []string         `json:"authed_teams"`
-	EventID     string           `json:"event_id"`
-	EventTime   int              `json:"event_time"`
+	Type         string           `json:"type"`
+	Token        string           `json:"token"`
+	TeamID       string           `json:"team_id"`
+	EnterpriseID string           `json:"enterprise_id"`
+	APIAppID     string           `json:"api_app_id"`
+	InnerEvent   *json.RawMessage `json:"event"`
+	AuthedUsers  []string         `json:"authed_users"`
+	AuthedTeams  []string         `json:"authed_teams"`
+	EventID      string           `json:"event_id"`
+	EventTime    int              `json:"event_time"`
 }
 
 // EventsAPIAppRateLimited indicates your app's event subscriptions are being rate limited
@@ -0,0 +1,93 @@
+package slackevents
+
+import (
+	"context"
+	"sync"
+)
+
+// TeamRouter dispatches events to the handler registered for the workspace or enterprise
+// the event was delivered for, allowing a single process to serve multiple workspaces with
+// distinct behavior, e.g. a slack.Client per installation. Handlers registered for a team
+// take precedence over handlers registered for its enterprise, events without a matching
+// handler are passed to the fallback.
+//
+// The Handle method is a HandlerFunc and can be passed to NewHandler. It is safe to register
+// and remove handlers while events are being dispatched.
+type TeamRouter struct {
+	m           sync.RWMutex
+	teams       map[string]HandlerFunc
+	enterprises map[string]HandlerFunc
+	fallback    HandlerFunc
+}
+
+// NewTeamRouter builds a router, the fallback handles events of unregistered workspaces and
+// may be nil in which case those events are acknowledged and dropped.
+func NewTeamRouter(fallback HandlerFunc) *TeamRouter {
+	return &TeamRouter{
+		teams:       make(map[string]HandlerFunc),
+		enterprises: make(map[string]HandlerFunc),
+		fallback:    fallback,
+	}
+}
+
+// Team registers the handler for events of the workspace, replacing any existing handler.
+func (t *TeamRouter) Team(teamID string, fn HandlerFunc) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.teams[teamID] = fn
+}
+
+// Enterprise registers the handler for events of the enterprise grid organization, replacing
+// any existing handler.
+func (t *TeamRouter) Enterprise(enterpriseID string, fn HandlerFunc) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.enterprises[enterpriseID] = fn
+}
+
+// RemoveTeam removes the handler of the workspace, e.g. once the app is uninstalled.
+func (t *TeamRouter) RemoveTeam(teamID string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	delete(t.teams, teamID)
+}
+
+// RemoveEnterprise removes the handler of the enterprise grid organization.
+func (t *TeamRouter) RemoveEnterprise(enterpriseID string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	delete(t.enterprises, enterpriseID)
+}
+
+// Route returns the handler for the team and enterprise, falling back to the fallback handler.
+// the returned handler is nil when nothing matches and there is no fallback.
+func (t *TeamRouter) Route(teamID, enterpriseID string) HandlerFunc {
+	t.m.RLock()
+	defer t.m.RUnlock()
+
+	if fn, ok := t.teams[teamID]; ok && teamID != "" {
+		return fn
+	}
+
+	if fn, ok := t.enterprises[enterpriseID]; ok && enterpriseID != "" {
+		return fn
+	}
+
+	return t.fallback
+}
+
+// Handle dispatches the event to the handler of its workspace. the team and enterprise are
+// read from the Envelope in the context, falling back to the team of the event.
+func (t *TeamRouter) Handle(ctx context.Context, event EventsAPIEvent) error {
+	teamID, enterpriseID := event.TeamID, ""
+	if e, ok := EnvelopeFromContext(ctx); ok {
+		teamID, enterpriseID = e.TeamID, e.EnterpriseID
+	}
+
+	fn := t.Route(teamID, enterpriseID)
+	if fn == nil {
+		return nil
+	}
+
+	return fn(ctx, event)
+}
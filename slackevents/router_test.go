@@ -0,0 +1,53 @@
+package slackevents
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTeamRouter(t *testing.T) {
+	var routed []string
+
+	record := func(name string) HandlerFunc {
+		return func(ctx context.Context, event EventsAPIEvent) error {
+			routed = append(routed, name)
+			return nil
+		}
+	}
+
+	router := NewTeamRouter(record("fallback"))
+	router.Team("T1", record("team"))
+	router.Enterprise("E1", record("enterprise"))
+
+	h := NewHandler(router.Handle, OptionNoVerifyToken())
+	deliver := func(body string) {
+		req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(body))
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, req)
+		if resp.Code != http.StatusOK {
+			t.Fatalf("unexpected response %d", resp.Code)
+		}
+	}
+
+	deliver(handlerCallbackEvent)
+	deliver(strings.Replace(handlerCallbackEvent, `"T1"`, `"T2"`, 1))
+	deliver(strings.Replace(strings.Replace(handlerCallbackEvent, `"T1"`, `"T2"`, 1), `"E1"`, `"E2"`, 1))
+
+	router.RemoveTeam("T1")
+	deliver(handlerCallbackEvent)
+
+	expected := []string{"team", "enterprise", "fallback", "enterprise"}
+	if strings.Join(routed, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected %v, got %v", expected, routed)
+	}
+}
+
+func TestTeamRouterWithoutFallback(t *testing.T) {
+	router := NewTeamRouter(nil)
+	if err := router.Handle(context.Background(), EventsAPIEvent{TeamID: "T1"}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
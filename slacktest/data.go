@@ -48,6 +48,13 @@ var defaultGroupsListJSON = fmt.Sprintf(`
 		}
 		`, defaultGroupJSON)
 
+var defaultPrivateConversationsListJSON = fmt.Sprintf(`
+	{
+		"ok": true,
+		"channels": [%s]
+	}
+	`, defaultGroupJSON)
+
 var defaultAuthTestJSON = fmt.Sprintf(`
 	{
 		"ok": true,
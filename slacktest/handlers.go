@@ -49,6 +49,16 @@ func listGroupsHandler(w http.ResponseWriter, _ *http.Request) {
 	_, _ = w.Write([]byte(defaultGroupsListJSON))
 }
 
+// handle conversations.list, the default channels are public and the default groups are private.
+func listConversationsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("types") == slack.ConversationTypePrivateChannel {
+		_, _ = w.Write([]byte(defaultPrivateConversationsListJSON))
+		return
+	}
+
+	_, _ = w.Write([]byte(defaultChannelsListJSON))
+}
+
 // handle chat.postMessage
 func (sts *Server) postMessageHandler(w http.ResponseWriter, r *http.Request) {
 	serverAddr := r.Context().Value(ServerBotHubNameContextKey).(string)
@@ -51,6 +51,7 @@ func NewTestServer(custom ...binder) *Server {
 	s.Handle("/chat.postMessage", s.postMessageHandler)
 	s.Handle("/channels.list", listChannelsHandler)
 	s.Handle("/groups.list", listGroupsHandler)
+	s.Handle("/conversations.list", listConversationsHandler)
 	s.Handle("/users.info", usersInfoHandler)
 	s.Handle("/bots.info", botsInfoHandler)
 	s.Handle("/auth.test", authTestHandler)
@@ -0,0 +1,345 @@
+package slack
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// SlashParseError the text of a slash command did not match the arguments of the parser,
+// Usage is suitable for an ephemeral reply to the user.
+type SlashParseError struct {
+	Reason string
+	Usage  string
+}
+
+func (t SlashParseError) Error() string {
+	return t.Reason
+}
+
+type slashFlag struct {
+	name  string
+	def   string
+	usage string
+}
+
+type slashArg struct {
+	name     string
+	usage    string
+	optional bool
+}
+
+// SlashParser parses the text of a slash command into positional arguments and key=value
+// flags. Arguments are separated by whitespace, double quotes (including the curly quotes
+// inserted by slack clients) group text containing whitespace. Flags may be prefixed with --,
+// e.g. `/deploy api env=staging --note="hot fix"`.
+type SlashParser struct {
+	command     string
+	description string
+	flags       []slashFlag
+	args        []slashArg
+	variadic    *slashArg
+}
+
+// NewSlashParser builds a parser for the command, the description is included in the usage.
+func NewSlashParser(command, description string) *SlashParser {
+	return &SlashParser{command: command, description: description}
+}
+
+// Flag declares a key=value flag with a default value.
+func (t *SlashParser) Flag(name, def, usage string) *SlashParser {
+	t.flags = append(t.flags, slashFlag{name: name, def: def, usage: usage})
+	return t
+}
+
+// Arg declares a required positional argument.
+func (t *SlashParser) Arg(name, usage string) *SlashParser {
+	t.args = append(t.args, slashArg{name: name, usage: usage})
+	return t
+}
+
+// OptionalArg declares an optional positional argument, it must follow the required arguments.
+func (t *SlashParser) OptionalArg(name, usage string) *SlashParser {
+	t.args = append(t.args, slashArg{name: name, usage: usage, optional: true})
+	return t
+}
+
+// Rest accepts any number of trailing positional arguments.
+func (t *SlashParser) Rest(name, usage string) *SlashParser {
+	t.variadic = &slashArg{name: name, usage: usage, optional: true}
+	return t
+}
+
+// Usage returns the help text of the command, formatted for a slack message.
+func (t *SlashParser) Usage() string {
+	synopsis := []string{t.command}
+	for _, a := range t.args {
+		if a.optional {
+			synopsis = append(synopsis, "["+a.name+"]")
+		} else {
+			synopsis = append(synopsis, "<"+a.name+">")
+		}
+	}
+
+	if t.variadic != nil {
+		synopsis = append(synopsis, "["+t.variadic.name+"...]")
+	}
+
+	if len(t.flags) > 0 {
+		synopsis = append(synopsis, "[key=value...]")
+	}
+
+	lines := []string{}
+	if t.description != "" {
+		lines = append(lines, t.description)
+	}
+	lines = append(lines, "Usage: `"+strings.Join(synopsis, " ")+"`")
+
+	args := t.args
+	if t.variadic != nil {
+		args = append(args[:len(args):len(args)], *t.variadic)
+	}
+
+	if len(args) > 0 {
+		lines = append(lines, "Arguments:")
+		for _, a := range args {
+			lines = append(lines, fmt.Sprintf("• `%s` %s", a.name, a.usage))
+		}
+	}
+
+	if len(t.flags) > 0 {
+		lines = append(lines, "Flags:")
+		for _, f := range t.flags {
+			line := fmt.Sprintf("• `%s=` %s", f.name, f.usage)
+			if f.def != "" {
+				line += fmt.Sprintf(" (default %q)", f.def)
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (t *SlashParser) failure(format string, args ...interface{}) SlashParseError {
+	return SlashParseError{Reason: fmt.Sprintf(format, args...), Usage: t.Usage()}
+}
+
+func (t *SlashParser) flag(name string) (slashFlag, bool) {
+	for _, f := range t.flags {
+		if f.name == name {
+			return f, true
+		}
+	}
+
+	return slashFlag{}, false
+}
+
+// Parse the text of the slash command. The text `help` (or --help) results in a
+// SlashParseError with the reason help.
+func (t *SlashParser) Parse(text string) (SlashArgs, error) {
+	parsed := SlashArgs{
+		Flags: make(map[string]string, len(t.flags)),
+	}
+
+	for _, f := range t.flags {
+		parsed.Flags[f.name] = f.def
+	}
+
+	tokens, err := tokenizeSlashText(text)
+	if err != nil {
+		return parsed, t.failure("%s", err)
+	}
+
+	if len(tokens) == 1 && tokens[0].key == "" && (tokens[0].value == "help" || tokens[0].value == "--help") {
+		return parsed, t.failure("help")
+	}
+
+	for _, tok := range tokens {
+		parsed.mentions(tok.value)
+
+		if tok.key == "" {
+			parsed.Args = append(parsed.Args, tok.value)
+			continue
+		}
+
+		if _, ok := t.flag(tok.key); !ok {
+			return parsed, t.failure("unknown flag %s", tok.key)
+		}
+
+		parsed.Flags[tok.key] = tok.value
+	}
+
+	required := 0
+	for _, a := range t.args {
+		if !a.optional {
+			required++
+		}
+	}
+
+	if len(parsed.Args) < required {
+		return parsed, t.failure("missing argument %s", t.args[len(parsed.Args)].name)
+	}
+
+	if t.variadic == nil && len(parsed.Args) > len(t.args) {
+		return parsed, t.failure("unexpected argument %s", parsed.Args[len(t.args)])
+	}
+
+	return parsed, nil
+}
+
+// SlashArgs the parsed text of a slash command.
+type SlashArgs struct {
+	// Args positional arguments in order.
+	Args []string
+	// Flags values of the declared flags, including defaults.
+	Flags map[string]string
+	// Users ids of the users mentioned in the arguments and flags.
+	Users []string
+	// Channels ids of the channels mentioned in the arguments and flags.
+	Channels []string
+}
+
+// Arg returns the positional argument at index i, empty when absent.
+func (t SlashArgs) Arg(i int) string {
+	if i < 0 || i >= len(t.Args) {
+		return ""
+	}
+
+	return t.Args[i]
+}
+
+// Flag returns the value of the flag.
+func (t SlashArgs) Flag(name string) string {
+	return t.Flags[name]
+}
+
+var mentionPattern = regexp.MustCompile(`<([@#])([A-Z0-9]+)(?:\|[^>]*)?>`)
+
+func (t *SlashArgs) mentions(s string) {
+	for _, m := range mentionPattern.FindAllStringSubmatch(s, -1) {
+		if m[1] == "@" {
+			t.Users = appendUnique(t.Users, m[2])
+		} else {
+			t.Channels = appendUnique(t.Channels, m[2])
+		}
+	}
+}
+
+func appendUnique(set []string, s string) []string {
+	for _, v := range set {
+		if v == s {
+			return set
+		}
+	}
+
+	return append(set, s)
+}
+
+// ParseUserMention extracts the user id from an escaped mention, e.g. <@U123|bob>.
+func ParseUserMention(s string) (string, bool) {
+	return parseMention(s, "<@")
+}
+
+// ParseChannelMention extracts the channel id from an escaped mention, e.g. <#C123|general>.
+func ParseChannelMention(s string) (string, bool) {
+	return parseMention(s, "<#")
+}
+
+func parseMention(s, prefix string) (string, bool) {
+	if !strings.HasPrefix(s, prefix) || !strings.HasSuffix(s, ">") {
+		return "", false
+	}
+
+	id := strings.TrimSuffix(strings.TrimPrefix(s, prefix), ">")
+	if i := strings.IndexByte(id, '|'); i >= 0 {
+		id = id[:i]
+	}
+
+	if id == "" {
+		return "", false
+	}
+
+	return id, true
+}
+
+type slashToken struct {
+	key   string
+	value string
+}
+
+// tokenizeSlashText splits the text into tokens, a token of the form key=value (optionally
+// prefixed with --) where the = is not quoted is a flag.
+func tokenizeSlashText(text string) ([]slashToken, error) {
+	var (
+		tokens  []slashToken
+		current strings.Builder
+		key     string
+		inToken bool
+		quote   rune
+		quoted  bool
+	)
+
+	flush := func() {
+		if inToken {
+			tokens = append(tokens, slashToken{key: key, value: current.String()})
+		}
+		current.Reset()
+		key, inToken, quoted = "", false, false
+	}
+
+	for _, r := range text {
+		switch {
+		case quote != 0 && r == closingQuote(quote):
+			quote = 0
+		case quote != 0:
+			current.WriteRune(r)
+		case isOpeningQuote(r):
+			quote, inToken, quoted = r, true, true
+		case unicode.IsSpace(r):
+			flush()
+		case r == '=' && key == "" && !quoted && isFlagName(current.String()):
+			key, inToken = strings.TrimPrefix(current.String(), "--"), true
+			current.Reset()
+		default:
+			inToken = true
+			current.WriteRune(r)
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+
+	flush()
+
+	return tokens, nil
+}
+
+func isOpeningQuote(r rune) bool {
+	return r == '"' || r == '“'
+}
+
+func closingQuote(r rune) rune {
+	if r == '“' {
+		return '”'
+	}
+
+	return r
+}
+
+func isFlagName(s string) bool {
+	s = strings.TrimPrefix(s, "--")
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,108 @@
+package slack
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newDeployParser() *SlashParser {
+	return NewSlashParser("/deploy", "Deploy a service.").
+		Arg("service", "the service to deploy").
+		OptionalArg("version", "the version, defaults to latest").
+		Flag("env", "staging", "the target environment").
+		Flag("note", "", "note attached to the deploy")
+}
+
+func TestSlashParserParse(t *testing.T) {
+	tests := []struct {
+		text     string
+		args     []string
+		flags    map[string]string
+		users    []string
+		channels []string
+	}{
+		{
+			text:  "api",
+			args:  []string{"api"},
+			flags: map[string]string{"env": "staging", "note": ""},
+		},
+		{
+			text:  `api v1.2 env=production --note="hot fix for <@U1|bob>"`,
+			args:  []string{"api", "v1.2"},
+			flags: map[string]string{"env": "production", "note": "hot fix for <@U1|bob>"},
+			users: []string{"U1"},
+		},
+		{
+			text:  "“api gateway” note=",
+			args:  []string{"api gateway"},
+			flags: map[string]string{"env": "staging", "note": ""},
+		},
+		{
+			text:     `<@U2|alice> <#C1|general>`,
+			args:     []string{"<@U2|alice>", "<#C1|general>"},
+			flags:    map[string]string{"env": "staging", "note": ""},
+			users:    []string{"U2"},
+			channels: []string{"C1"},
+		},
+		{
+			text:  `"a=b"`,
+			args:  []string{"a=b"},
+			flags: map[string]string{"env": "staging", "note": ""},
+		},
+	}
+
+	for _, test := range tests {
+		parsed, err := newDeployParser().Parse(test.text)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.text, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(parsed.Args, test.args) || !reflect.DeepEqual(parsed.Flags, test.flags) {
+			t.Errorf("%s: unexpected result %#v", test.text, parsed)
+		}
+
+		if !reflect.DeepEqual(parsed.Users, test.users) || !reflect.DeepEqual(parsed.Channels, test.channels) {
+			t.Errorf("%s: unexpected mentions %v %v", test.text, parsed.Users, parsed.Channels)
+		}
+	}
+}
+
+func TestSlashParserErrors(t *testing.T) {
+	tests := map[string]string{
+		"":                "missing argument service",
+		"api v1 extra":    "unexpected argument extra",
+		"api region=west": "unknown flag region",
+		`api note="open`:  "unterminated quote",
+		"help":            "help",
+	}
+
+	for text, reason := range tests {
+		_, err := newDeployParser().Parse(text)
+		perr, ok := err.(SlashParseError)
+		if !ok {
+			t.Errorf("%q: expected a parse error, got %v", text, err)
+			continue
+		}
+
+		if perr.Reason != reason || perr.Usage == "" {
+			t.Errorf("%q: unexpected error %#v", text, perr)
+		}
+	}
+}
+
+func TestSlashParserUsage(t *testing.T) {
+	usage := newDeployParser().Rest("targets", "additional targets").Usage()
+
+	for _, expected := range []string{
+		"Deploy a service.",
+		"Usage: `/deploy <service> [version] [targets...] [key=value...]`",
+		"• `env=` the target environment (default \"staging\")",
+		"• `targets` additional targets",
+	} {
+		if !strings.Contains(usage, expected) {
+			t.Errorf("expected %q in usage:\n%s", expected, usage)
+		}
+	}
+}
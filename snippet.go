@@ -0,0 +1,127 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// snippetTypesByExtension filetypes of snippets by the extension of the filename.
+var snippetTypesByExtension = map[string]string{
+	".c":          "c",
+	".h":          "c",
+	".cpp":        "cpp",
+	".cc":         "cpp",
+	".cs":         "csharp",
+	".css":        "css",
+	".diff":       "diff",
+	".patch":      "diff",
+	".dockerfile": "dockerfile",
+	".go":         "go",
+	".html":       "html",
+	".java":       "java",
+	".js":         "javascript",
+	".json":       "json",
+	".kt":         "kotlin",
+	".md":         "markdown",
+	".php":        "php",
+	".py":         "python",
+	".rb":         "ruby",
+	".rs":         "rust",
+	".sh":         "shell",
+	".bash":       "shell",
+	".sql":        "sql",
+	".swift":      "swift",
+	".ts":         "typescript",
+	".txt":        "text",
+	".log":        "text",
+	".xml":        "xml",
+	".yaml":       "yaml",
+	".yml":        "yaml",
+}
+
+// DetectSnippetType determines the filetype of a snippet from the extension of the filename,
+// falling back to sniffing the content. returns text when the type cannot be determined.
+func DetectSnippetType(filename string, content []byte) string {
+	if filetype, ok := snippetTypesByExtension[strings.ToLower(filepath.Ext(filename))]; ok {
+		return filetype
+	}
+
+	if strings.EqualFold(filepath.Base(filename), "dockerfile") {
+		return "dockerfile"
+	}
+
+	trimmed := bytes.TrimSpace(content)
+	lower := trimmed
+	if len(lower) > 512 {
+		lower = lower[:512]
+	}
+	lower = bytes.ToLower(lower)
+
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("#!")):
+		shebang := string(lower)
+		if i := strings.IndexByte(shebang, '\n'); i >= 0 {
+			shebang = shebang[:i]
+		}
+
+		switch {
+		case strings.Contains(shebang, "python"):
+			return "python"
+		case strings.Contains(shebang, "ruby"):
+			return "ruby"
+		case strings.Contains(shebang, "node"):
+			return "javascript"
+		default:
+			return "shell"
+		}
+	case bytes.HasPrefix(lower, []byte("<?xml")):
+		return "xml"
+	case bytes.HasPrefix(lower, []byte("<!doctype html")), bytes.HasPrefix(lower, []byte("<html")):
+		return "html"
+	case bytes.HasPrefix(trimmed, []byte("diff --git")), bytes.HasPrefix(trimmed, []byte("--- ")) && bytes.Contains(trimmed, []byte("\n+++ ")):
+		return "diff"
+	case (bytes.HasPrefix(trimmed, []byte("{")) || bytes.HasPrefix(trimmed, []byte("["))) && json.Valid(trimmed):
+		return "json"
+	case bytes.HasPrefix(trimmed, []byte("---\n")):
+		return "yaml"
+	default:
+		return "text"
+	}
+}
+
+// UploadSnippet uploads text content as a snippet, see UploadSnippetContext.
+func (api *Client) UploadSnippet(channel, title string, content io.Reader) (*File, error) {
+	return api.UploadSnippetContext(context.Background(), channel, title, content)
+}
+
+// UploadSnippetContext uploads the text content as a snippet to the channel with a custom context.
+// The filetype of the snippet is detected from the extension of the title (e.g. main.go) or by
+// sniffing the content, see DetectSnippetType.
+func (api *Client) UploadSnippetContext(ctx context.Context, channel, title string, content io.Reader) (*File, error) {
+	encoded, err := ioutil.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(encoded) == 0 {
+		return nil, ErrParametersMissing
+	}
+
+	params := FileUploadParameters{
+		Content:  string(encoded),
+		Filetype: DetectSnippetType(title, encoded),
+		Filename: title,
+		Title:    title,
+	}
+
+	if channel != "" {
+		params.Channels = []string{channel}
+	}
+
+	return api.UploadFileContext(ctx, params)
+}
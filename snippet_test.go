@@ -0,0 +1,55 @@
+package slack
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectSnippetType(t *testing.T) {
+	tests := []struct {
+		filename string
+		content  string
+		expected string
+	}{
+		{filename: "main.go", content: "package main", expected: "go"},
+		{filename: "CONFIG.YML", content: "", expected: "yaml"},
+		{filename: "Dockerfile", content: "FROM scratch", expected: "dockerfile"},
+		{filename: "deploy", content: "#!/usr/bin/env python3\nprint(1)", expected: "python"},
+		{filename: "deploy", content: "#!/bin/bash\necho hi", expected: "shell"},
+		{filename: "payload", content: ` {"ok": true}`, expected: "json"},
+		{filename: "payload", content: `{"ok": true`, expected: "text"},
+		{filename: "change", content: "diff --git a/x b/x", expected: "diff"},
+		{filename: "page", content: "<!DOCTYPE html><html></html>", expected: "html"},
+		{filename: "notes", content: "hello world", expected: "text"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, DetectSnippetType(test.filename, []byte(test.content)), test.filename)
+	}
+}
+
+func TestUploadSnippet(t *testing.T) {
+	http.HandleFunc("/snippet/auth.test", authTestHandler)
+	http.HandleFunc("/snippet/files.upload", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `{"ok": true}`, r.FormValue("content"))
+		assert.Equal(t, "json", r.FormValue("filetype"))
+		assert.Equal(t, "C1", r.FormValue("channels"))
+		assert.Equal(t, "response", r.FormValue("title"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"file":{"id":"F1","filetype":"json","mode":"snippet"}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/snippet/"))
+
+	file, err := api.UploadSnippet("C1", "response", strings.NewReader(`{"ok": true}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "F1", file.ID)
+	assert.Equal(t, "snippet", file.Mode)
+
+	_, err = api.UploadSnippet("C1", "empty", strings.NewReader(""))
+	assert.Equal(t, ErrParametersMissing, err)
+}
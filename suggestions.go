@@ -0,0 +1,201 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Limits of the options returned to external selects.
+const (
+	MaxSuggestionOptions = 100
+	OptionValueMaxLength = 150
+)
+
+// DefaultSuggestionMoreText the text of the option selecting the next page of suggestions.
+const DefaultSuggestionMoreText = "More results…"
+
+const suggestionCursorPrefix = "slack.cursor:"
+
+// SuggestionSource provides the options of an external select, returning at most limit
+// options matching the query starting at offset, and whether more options match.
+type SuggestionSource func(ctx context.Context, query string, offset, limit int) (options []*OptionBlockObject, more bool, err error)
+
+// StaticSuggestions a SuggestionSource serving a fixed list of options filtered by the query,
+// see FilterOptions.
+func StaticSuggestions(options ...*OptionBlockObject) SuggestionSource {
+	return func(ctx context.Context, query string, offset, limit int) ([]*OptionBlockObject, bool, error) {
+		matched := FilterOptions(query, options...)
+		if offset >= len(matched) {
+			return nil, false, nil
+		}
+
+		matched = matched[offset:]
+		if len(matched) > limit {
+			return matched[:limit], true, nil
+		}
+
+		return matched, false, nil
+	}
+}
+
+// FilterOptions returns the options whose text contains the query, ignoring case.
+func FilterOptions(query string, options ...*OptionBlockObject) []*OptionBlockObject {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return options
+	}
+
+	matched := make([]*OptionBlockObject, 0, len(options))
+	for _, o := range options {
+		if o.Text != nil && strings.Contains(strings.ToLower(o.Text.Text), query) {
+			matched = append(matched, o)
+		}
+	}
+
+	return matched
+}
+
+// SuggestionCursor position within the suggestions of a query, encoded in the value of the
+// option selecting the next page.
+type SuggestionCursor struct {
+	Query  string
+	Offset int
+}
+
+// Encode the cursor into an option value, the query is truncated to fit OptionValueMaxLength.
+func (t SuggestionCursor) Encode() string {
+	encoded := suggestionCursorPrefix + strconv.Itoa(t.Offset) + ":"
+	query := t.Query
+	for len(encoded)+len(query) > OptionValueMaxLength {
+		query = truncateRunes(query, len([]rune(query))-1)
+	}
+
+	return encoded + query
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if n < 0 {
+		n = 0
+	}
+	if len(runes) <= n {
+		return s
+	}
+
+	return string(runes[:n])
+}
+
+// ParseSuggestionCursor decodes the value of a selected option, the boolean reports whether
+// the value is a cursor, i.e. the user selected the option for the next page.
+func ParseSuggestionCursor(value string) (SuggestionCursor, bool) {
+	if !strings.HasPrefix(value, suggestionCursorPrefix) {
+		return SuggestionCursor{}, false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(value, suggestionCursorPrefix), ":", 2)
+	if len(parts) != 2 {
+		return SuggestionCursor{}, false
+	}
+
+	offset, err := strconv.Atoi(parts[0])
+	if err != nil || offset < 0 {
+		return SuggestionCursor{}, false
+	}
+
+	return SuggestionCursor{Query: parts[1], Offset: offset}, true
+}
+
+// OptionsResponse the response to a block_suggestion request.
+type OptionsResponse struct {
+	Options      []*OptionBlockObject      `json:"options,omitempty"`
+	OptionGroups []*OptionGroupBlockObject `json:"option_groups,omitempty"`
+}
+
+// SuggestionOption options for the SuggestionPaginator.
+type SuggestionOption func(*SuggestionPaginator)
+
+// SuggestionOptionPageSize number of options per page, at most MaxSuggestionOptions - 1 to
+// leave room for the option selecting the next page.
+func SuggestionOptionPageSize(n int) SuggestionOption {
+	return func(t *SuggestionPaginator) {
+		t.pageSize = n
+	}
+}
+
+// SuggestionOptionMoreText text of the option selecting the next page, defaults to
+// DefaultSuggestionMoreText.
+func SuggestionOptionMoreText(s string) SuggestionOption {
+	return func(t *SuggestionPaginator) {
+		t.moreText = s
+	}
+}
+
+// NewSuggestionPaginator serves the options of external selects from the source in pages.
+// When more options match than fit in a page, a final option is added whose value is the
+// SuggestionCursor of the next page. Selections of that option should be detected with
+// ParseSuggestionCursor, the next page is served by Page.
+func NewSuggestionPaginator(source SuggestionSource, options ...SuggestionOption) SuggestionPaginator {
+	t := SuggestionPaginator{
+		source:   source,
+		pageSize: MaxSuggestionOptions - 1,
+		moreText: DefaultSuggestionMoreText,
+	}
+
+	for _, opt := range options {
+		opt(&t)
+	}
+
+	if t.pageSize <= 0 || t.pageSize >= MaxSuggestionOptions {
+		t.pageSize = MaxSuggestionOptions - 1
+	}
+
+	return t
+}
+
+// SuggestionPaginator serves paginated options to external selects.
+type SuggestionPaginator struct {
+	source   SuggestionSource
+	pageSize int
+	moreText string
+}
+
+// Page returns the options at the cursor.
+func (t SuggestionPaginator) Page(ctx context.Context, cursor SuggestionCursor) (OptionsResponse, error) {
+	options, more, err := t.source(ctx, cursor.Query, cursor.Offset, t.pageSize)
+	if err != nil {
+		return OptionsResponse{}, err
+	}
+
+	if len(options) > t.pageSize {
+		options, more = options[:t.pageSize], true
+	}
+
+	if more {
+		next := SuggestionCursor{Query: cursor.Query, Offset: cursor.Offset + len(options)}
+		// sources may return slices of their own options, the capacity is limited to avoid overwriting them.
+		options = append(options[:len(options):len(options)], NewOptionBlockObject(next.Encode(), PlainText(t.moreText, false)))
+	}
+
+	return OptionsResponse{Options: options}, nil
+}
+
+// Respond returns the first page of options for the query of a block_suggestion callback.
+// a query which is itself a cursor resumes from the cursor.
+func (t SuggestionPaginator) Respond(ctx context.Context, cb InteractionCallback) (OptionsResponse, error) {
+	cursor, ok := ParseSuggestionCursor(cb.Value)
+	if !ok {
+		cursor = SuggestionCursor{Query: cb.Value}
+	}
+
+	return t.Page(ctx, cursor)
+}
+
+// ServeHTTP responds to block_suggestion requests made to the options load url of the app.
+// requests should be authenticated before reaching the paginator, e.g. with SecretsVerifier.
+func (t SuggestionPaginator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	NewInteractionHandler(func(ctx context.Context, cb InteractionCallback) (interface{}, error) {
+		return t.Respond(ctx, cb)
+	}).ServeHTTP(w, r)
+}
@@ -0,0 +1,101 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func testSuggestionOptions(n int) []*OptionBlockObject {
+	options := make([]*OptionBlockObject, 0, n)
+	for i := 0; i < n; i++ {
+		options = append(options, NewOptionBlockObject(fmt.Sprintf("v%d", i), PlainText(fmt.Sprintf("Option %d", i), false)))
+	}
+	return options
+}
+
+func TestSuggestionPaginatorPages(t *testing.T) {
+	p := NewSuggestionPaginator(StaticSuggestions(testSuggestionOptions(150)...))
+
+	first, err := p.Respond(context.Background(), InteractionCallback{Type: InteractionTypeBlockSuggestion})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(first.Options) != MaxSuggestionOptions {
+		t.Fatalf("expected %d options, got %d", MaxSuggestionOptions, len(first.Options))
+	}
+
+	more := first.Options[len(first.Options)-1]
+	cursor, ok := ParseSuggestionCursor(more.Value)
+	if !ok || cursor.Offset != 99 || more.Text.Text != DefaultSuggestionMoreText {
+		t.Fatalf("unexpected more option %#v %#v", more, cursor)
+	}
+
+	second, err := p.Page(context.Background(), cursor)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(second.Options) != 51 || second.Options[0].Value != "v99" {
+		t.Errorf("unexpected second page %d %s", len(second.Options), second.Options[0].Value)
+	}
+}
+
+func TestSuggestionPaginatorFilters(t *testing.T) {
+	p := NewSuggestionPaginator(StaticSuggestions(testSuggestionOptions(30)...), SuggestionOptionPageSize(5))
+
+	resp, err := p.Respond(context.Background(), InteractionCallback{Value: "option 2"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// option 2, 20-29 match, 5 per page plus the next page.
+	if len(resp.Options) != 6 || resp.Options[0].Value != "v2" {
+		t.Fatalf("unexpected options %d", len(resp.Options))
+	}
+
+	cursor, _ := ParseSuggestionCursor(resp.Options[5].Value)
+	if cursor.Query != "option 2" || cursor.Offset != 5 {
+		t.Errorf("unexpected cursor %#v", cursor)
+	}
+}
+
+func TestSuggestionCursorTruncatesQuery(t *testing.T) {
+	encoded := SuggestionCursor{Query: strings.Repeat("é", 200), Offset: 10}.Encode()
+	if len(encoded) > OptionValueMaxLength {
+		t.Errorf("expected the cursor to fit, got %d", len(encoded))
+	}
+
+	if _, ok := ParseSuggestionCursor(encoded); !ok {
+		t.Errorf("expected a cursor")
+	}
+
+	if _, ok := ParseSuggestionCursor("v1"); ok {
+		t.Errorf("expected a plain value")
+	}
+}
+
+func TestSuggestionPaginatorServeHTTP(t *testing.T) {
+	p := NewSuggestionPaginator(StaticSuggestions(testSuggestionOptions(3)...))
+
+	payload := `{"type":"block_suggestion","action_id":"pick","block_id":"b1","value":"1"}`
+	req := httptest.NewRequest(http.MethodPost, "/options", strings.NewReader(url.Values{"payload": {payload}}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, req)
+
+	var resp OptionsResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if rec.Code != http.StatusOK || len(resp.Options) != 1 || resp.Options[0].Value != "v1" {
+		t.Errorf("unexpected response %d %#v", rec.Code, resp)
+	}
+}
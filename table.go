@@ -0,0 +1,294 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Table defaults.
+const (
+	DefaultTableMaxColumnWidth = 40
+	DefaultTableMaxWidth       = 120
+	// SectionMaxFields the maximum number of fields slack accepts in a single section block.
+	SectionMaxFields = 10
+)
+
+// TableOption options for rendering a Table.
+type TableOption func(*tableRenderer)
+
+// TableOptionMaxColumnWidth maximum display width of a single column in a code block,
+// longer cells are truncated. defaults to DefaultTableMaxColumnWidth.
+func TableOptionMaxColumnWidth(n int) TableOption {
+	return func(t *tableRenderer) {
+		t.column = n
+	}
+}
+
+// TableOptionMaxWidth maximum display width of a line in a code block, the trailing
+// columns are truncated to fit. defaults to DefaultTableMaxWidth.
+func TableOptionMaxWidth(n int) TableOption {
+	return func(t *tableRenderer) {
+		t.width = n
+	}
+}
+
+// TableOptionMaxLength maximum length of the rendered code block, rows which do not fit
+// are summarized by count. defaults to SectionTextMaxLength.
+func TableOptionMaxLength(n int) TableOption {
+	return func(t *tableRenderer) {
+		t.length = n
+	}
+}
+
+// NewTable builds a Table with the given column headers.
+func NewTable(header ...string) *Table {
+	return &Table{Header: header}
+}
+
+// Table tabular data that can be rendered as an aligned monospace code block or as
+// section blocks with fields.
+type Table struct {
+	Header []string
+	Rows   [][]string
+}
+
+// AddRow appends a row to the table, missing cells are rendered empty.
+func (t *Table) AddRow(cells ...string) *Table {
+	t.Rows = append(t.Rows, cells)
+	return t
+}
+
+type tableRenderer struct {
+	column int
+	width  int
+	length int
+}
+
+func newTableRenderer(options ...TableOption) tableRenderer {
+	r := tableRenderer{
+		column: DefaultTableMaxColumnWidth,
+		width:  DefaultTableMaxWidth,
+		length: SectionTextMaxLength,
+	}
+
+	for _, opt := range options {
+		opt(&r)
+	}
+
+	return r
+}
+
+// columns returns the number of columns in the table.
+func (t Table) columns() int {
+	n := len(t.Header)
+	for _, row := range t.Rows {
+		if len(row) > n {
+			n = len(row)
+		}
+	}
+
+	return n
+}
+
+// CodeBlock renders the table as mrkdwn code block with the columns aligned, the
+// width of wide characters (e.g. CJK and emoji) is taken into account.
+func (t Table) CodeBlock(options ...TableOption) string {
+	r := newTableRenderer(options...)
+	n := t.columns()
+	widths := make([]int, n)
+
+	measure := func(cells []string) {
+		for i, c := range cells {
+			if w := displayWidth(tableCell(c)); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	measure(t.Header)
+	for _, row := range t.Rows {
+		measure(row)
+	}
+
+	for i := range widths {
+		if r.column > 0 && widths[i] > r.column {
+			widths[i] = r.column
+		}
+	}
+
+	// shrink the trailing columns until the line fits.
+	if r.width > 0 {
+		total := 0
+		for i, w := range widths {
+			if i > 0 {
+				total += 2
+			}
+			total += w
+		}
+
+		for i := n - 1; i >= 0 && total > r.width; i-- {
+			shrink := total - r.width
+			if shrink > widths[i]-1 {
+				shrink = widths[i] - 1
+			}
+			widths[i] -= shrink
+			total -= shrink
+		}
+	}
+
+	line := func(cells []string) string {
+		parts := make([]string, n)
+		for i := range parts {
+			var c string
+			if i < len(cells) {
+				c = tableCell(cells[i])
+			}
+			parts[i] = padWidth(truncateWidth(c, widths[i]), widths[i])
+		}
+
+		return strings.TrimRight(strings.Join(parts, "  "), " ")
+	}
+
+	lines := make([]string, 0, len(t.Rows)+2)
+	if len(t.Header) > 0 {
+		lines = append(lines, line(t.Header))
+		sep := make([]string, n)
+		for i, w := range widths {
+			sep[i] = strings.Repeat("-", w)
+		}
+		lines = append(lines, strings.Join(sep, "  "))
+	}
+
+	const fence = "```"
+	size := 2*len(fence) + 2
+	for _, l := range lines {
+		size += len(l) + 1
+	}
+
+	// reserve room for summarizing the rows which do not fit.
+	reserve := len(remainingRows(len(t.Rows))) + 1
+	for i, row := range t.Rows {
+		l := line(row)
+		need := size + len(l) + 1
+		if i < len(t.Rows)-1 {
+			need += reserve
+		}
+
+		if r.length > 0 && need > r.length {
+			lines = append(lines, remainingRows(len(t.Rows)-i))
+			break
+		}
+
+		size += len(l) + 1
+		lines = append(lines, l)
+	}
+
+	return fence + "\n" + strings.Join(lines, "\n") + "\n" + fence
+}
+
+// SectionBlocks renders each row of the table as a section block with a field per
+// column, labelled by the header. rows with more columns than slack allows are split
+// across multiple sections. Cells are truncated to SectionFieldMaxLength.
+func (t Table) SectionBlocks() []Block {
+	blocks := make([]Block, 0, len(t.Rows))
+	for _, row := range t.Rows {
+		fields := make([]*TextBlockObject, 0, len(row))
+		for i, c := range row {
+			text := c
+			if i < len(t.Header) && t.Header[i] != "" {
+				text = fmt.Sprintf("*%s*\n%s", t.Header[i], c)
+			}
+			fields = append(fields, NewTextBlockObject(MarkdownType, Truncate(text, SectionFieldMaxLength), false, false))
+		}
+
+		for len(fields) > SectionMaxFields {
+			blocks = append(blocks, NewSectionBlock(nil, fields[:SectionMaxFields], nil))
+			fields = fields[SectionMaxFields:]
+		}
+
+		if len(fields) > 0 {
+			blocks = append(blocks, NewSectionBlock(nil, fields, nil))
+		}
+	}
+
+	return blocks
+}
+
+func remainingRows(n int) string {
+	return fmt.Sprintf("%s %d more rows", Ellipsis, n)
+}
+
+var tableCellReplacer = strings.NewReplacer("\r\n", " ", "\n", " ", "\t", " ", "`", "'")
+
+// tableCell normalizes a cell for a code block, newlines would break the alignment
+// and backticks would terminate the block.
+func tableCell(s string) string {
+	return tableCellReplacer.Replace(s)
+}
+
+// runeWidth the number of monospace columns a rune occupies.
+func runeWidth(r rune) int {
+	switch {
+	case r == 0 || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) || r == '\u200b':
+		return 0
+	case r >= 0x1100 && r <= 0x115F, // hangul jamo
+		r >= 0x2E80 && r <= 0xA4CF && r != 0x303F, // cjk, kana, yi
+		r >= 0xAC00 && r <= 0xD7A3,                // hangul syllables
+		r >= 0xF900 && r <= 0xFAFF,                // cjk compatibility ideographs
+		r >= 0xFE30 && r <= 0xFE4F,                // cjk compatibility forms
+		r >= 0xFF00 && r <= 0xFF60,                // fullwidth forms
+		r >= 0xFFE0 && r <= 0xFFE6,
+		r >= 0x1F300 && r <= 0x1F64F, // emoji
+		r >= 0x1F900 && r <= 0x1F9FF,
+		r >= 0x20000 && r <= 0x3FFFD:
+		return 2
+	default:
+		return 1
+	}
+}
+
+func displayWidth(s string) (n int) {
+	for _, r := range s {
+		n += runeWidth(r)
+	}
+
+	return n
+}
+
+// truncateWidth shortens the text to at most max columns, replacing the end of the
+// text with an Ellipsis when it is too wide.
+func truncateWidth(s string, max int) string {
+	if displayWidth(s) <= max {
+		return s
+	}
+
+	if max <= 0 {
+		return ""
+	}
+
+	var (
+		b strings.Builder
+		w int
+	)
+
+	for _, r := range s {
+		rw := runeWidth(r)
+		if w+rw > max-1 {
+			break
+		}
+		b.WriteRune(r)
+		w += rw
+	}
+
+	b.WriteString(Ellipsis)
+	return b.String()
+}
+
+func padWidth(s string, width int) string {
+	if w := displayWidth(s); w < width {
+		return s + strings.Repeat(" ", width-w)
+	}
+
+	return s
+}
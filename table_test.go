@@ -0,0 +1,48 @@
+package slack
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableCodeBlock(t *testing.T) {
+	table := NewTable("service", "status").
+		AddRow("api", "ok").
+		AddRow("worker-with-a-long-name", "degraded").
+		AddRow("日本", "ok")
+
+	expected := "```\n" +
+		"service     status\n" +
+		"----------  --------\n" +
+		"api         ok\n" +
+		"worker-wi…  degraded\n" +
+		"日本        ok\n" +
+		"```"
+	assert.Equal(t, expected, table.CodeBlock(TableOptionMaxColumnWidth(10)))
+
+	narrow := table.CodeBlock(TableOptionMaxColumnWidth(10), TableOptionMaxWidth(15))
+	assert.Contains(t, narrow, "worker-wi…  de…\n")
+
+	truncated := table.CodeBlock(TableOptionMaxColumnWidth(10), TableOptionMaxLength(80))
+	assert.True(t, len(truncated) <= 80, truncated)
+	assert.True(t, strings.HasSuffix(truncated, "… 2 more rows\n```"), truncated)
+}
+
+func TestTableSectionBlocks(t *testing.T) {
+	table := NewTable("service", "status").AddRow("api", "ok", "extra")
+
+	blocks := table.SectionBlocks()
+	assert.Len(t, blocks, 1)
+
+	section := blocks[0].(*SectionBlock)
+	assert.Len(t, section.Fields, 3)
+	assert.Equal(t, "*service*\napi", section.Fields[0].Text)
+	assert.Equal(t, "extra", section.Fields[2].Text)
+
+	wide := NewTable().AddRow(make([]string, 12)...)
+	blocks = wide.SectionBlocks()
+	assert.Len(t, blocks, 2)
+	assert.Len(t, blocks[1].(*SectionBlock).Fields, 2)
+}
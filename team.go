@@ -165,3 +165,72 @@ func (api *Client) GetBillableInfoForTeamContext(ctx context.Context) (map[strin
 
 	return api.billableInfoRequest(ctx, "team.billableInfo", values)
 }
+
+// Visibility of the custom profile fields returned by GetTeamProfile.
+const (
+	TeamProfileVisibilityAll     = "all"
+	TeamProfileVisibilityVisible = "visible"
+	TeamProfileVisibilityHidden  = "hidden"
+)
+
+// TeamProfile the custom profile fields defined by the team.
+type TeamProfile struct {
+	Fields []TeamProfileField `json:"fields"`
+}
+
+// TeamProfileField the definition of a custom profile field, the ID is the key of the field
+// in UserProfileCustomFields.
+type TeamProfileField struct {
+	ID             string                  `json:"id"`
+	Ordering       int                     `json:"ordering"`
+	Label          string                  `json:"label"`
+	Hint           string                  `json:"hint"`
+	Type           string                  `json:"type"`
+	PossibleValues []string                `json:"possible_values"`
+	IsHidden       bool                    `json:"is_hidden"`
+	Options        TeamProfileFieldOptions `json:"options"`
+}
+
+// TeamProfileFieldOptions the options of a custom profile field.
+type TeamProfileFieldOptions struct {
+	IsProtected bool `json:"is_protected"`
+	IsScim      bool `json:"is_scim"`
+}
+
+// Field returns the field with the label, the boolean reports whether the field exists.
+func (t TeamProfile) Field(label string) (TeamProfileField, bool) {
+	for _, field := range t.Fields {
+		if field.Label == label {
+			return field, true
+		}
+	}
+
+	return TeamProfileField{}, false
+}
+
+// GetTeamProfile returns the custom profile fields of the team, see GetTeamProfileContext.
+func (api *Client) GetTeamProfile(visibility string) (*TeamProfile, error) {
+	return api.GetTeamProfileContext(context.Background(), visibility)
+}
+
+// GetTeamProfileContext returns the custom profile fields of the team with a custom context. visibility
+// filters the fields (see TeamProfileVisibilityAll), empty returns the visible fields.
+func (api *Client) GetTeamProfileContext(ctx context.Context, visibility string) (*TeamProfile, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+	if visibility != "" {
+		values.Add("visibility", visibility)
+	}
+
+	response := struct {
+		Profile TeamProfile `json:"profile"`
+		SlackResponse
+	}{}
+
+	if err := api.postMethod(ctx, "team.profile.get", values, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Profile, response.Err()
+}
@@ -166,3 +166,37 @@ func TestGetAccessLogs(t *testing.T) {
 		t.Fatal(ErrIncorrectResponse)
 	}
 }
+
+func TestGetTeamProfile(t *testing.T) {
+	http.HandleFunc("/teamprofile/team.profile.get", func(rw http.ResponseWriter, r *http.Request) {
+		if r.FormValue("visibility") != TeamProfileVisibilityAll {
+			t.Errorf("unexpected visibility %q", r.FormValue("visibility"))
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"profile":{"fields":[
+			{"id":"Xf1","ordering":0,"label":"Phone extension","hint":"Enter the extension","type":"text","possible_values":null,"options":{"is_scim":false,"is_protected":false},"is_hidden":false},
+			{"id":"Xf2","ordering":1,"label":"Office","type":"options_list","possible_values":["London","Tokyo"],"options":{"is_scim":true,"is_protected":true},"is_hidden":true}
+		]}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/teamprofile/"))
+
+	profile, err := api.GetTeamProfile(TeamProfileVisibilityAll)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(profile.Fields) != 2 || profile.Fields[0].Hint != "Enter the extension" {
+		t.Fatalf("unexpected profile %#v", profile)
+	}
+
+	office, ok := profile.Field("Office")
+	if !ok || office.ID != "Xf2" || len(office.PossibleValues) != 2 || !office.IsHidden || !office.Options.IsProtected {
+		t.Errorf("unexpected field %#v", office)
+	}
+
+	if _, ok = profile.Field("Missing"); ok {
+		t.Error("expected the field to be missing")
+	}
+}
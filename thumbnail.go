@@ -0,0 +1,95 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Thumbnail an image preview of a file generated by slack.
+type Thumbnail struct {
+	URL    string
+	Width  int
+	Height int
+}
+
+// longest side of the thumbnail.
+func (t Thumbnail) size() int {
+	if t.Width > t.Height {
+		return t.Width
+	}
+
+	return t.Height
+}
+
+// Thumbnails returns the thumbnails available for the file ordered from smallest to largest.
+// The dimensions of thumbnails without reported dimensions (e.g. thumb_64) are their nominal size.
+func (t File) Thumbnails() []Thumbnail {
+	candidates := []Thumbnail{
+		{URL: t.Thumb64, Width: 64, Height: 64},
+		{URL: t.Thumb80, Width: 80, Height: 80},
+		{URL: t.Thumb160, Width: 160, Height: 160},
+		thumbnail(t.Thumb360, 360, t.Thumb360W, t.Thumb360H),
+		thumbnail(t.Thumb480, 480, t.Thumb480W, t.Thumb480H),
+		thumbnail(t.Thumb720, 720, t.Thumb720W, t.Thumb720H),
+		thumbnail(t.Thumb800, 800, t.Thumb800W, t.Thumb800H),
+		thumbnail(t.Thumb960, 960, t.Thumb960W, t.Thumb960H),
+		thumbnail(t.Thumb1024, 1024, t.Thumb1024W, t.Thumb1024H),
+	}
+
+	thumbs := make([]Thumbnail, 0, len(candidates))
+	for _, c := range candidates {
+		if c.URL != "" {
+			thumbs = append(thumbs, c)
+		}
+	}
+
+	sort.SliceStable(thumbs, func(i, j int) bool {
+		return thumbs[i].size() < thumbs[j].size()
+	})
+
+	return thumbs
+}
+
+func thumbnail(url string, nominal, width, height int) Thumbnail {
+	if width == 0 || height == 0 {
+		width, height = nominal, nominal
+	}
+
+	return Thumbnail{URL: url, Width: width, Height: height}
+}
+
+// Thumbnail returns the smallest thumbnail whose longest side is at least size pixels, falling
+// back to the largest thumbnail available. The boolean reports whether the file has thumbnails.
+func (t File) Thumbnail(size int) (Thumbnail, bool) {
+	thumbs := t.Thumbnails()
+	if len(thumbs) == 0 {
+		return Thumbnail{}, false
+	}
+
+	for _, thumb := range thumbs {
+		if thumb.size() >= size {
+			return thumb, true
+		}
+	}
+
+	return thumbs[len(thumbs)-1], true
+}
+
+// GetThumbnail downloads the best fitting thumbnail of the file, see GetThumbnailContext.
+func (api *Client) GetThumbnail(file File, size int, writer io.Writer) (Thumbnail, error) {
+	return api.GetThumbnailContext(context.Background(), file, size, writer)
+}
+
+// GetThumbnailContext downloads the best fitting thumbnail of the file for the requested size
+// (see File.Thumbnail) into the writer with a custom context, authenticating with the token of
+// the client. returns the thumbnail that was downloaded.
+func (api *Client) GetThumbnailContext(ctx context.Context, file File, size int, writer io.Writer) (Thumbnail, error) {
+	thumb, ok := file.Thumbnail(size)
+	if !ok {
+		return thumb, fmt.Errorf("file %s has no thumbnails", file.ID)
+	}
+
+	return thumb, api.GetFileContext(ctx, thumb.URL, writer)
+}
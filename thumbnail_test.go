@@ -0,0 +1,62 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileThumbnail(t *testing.T) {
+	var file File
+	err := json.Unmarshal([]byte(`{
+		"id": "F1",
+		"thumb_64": "http://example.com/64.png",
+		"thumb_360": "http://example.com/360.png", "thumb_360_w": 360, "thumb_360_h": 180,
+		"thumb_800": "http://example.com/800.png", "thumb_800_w": 800, "thumb_800_h": 400,
+		"thumb_pdf": "http://example.com/pdf.png", "thumb_pdf_w": 900, "thumb_pdf_h": 1200,
+		"preview_is_truncated": true
+	}`), &file)
+	assert.Nil(t, err)
+	assert.Equal(t, "http://example.com/pdf.png", file.ThumbPDF)
+	assert.True(t, file.PreviewTruncated)
+
+	assert.Equal(t, []Thumbnail{
+		{URL: "http://example.com/64.png", Width: 64, Height: 64},
+		{URL: "http://example.com/360.png", Width: 360, Height: 180},
+		{URL: "http://example.com/800.png", Width: 800, Height: 400},
+	}, file.Thumbnails())
+
+	thumb, ok := file.Thumbnail(200)
+	assert.True(t, ok)
+	assert.Equal(t, "http://example.com/360.png", thumb.URL)
+
+	thumb, ok = file.Thumbnail(2000)
+	assert.True(t, ok)
+	assert.Equal(t, "http://example.com/800.png", thumb.URL)
+
+	_, ok = File{}.Thumbnail(64)
+	assert.False(t, ok)
+}
+
+func TestGetThumbnail(t *testing.T) {
+	http.HandleFunc("/thumbs/480.png", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer testing-token", r.Header.Get("Authorization"))
+		w.Write([]byte("png"))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token")
+
+	file := File{ID: "F1", Thumb80: "http://" + serverAddr + "/thumbs/80.png", Thumb480: "http://" + serverAddr + "/thumbs/480.png"}
+	buf := &bytes.Buffer{}
+	thumb, err := api.GetThumbnail(file, 100, buf)
+	assert.Nil(t, err)
+	assert.Equal(t, Thumbnail{URL: file.Thumb480, Width: 480, Height: 480}, thumb)
+	assert.Equal(t, "png", buf.String())
+
+	_, err = api.GetThumbnail(File{ID: "F2"}, 100, buf)
+	assert.EqualError(t, err, "file F2 has no thumbnails")
+}
@@ -0,0 +1,151 @@
+package slack
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// TokenType the kind of token, determined by its prefix.
+type TokenType string
+
+// Types of tokens.
+const (
+	TokenTypeUnknown TokenType = ""
+	TokenTypeBot     TokenType = "bot"
+	TokenTypeUser    TokenType = "user"
+	TokenTypeApp     TokenType = "app"
+	TokenTypeRefresh TokenType = "refresh"
+)
+
+// DetectTokenType determines the type of the token from its prefix, rotating access tokens
+// (xoxe.xoxb-, xoxe.xoxp-) are reported as the type of the underlying token.
+func DetectTokenType(token string) TokenType {
+	token = strings.TrimPrefix(token, "xoxe.")
+
+	switch {
+	case strings.HasPrefix(token, "xoxb-"):
+		return TokenTypeBot
+	case strings.HasPrefix(token, "xoxp-"):
+		return TokenTypeUser
+	case strings.HasPrefix(token, "xapp-"):
+		return TokenTypeApp
+	case strings.HasPrefix(token, "xoxe-"):
+		return TokenTypeRefresh
+	default:
+		return TokenTypeUnknown
+	}
+}
+
+// TokenTypeError an api method was called with a token which cannot be used with it.
+type TokenTypeError struct {
+	Method   string
+	Token    TokenType
+	Required TokenType
+}
+
+func (t TokenTypeError) Error() string {
+	return fmt.Sprintf("%s requires a %s token, the client has a %s token", t.Method, t.Required, t.Token)
+}
+
+// tokenRequirements the token required by api methods, keyed by method or method prefix
+// (ending with a dot).
+var tokenRequirements = map[string]TokenType{
+	"admin.":                TokenTypeUser,
+	"search.":               TokenTypeUser,
+	"stars.":                TokenTypeUser,
+	"reminders.":            TokenTypeUser,
+	"dnd.setSnooze":         TokenTypeUser,
+	"dnd.endSnooze":         TokenTypeUser,
+	"dnd.endDnd":            TokenTypeUser,
+	"users.setPresence":     TokenTypeUser,
+	"users.profile.set":     TokenTypeUser,
+	"users.setPhoto":        TokenTypeUser,
+	"users.deletePhoto":     TokenTypeUser,
+	"apps.connections.open": TokenTypeApp,
+}
+
+// requiredTokenType returns the token type required by the method, unknown when the method
+// accepts multiple token types.
+func requiredTokenType(method string) TokenType {
+	if required, ok := tokenRequirements[method]; ok {
+		return required
+	}
+
+	if i := strings.IndexByte(method, '.'); i >= 0 {
+		return tokenRequirements[method[:i+1]]
+	}
+
+	return TokenTypeUnknown
+}
+
+// checkTokenType verifies the token can be used with the method, tokens of an unknown type
+// are not checked.
+func checkTokenType(token TokenType, method string) error {
+	required := requiredTokenType(method)
+
+	switch token {
+	case TokenTypeUnknown:
+		return nil
+	case TokenTypeRefresh:
+		// refresh tokens can only be exchanged for access tokens.
+		if strings.HasPrefix(method, "oauth.") || strings.HasPrefix(method, "tooling.") {
+			return nil
+		}
+	case TokenTypeApp:
+		// app level tokens are limited to the apps.* methods.
+		if strings.HasPrefix(method, "apps.") {
+			return nil
+		}
+	default:
+		if required == TokenTypeUnknown || required == token {
+			return nil
+		}
+	}
+
+	if required == TokenTypeUnknown {
+		required = "bot or user"
+	}
+
+	return TokenTypeError{Method: method, Token: token, Required: required}
+}
+
+// OptionNoTokenGuard disables checking the token type before calling api methods, see
+// DetectTokenType. Useful when slack accepts a token type for a method the client does not
+// expect.
+func OptionNoTokenGuard() func(*Client) {
+	return func(c *Client) {
+		c.tokenGuard = false
+	}
+}
+
+// TokenType returns the type of the token of the client.
+func (api *Client) TokenType() TokenType {
+	return DetectTokenType(api.token)
+}
+
+// tokenGuardClient rejects requests to api methods which cannot be used with the token,
+// requests outside of the api endpoint (e.g. file downloads) are not checked.
+type tokenGuardClient struct {
+	httpClient
+	endpoint string
+	token    TokenType
+}
+
+func (t tokenGuardClient) Do(req *http.Request) (*http.Response, error) {
+	if !strings.HasPrefix(req.URL.String(), t.endpoint) {
+		return t.httpClient.Do(req)
+	}
+
+	if err := checkTokenType(t.token, path.Base(req.URL.Path)); err != nil {
+		// match the behavior of http.Client which always closes the body.
+		if req.Body != nil {
+			req.Body.Close()
+		}
+
+		return nil, err
+	}
+
+	return t.httpClient.Do(req)
+}
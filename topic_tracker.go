@@ -0,0 +1,121 @@
+package slack
+
+import (
+	"context"
+	"sync"
+)
+
+// ChannelTopicChangedEvent is emitted by the RTM after a channel_topic or group_topic
+// message. Previous is only populated when PreviousKnown is true, the RTM learns the
+// topic of a conversation when joining it or observing a change. the tracker is not
+// seeded with the conversations the user is already a member of, use TopicTracker.Seed
+// with RTMOptionTopicTracker to know the previous value of the first change.
+type ChannelTopicChangedEvent struct {
+	Channel       string
+	User          string
+	Timestamp     string
+	Previous      string
+	PreviousKnown bool
+	Current       string
+}
+
+// ChannelPurposeChangedEvent is emitted by the RTM after a channel_purpose or group_purpose
+// message, see ChannelTopicChangedEvent.
+type ChannelPurposeChangedEvent struct {
+	Channel       string
+	User          string
+	Timestamp     string
+	Previous      string
+	PreviousKnown bool
+	Current       string
+}
+
+// NewTopicTracker tracks the topic and purpose of conversations.
+func NewTopicTracker() *TopicTracker {
+	return &TopicTracker{
+		topics:   make(map[string]string),
+		purposes: make(map[string]string),
+	}
+}
+
+// TopicTracker remembers the topic and purpose of conversations to provide the
+// previous value when they change. useful for governance bots that enforce
+// naming and topic policies using either the RTM or the events api.
+type TopicTracker struct {
+	m        sync.Mutex
+	topics   map[string]string
+	purposes map[string]string
+}
+
+// Observe records the current topic and purpose of the conversation, e.g. from conversations.info.
+func (t *TopicTracker) Observe(ch Channel) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.topics[ch.ID] = ch.Topic.Value
+	t.purposes[ch.ID] = ch.Purpose.Value
+}
+
+// Seed observes the current topic and purpose of the conversations using conversations.info.
+func (t *TopicTracker) Seed(ctx context.Context, api *Client, channelIDs ...string) error {
+	for _, id := range channelIDs {
+		ch, err := api.GetConversationInfoContext(ctx, id, false)
+		if err != nil {
+			return err
+		}
+
+		t.Observe(*ch)
+	}
+
+	return nil
+}
+
+// SetTopic records the topic of the conversation returning the previous topic,
+// the boolean reports whether the previous topic was known.
+func (t *TopicTracker) SetTopic(channelID, topic string) (string, bool) {
+	return t.set(t.topics, channelID, topic)
+}
+
+// SetPurpose records the purpose of the conversation returning the previous purpose,
+// the boolean reports whether the previous purpose was known.
+func (t *TopicTracker) SetPurpose(channelID, purpose string) (string, bool) {
+	return t.set(t.purposes, channelID, purpose)
+}
+
+// Handle builds the topic or purpose change described by the message, returns nil
+// when the message is not a topic or purpose change.
+func (t *TopicTracker) Handle(msg Msg) interface{} {
+	switch msg.SubType {
+	case "channel_topic", "group_topic":
+		previous, known := t.SetTopic(msg.Channel, msg.Topic)
+		return &ChannelTopicChangedEvent{
+			Channel:       msg.Channel,
+			User:          msg.User,
+			Timestamp:     msg.Timestamp,
+			Previous:      previous,
+			PreviousKnown: known,
+			Current:       msg.Topic,
+		}
+	case "channel_purpose", "group_purpose":
+		previous, known := t.SetPurpose(msg.Channel, msg.Purpose)
+		return &ChannelPurposeChangedEvent{
+			Channel:       msg.Channel,
+			User:          msg.User,
+			Timestamp:     msg.Timestamp,
+			Previous:      previous,
+			PreviousKnown: known,
+			Current:       msg.Purpose,
+		}
+	default:
+		return nil
+	}
+}
+
+func (t *TopicTracker) set(values map[string]string, channelID, value string) (string, bool) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	previous, ok := values[channelID]
+	values[channelID] = value
+	return previous, ok
+}
@@ -0,0 +1,66 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicTracker(t *testing.T) {
+	tracker := NewTopicTracker()
+
+	previous, known := tracker.SetTopic("C1", "first")
+	assert.False(t, known)
+	assert.Equal(t, "", previous)
+
+	previous, known = tracker.SetTopic("C1", "second")
+	assert.True(t, known)
+	assert.Equal(t, "first", previous)
+
+	assert.Nil(t, tracker.Handle(Msg{Channel: "C1", Text: "hello"}))
+
+	tracker.Observe(Channel{GroupConversation: GroupConversation{Conversation: Conversation{ID: "G1"}, Purpose: Purpose{Value: "secret"}}})
+	changed := tracker.Handle(Msg{SubType: "group_purpose", Channel: "G1", User: "U1", Purpose: "public"})
+	assert.Equal(t, &ChannelPurposeChangedEvent{Channel: "G1", User: "U1", Previous: "secret", PreviousKnown: true, Current: "public"}, changed)
+}
+
+func TestRTMTopicChanges(t *testing.T) {
+	rtm := New("testing-token").NewRTM()
+
+	rtm.handleEvent("channel_joined", json.RawMessage(`{"type":"channel_joined","channel":{"id":"C1","topic":{"value":"old topic"}}}`))
+	rtm.handleEvent("message", json.RawMessage(`{"type":"message","subtype":"channel_topic","channel":"C1","user":"U1","topic":"new topic","ts":"1.0"}`))
+	assert.Equal(t, 3, len(rtm.IncomingEvents))
+
+	<-rtm.IncomingEvents
+	<-rtm.IncomingEvents
+	e := <-rtm.IncomingEvents
+	assert.Equal(t, "channel_topic_changed", e.Type)
+	changed := e.Data.(*ChannelTopicChangedEvent)
+	assert.Equal(t, "old topic", changed.Previous)
+	assert.True(t, changed.PreviousKnown)
+	assert.Equal(t, "new topic", changed.Current)
+	assert.Equal(t, "U1", changed.User)
+}
+
+func TestTopicTrackerSeed(t *testing.T) {
+	http.HandleFunc("/topicseed/conversations.info", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":{"id":"` + r.FormValue("channel") + `","topic":{"value":"seeded topic"}}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/topicseed/"))
+	tracker := NewTopicTracker()
+	assert.Nil(t, tracker.Seed(context.Background(), api, "C1"))
+
+	rtm := api.NewRTM(RTMOptionTopicTracker(tracker))
+	rtm.handleEvent("message", json.RawMessage(`{"type":"message","subtype":"channel_topic","channel":"C1","user":"U1","topic":"new topic","ts":"1.0"}`))
+	<-rtm.IncomingEvents
+	e := <-rtm.IncomingEvents
+	changed := e.Data.(*ChannelTopicChangedEvent)
+	assert.True(t, changed.PreviousKnown)
+	assert.Equal(t, "seeded topic", changed.Previous)
+}
@@ -0,0 +1,119 @@
+package slack
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+// tokenPattern matches slack tokens, including rotating tokens e.g. xoxe.xoxp-.
+var tokenPattern = regexp.MustCompile(`\b((?:xoxe\.)?xox[a-z]|xapp)-[0-9A-Za-z-]+`)
+
+// RedactTokens replaces the slack tokens in s, keeping the prefix identifying the type of token.
+func RedactTokens(s string) string {
+	return tokenPattern.ReplaceAllString(s, "${1}-REDACTED")
+}
+
+// TransportOption options for NewTransport.
+type TransportOption func(*transport)
+
+// TransportOptionRetry retries requests up to n times, see OptionRetry.
+func TransportOptionRetry(n int) TransportOption {
+	return func(t *transport) {
+		t.retries = n
+	}
+}
+
+// TransportOptionHeaders attaches the headers to every request, see OptionHeaders.
+func TransportOptionHeaders(headers http.Header) TransportOption {
+	return func(t *transport) {
+		if t.headers == nil {
+			t.headers = http.Header{}
+		}
+		applyHeaders(t.headers, headers)
+	}
+}
+
+// TransportOptionUserAgent identifies the application in the User-Agent of every request, see OptionUserAgent.
+func TransportOptionUserAgent(ua string) TransportOption {
+	return TransportOptionHeaders(http.Header{"User-Agent": {ua + " " + LibraryUserAgent}})
+}
+
+// TransportOptionLog logs retried requests and failed responses, tokens are redacted from the
+// log lines, see RedactTokens.
+func TransportOptionLog(l logger) TransportOption {
+	return func(t *transport) {
+		t.d = redactedDebug{logger: l}
+	}
+}
+
+// NewTransport wraps the round tripper with the retry, rate limit and header handling of the client,
+// allowing requests made outside of the client (e.g. bespoke api calls or other sdks) to reuse them.
+// a nil base uses http.DefaultTransport.
+//
+// Example:
+//
+//	client := &http.Client{Transport: slack.NewTransport(nil, slack.TransportOptionRetry(3))}
+func NewTransport(base http.RoundTripper, options ...TransportOption) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	t := &transport{d: discard{}}
+	for _, opt := range options {
+		opt(t)
+	}
+
+	t.client = roundTripperClient{RoundTripper: base}
+	if t.retries > 0 {
+		t.client = retryClient{httpClient: t.client, retries: t.retries, d: t.d}
+	}
+
+	return t
+}
+
+// transport adapts the http clients of the Client to an http.RoundTripper.
+type transport struct {
+	client  httpClient
+	headers http.Header
+	retries int
+	d       debug
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// round trippers must not modify the request.
+	if len(t.headers) > 0 {
+		req = req.Clone(req.Context())
+		applyHeaders(req.Header, t.headers)
+	}
+
+	return t.client.Do(req)
+}
+
+// roundTripperClient adapts an http.RoundTripper to an httpClient.
+type roundTripperClient struct {
+	http.RoundTripper
+}
+
+func (t roundTripperClient) Do(req *http.Request) (*http.Response, error) {
+	return t.RoundTrip(req)
+}
+
+// redactedDebug logs debug lines with the tokens redacted.
+type redactedDebug struct {
+	logger
+}
+
+func (t redactedDebug) Debug() bool {
+	return true
+}
+
+// Debugf print a formatted debug line.
+func (t redactedDebug) Debugf(format string, v ...interface{}) {
+	t.Output(2, RedactTokens(fmt.Sprintf(format, v...)))
+}
+
+// Debugln print a debug line.
+func (t redactedDebug) Debugln(v ...interface{}) {
+	t.Output(2, RedactTokens(fmt.Sprintln(v...)))
+}
@@ -0,0 +1,168 @@
+package slack
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// UserFieldChange a field which differs between two versions of a user,
+// Field is the json path of the field e.g. profile.title.
+type UserFieldChange struct {
+	Field    string
+	Previous interface{}
+	Current  interface{}
+}
+
+// DiffUsers returns the fields which differ between the previous and current
+// versions of a user. The updated timestamp is ignored.
+func DiffUsers(previous, current User) []UserFieldChange {
+	return diffFields("", reflect.ValueOf(previous), reflect.ValueOf(current), nil)
+}
+
+func diffFields(prefix string, previous, current reflect.Value, changes []UserFieldChange) []UserFieldChange {
+	for i := 0; i < previous.NumField(); i++ {
+		field := previous.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			name = field.Name
+		}
+
+		if prefix == "" && name == "updated" {
+			continue
+		}
+
+		p, c := previous.Field(i), current.Field(i)
+		if p.Kind() == reflect.Struct && exportedFields(p.Type()) {
+			changes = diffFields(prefix+name+".", p, c, changes)
+			continue
+		}
+
+		if !reflect.DeepEqual(p.Interface(), c.Interface()) {
+			changes = append(changes, UserFieldChange{
+				Field:    prefix + name,
+				Previous: p.Interface(),
+				Current:  c.Interface(),
+			})
+		}
+	}
+
+	return changes
+}
+
+func exportedFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).PkgPath == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UserChangedEvent is emitted by the RTM after a user_change event when the
+// previous version of the user is cached, see RTMOptionUserCache.
+type UserChangedEvent struct {
+	Previous User
+	Current  User
+	Changes  []UserFieldChange
+}
+
+// Changed reports whether any of the provided fields changed, fields are json paths
+// e.g. profile.title, a field matches all of its nested fields e.g. profile.
+func (t UserChangedEvent) Changed(fields ...string) bool {
+	for _, change := range t.Changes {
+		for _, field := range fields {
+			if change.Field == field || strings.HasPrefix(change.Field, field+".") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// UserTenantID the partition of the UserCache a user belongs to, the enterprise id
+// for users of an enterprise grid organization otherwise the team id.
+func UserTenantID(u User) string {
+	if u.Enterprise.EnterpriseID != "" {
+		return u.Enterprise.EnterpriseID
+	}
+
+	return u.TeamID
+}
+
+// NewUserCache caches users in memory, used to compute the changes of a user_change event.
+func NewUserCache(users ...User) *UserCache {
+	c := &UserCache{
+		tenants: make(map[string]map[string]User),
+	}
+
+	for _, u := range users {
+		c.Set(u)
+	}
+
+	return c
+}
+
+// UserCache in memory cache of users partitioned by tenant, see UserTenantID. apps
+// serving many workspaces never observe the users of another workspace. Safe for
+// concurrent use.
+type UserCache struct {
+	m       sync.Mutex
+	tenants map[string]map[string]User
+}
+
+// Get a user of the tenant from the cache.
+func (t *UserCache) Get(tenantID, userID string) (User, bool) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	u, ok := t.tenants[tenantID][userID]
+	return u, ok
+}
+
+// Set stores the user in the cache returning the previous version of the user,
+// the boolean reports whether the previous version was cached.
+func (t *UserCache) Set(u User) (User, bool) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	tenantID := UserTenantID(u)
+	users, ok := t.tenants[tenantID]
+	if !ok {
+		users = make(map[string]User)
+		t.tenants[tenantID] = users
+	}
+
+	previous, ok := users[u.ID]
+	users[u.ID] = u
+	return previous, ok
+}
+
+// Purge removes every user of the tenant from the cache, e.g. when the app is uninstalled.
+func (t *UserCache) Purge(tenantID string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	delete(t.tenants, tenantID)
+}
+
+// Handle caches the user of the event, returns the changes when the previous
+// version of the user was cached.
+func (t *UserCache) Handle(ev *UserChangeEvent) *UserChangedEvent {
+	previous, ok := t.Set(ev.User)
+	if !ok {
+		return nil
+	}
+
+	return &UserChangedEvent{
+		Previous: previous,
+		Current:  ev.User,
+		Changes:  DiffUsers(previous, ev.User),
+	}
+}
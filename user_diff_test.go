@@ -0,0 +1,76 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffUsers(t *testing.T) {
+	previous := User{ID: "U1", Name: "spengler", Updated: 1, Profile: UserProfile{Title: "Scientist", StatusText: "busy"}}
+	current := previous
+	current.Updated = 2
+	current.Profile.Title = "Chief Scientist"
+	current.Profile.StatusText = ""
+
+	changes := DiffUsers(previous, current)
+	assert.Equal(t, []UserFieldChange{
+		{Field: "profile.title", Previous: "Scientist", Current: "Chief Scientist"},
+		{Field: "profile.status_text", Previous: "busy", Current: ""},
+	}, changes)
+
+	assert.Empty(t, DiffUsers(previous, previous))
+
+	ev := UserChangedEvent{Changes: changes}
+	assert.True(t, ev.Changed("profile.title"))
+	assert.True(t, ev.Changed("profile"))
+	assert.False(t, ev.Changed("name", "profile.email"))
+}
+
+func TestRTMUserChanges(t *testing.T) {
+	cache := NewUserCache(User{ID: "U1", Name: "spengler", Profile: UserProfile{Title: "Scientist"}})
+	rtm := New("testing-token").NewRTM(RTMOptionUserCache(cache))
+
+	rtm.handleEvent("user_change", json.RawMessage(`{"type":"user_change","user":{"id":"U2","name":"venkman"}}`))
+	rtm.handleEvent("user_change", json.RawMessage(`{"type":"user_change","user":{"id":"U1","name":"spengler","profile":{"title":"Chief Scientist"}}}`))
+	assert.Equal(t, 3, len(rtm.IncomingEvents))
+
+	<-rtm.IncomingEvents
+	<-rtm.IncomingEvents
+	e := <-rtm.IncomingEvents
+	assert.Equal(t, "user_changed", e.Type)
+	changed := e.Data.(*UserChangedEvent)
+	assert.Equal(t, "Scientist", changed.Previous.Profile.Title)
+	assert.True(t, changed.Changed("profile.title"))
+
+	_, ok := cache.Get("", "U2")
+	assert.True(t, ok)
+}
+
+func TestUserCacheTenants(t *testing.T) {
+	cache := NewUserCache(
+		User{ID: "U1", TeamID: "T1", Name: "spengler"},
+		User{ID: "U1", TeamID: "T2", Name: "stantz"},
+		User{ID: "W1", TeamID: "T1", Name: "zeddemore", Enterprise: EnterpriseUser{EnterpriseID: "E1"}},
+	)
+
+	u, ok := cache.Get("T1", "U1")
+	assert.True(t, ok)
+	assert.Equal(t, "spengler", u.Name)
+
+	u, ok = cache.Get("T2", "U1")
+	assert.True(t, ok)
+	assert.Equal(t, "stantz", u.Name)
+
+	_, ok = cache.Get("T1", "W1")
+	assert.False(t, ok)
+	_, ok = cache.Get("E1", "W1")
+	assert.True(t, ok)
+
+	cache.Purge("T1")
+	_, ok = cache.Get("T1", "U1")
+	assert.False(t, ok)
+	_, ok = cache.Get("T2", "U1")
+	assert.True(t, ok)
+}
@@ -3,8 +3,10 @@ package slack
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -127,9 +129,22 @@ type User struct {
 	Enterprise        EnterpriseUser `json:"enterprise_user,omitempty"`
 }
 
-// UserPresence contains details about a user online status
+// Presence the online status of a user.
+type Presence string
+
+// Presence values, active and away are reported by users.getPresence, auto and away
+// are accepted by users.setPresence.
+const (
+	PresenceActive Presence = "active"
+	PresenceAway   Presence = "away"
+	PresenceAuto   Presence = "auto"
+)
+
+// UserPresence contains details about a user online status. Online, AutoAway, ManualAway,
+// ConnectionCount and LastActivity are only reported when retrieving the presence of the
+// user the token belongs to.
 type UserPresence struct {
-	Presence        string   `json:"presence,omitempty"`
+	Presence        Presence `json:"presence,omitempty"`
 	Online          bool     `json:"online,omitempty"`
 	AutoAway        bool     `json:"auto_away,omitempty"`
 	ManualAway      bool     `json:"manual_away,omitempty"`
@@ -137,6 +152,11 @@ type UserPresence struct {
 	LastActivity    JSONTime `json:"last_activity,omitempty"`
 }
 
+// Active reports whether the user is active.
+func (t UserPresence) Active() bool {
+	return t.Presence == PresenceActive
+}
+
 type UserIdentityResponse struct {
 	User UserIdentity `json:"user"`
 	Team TeamIdentity `json:"team"`
@@ -183,6 +203,7 @@ type TeamIdentity struct {
 
 type userResponseFull struct {
 	Members []User `json:"members,omitempty"`
+	Users   []User `json:"users,omitempty"`
 	User    `json:"user,omitempty"`
 	UserPresence
 	SlackResponse
@@ -237,8 +258,15 @@ func (api *Client) GetUserInfo(user string) (*User, error) {
 	return api.GetUserInfoContext(context.Background(), user)
 }
 
-// GetUserInfoContext will retrieve the complete user information with a custom context
+// GetUserInfoContext will retrieve the complete user information with a custom context,
+// returns ErrUserNotFound when the user does not exist.
 func (api *Client) GetUserInfoContext(ctx context.Context, user string) (*User, error) {
+	if api.userInfo != nil {
+		if u, ok := api.userInfo.get(user); ok {
+			return u, nil
+		}
+	}
+
 	values := url.Values{
 		"token":          {api.token},
 		"user":           {user},
@@ -246,12 +274,146 @@ func (api *Client) GetUserInfoContext(ctx context.Context, user string) (*User,
 	}
 
 	response, err := api.userRequest(ctx, "users.info", values)
+	if userNotFound(err) {
+		return nil, ErrUserNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
+	api.cacheUser(response.User)
 	return &response.User, nil
 }
 
+// MaxUsersInfoBatch the maximum number of users requested by a single users.info call, see GetUsersInfo.
+const MaxUsersInfoBatch = 30
+
+// GetUsersInfo will retrieve the complete information of multiple users, see GetUsersInfoContext.
+func (api *Client) GetUsersInfo(users ...string) ([]User, error) {
+	return api.GetUsersInfoContext(context.Background(), users...)
+}
+
+// GetUsersInfoContext will retrieve the complete information of multiple users with a custom context.
+// the users are requested in batches of MaxUsersInfoBatch, duplicate ids are requested once and users
+// available in the user info cache (see OptionUserInfoCache) are not requested. The users are returned
+// in the order they were provided, ids which do not exist are reported by a UsersNotFoundError returned
+// alongside the users which were found. Rate limited requests are retried.
+func (api *Client) GetUsersInfoContext(ctx context.Context, users ...string) ([]User, error) {
+	var (
+		ids     = make([]string, 0, len(users))
+		pending = make([]string, 0, len(users))
+		found   = make(map[string]User, len(users))
+		seen    = make(map[string]bool, len(users))
+		batches [][]string
+		missing []string
+	)
+
+	for _, id := range users {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+
+		if api.userInfo != nil {
+			if u, ok := api.userInfo.get(id); ok {
+				found[id] = *u
+				continue
+			}
+		}
+
+		pending = append(pending, id)
+	}
+
+	for len(pending) > 0 {
+		batch := pending
+		if len(batch) > MaxUsersInfoBatch {
+			batch = batch[:MaxUsersInfoBatch]
+		}
+		pending = pending[len(batch):]
+		batches = append(batches, batch)
+	}
+
+	for len(batches) > 0 {
+		batch := batches[0]
+		batches = batches[1:]
+
+		fetched, err := api.usersInfo(ctx, batch)
+		switch {
+		case err == nil:
+			for _, u := range fetched {
+				api.cacheUser(u)
+				found[u.ID] = u
+			}
+		case userNotFound(err) && len(batch) > 1:
+			// slack fails the entire request when any of the users do not exist,
+			// split the batch to isolate them.
+			batches = append(batches, batch[:len(batch)/2], batch[len(batch)/2:])
+		case userNotFound(err):
+		default:
+			return nil, err
+		}
+	}
+
+	result := make([]User, 0, len(ids))
+	for _, id := range ids {
+		if u, ok := found[id]; ok {
+			result = append(result, u)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	if len(missing) > 0 {
+		return result, UsersNotFoundError{Users: missing}
+	}
+
+	return result, nil
+}
+
+func (api *Client) usersInfo(ctx context.Context, ids []string) (users []User, err error) {
+	values := url.Values{
+		"token":          {api.token},
+		"users":          {strings.Join(ids, ",")},
+		"include_locale": {strconv.FormatBool(true)},
+	}
+
+	err = api.retryRateLimited(ctx, func() error {
+		response, err := api.userRequest(ctx, "users.info", values)
+		if err != nil {
+			return err
+		}
+
+		users = response.Users
+		return nil
+	})
+
+	return users, err
+}
+
+// UsersNotFoundError the users which do not exist, see GetUsersInfo.
+type UsersNotFoundError struct {
+	Users []string
+}
+
+func (t UsersNotFoundError) Error() string {
+	return fmt.Sprintf("users not found: %s", strings.Join(t.Users, ","))
+}
+
+// userNotFound reports whether slack rejected the request because the user does not exist,
+// users.info reports user_not_found while users.lookupByEmail reports users_not_found.
+func userNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch err.Error() {
+	case "user_not_found", "users_not_found":
+		return true
+	default:
+		return false
+	}
+}
+
 // GetUsersOption options for the GetUsers method call.
 type GetUsersOption func(*UserPagination)
 
@@ -269,10 +431,26 @@ func GetUsersOptionPresence(n bool) GetUsersOption {
 	}
 }
 
+// GetUsersOptionIncludeLocale include the locale of the users, defaults to true.
+func GetUsersOptionIncludeLocale(b bool) GetUsersOption {
+	return func(p *UserPagination) {
+		p.locale = b
+	}
+}
+
+// GetUsersOptionCursor resume the pagination from the cursor of a previous page,
+// see UserPagination.Metadata.
+func GetUsersOptionCursor(cursor string) GetUsersOption {
+	return func(p *UserPagination) {
+		p.previousResp = &ResponseMetadata{Cursor: cursor}
+	}
+}
+
 func newUserPagination(c *Client, options ...GetUsersOption) (up UserPagination) {
 	up = UserPagination{
-		c:     c,
-		limit: 200, // per slack api documentation.
+		c:      c,
+		limit:  200, // per slack api documentation.
+		locale: true,
 	}
 
 	for _, opt := range options {
@@ -287,6 +465,7 @@ type UserPagination struct {
 	Users        []User
 	limit        int
 	presence     bool
+	locale       bool
 	previousResp *ResponseMetadata
 	c            *Client
 }
@@ -305,6 +484,17 @@ func (t UserPagination) Failure(err error) error {
 	return err
 }
 
+// Metadata returns the response metadata of the current page, the cursor resumes the
+// pagination from the next page, see GetUsersOptionCursor.
+func (t UserPagination) Metadata() ResponseMetadata {
+	if t.previousResp == nil {
+		return ResponseMetadata{}
+	}
+
+	return *t.previousResp
+}
+
+// Next fetches the next page of users.
 func (t UserPagination) Next(ctx context.Context) (_ UserPagination, err error) {
 	var (
 		resp *userResponseFull
@@ -321,7 +511,7 @@ func (t UserPagination) Next(ctx context.Context) (_ UserPagination, err error)
 		"presence":       {strconv.FormatBool(t.presence)},
 		"token":          {t.c.token},
 		"cursor":         {t.previousResp.Cursor},
-		"include_locale": {strconv.FormatBool(true)},
+		"include_locale": {strconv.FormatBool(t.locale)},
 	}
 
 	if resp, err = t.c.userRequest(ctx, "users.list", values); err != nil {
@@ -341,13 +531,14 @@ func (api *Client) GetUsersPaginated(options ...GetUsersOption) UserPagination {
 }
 
 // GetUsers returns the list of users (with their detailed information)
-func (api *Client) GetUsers() ([]User, error) {
-	return api.GetUsersContext(context.Background())
+func (api *Client) GetUsers(options ...GetUsersOption) ([]User, error) {
+	return api.GetUsersContext(context.Background(), options...)
 }
 
-// GetUsersContext returns the list of users (with their detailed information) with a custom context
-func (api *Client) GetUsersContext(ctx context.Context) (results []User, err error) {
-	p := api.GetUsersPaginated()
+// GetUsersContext returns the list of users (with their detailed information) with a custom context.
+// users are fetched page by page following the cursor, waiting out rate limits.
+func (api *Client) GetUsersContext(ctx context.Context, options ...GetUsersOption) (results []User, err error) {
+	p := api.GetUsersPaginated(options...)
 	for err == nil {
 		p, err = p.Next(ctx)
 		if err == nil {
@@ -370,16 +561,27 @@ func (api *Client) GetUserByEmail(email string) (*User, error) {
 	return api.GetUserByEmailContext(context.Background(), email)
 }
 
-// GetUserByEmailContext will retrieve the complete user information by email with a custom context
+// GetUserByEmailContext will retrieve the complete user information by email with a custom context.
+// returns ErrUserNotFound when no user of the workspace has the email address.
 func (api *Client) GetUserByEmailContext(ctx context.Context, email string) (*User, error) {
+	if api.userInfo != nil {
+		if u, ok := api.userInfo.getByEmail(email); ok {
+			return u, nil
+		}
+	}
+
 	values := url.Values{
 		"token": {api.token},
-		"email": {email},
+		"email": {strings.TrimSpace(email)},
 	}
 	response, err := api.userRequest(ctx, "users.lookupByEmail", values)
+	if userNotFound(err) {
+		return nil, ErrUserNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
+	api.cacheUser(response.User)
 	return &response.User, nil
 }
 
@@ -399,15 +601,20 @@ func (api *Client) SetUserAsActiveContext(ctx context.Context) (err error) {
 }
 
 // SetUserPresence changes the currently authenticated user presence
-func (api *Client) SetUserPresence(presence string) error {
+func (api *Client) SetUserPresence(presence Presence) error {
 	return api.SetUserPresenceContext(context.Background(), presence)
 }
 
-// SetUserPresenceContext changes the currently authenticated user presence with a custom context
-func (api *Client) SetUserPresenceContext(ctx context.Context, presence string) error {
+// SetUserPresenceContext changes the currently authenticated user presence with a custom context.
+// the presence must be either PresenceAuto or PresenceAway.
+func (api *Client) SetUserPresenceContext(ctx context.Context, presence Presence) error {
+	if presence != PresenceAuto && presence != PresenceAway {
+		return fmt.Errorf("invalid presence %q, expected %s or %s", presence, PresenceAuto, PresenceAway)
+	}
+
 	values := url.Values{
 		"token":    {api.token},
-		"presence": {presence},
+		"presence": {string(presence)},
 	}
 
 	_, err := api.userRequest(ctx, "users.setPresence", values)
@@ -0,0 +1,207 @@
+package slack
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultUserInfoCacheTTL duration cached users are returned for by default.
+const DefaultUserInfoCacheTTL = 5 * time.Minute
+
+// UserInfoCacheOption options for the user info cache.
+type UserInfoCacheOption func(*userInfoCache)
+
+// UserInfoCacheOptionTTL duration cached users are returned for, defaults to DefaultUserInfoCacheTTL.
+func UserInfoCacheOptionTTL(d time.Duration) UserInfoCacheOption {
+	return func(t *userInfoCache) {
+		t.ttl = d
+	}
+}
+
+// OptionUserInfoCache memoizes the users returned by GetUserInfo and GetUserByEmail. Cached users are
+// refreshed by the user_change events received by the RTM, apps using the events api should pass the
+// users of user_change events to CacheUser. see InvalidateCachedUser and PurgeCachedUsers.
+func OptionUserInfoCache(options ...UserInfoCacheOption) func(*Client) {
+	return func(c *Client) {
+		c.userInfo = newUserInfoCache(options...)
+	}
+}
+
+func newUserInfoCache(options ...UserInfoCacheOption) *userInfoCache {
+	t := &userInfoCache{
+		ttl:    DefaultUserInfoCacheTTL,
+		now:    time.Now,
+		users:  make(map[string]cachedUser),
+		emails: make(map[string]string),
+	}
+
+	for _, opt := range options {
+		opt(t)
+	}
+
+	return t
+}
+
+type cachedUser struct {
+	user    User
+	fetched time.Time
+}
+
+// userInfoCache in memory cache of users keyed by id, with an index by email address.
+type userInfoCache struct {
+	ttl    time.Duration
+	now    func() time.Time
+	m      sync.Mutex
+	users  map[string]cachedUser
+	emails map[string]string
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func (t *userInfoCache) get(userID string) (*User, bool) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	cached, ok := t.users[userID]
+	if !ok {
+		return nil, false
+	}
+
+	if t.now().Sub(cached.fetched) >= t.ttl {
+		t.remove(userID)
+		return nil, false
+	}
+
+	u := cached.user
+	return &u, true
+}
+
+func (t *userInfoCache) getByEmail(email string) (*User, bool) {
+	t.m.Lock()
+	userID, ok := t.emails[normalizeEmail(email)]
+	t.m.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	return t.get(userID)
+}
+
+func (t *userInfoCache) set(u User) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	// the email of the user may have changed.
+	t.remove(u.ID)
+
+	t.users[u.ID] = cachedUser{user: u, fetched: t.now()}
+	if email := normalizeEmail(u.Profile.Email); email != "" {
+		t.emails[email] = u.ID
+	}
+}
+
+func (t *userInfoCache) invalidate(userID string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.remove(userID)
+}
+
+func (t *userInfoCache) purge() {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.users = make(map[string]cachedUser)
+	t.emails = make(map[string]string)
+}
+
+// remove the user from the cache, the lock must be held.
+func (t *userInfoCache) remove(userID string) {
+	cached, ok := t.users[userID]
+	if !ok {
+		return
+	}
+
+	delete(t.users, userID)
+	if email := normalizeEmail(cached.user.Profile.Email); t.emails[email] == userID {
+		delete(t.emails, email)
+	}
+}
+
+// CacheUser stores the user in the user info cache, e.g. the user of a user_change event
+// received via the events api. cached users.info and users.lookupByEmail responses of the
+// user are invalidated when OptionCache is set. noop unless OptionUserInfoCache or OptionCache is set.
+func (api *Client) CacheUser(u User) {
+	if u.ID == "" {
+		return
+	}
+
+	api.invalidateCachedResponses(u.ID)
+	api.cacheUser(u)
+}
+
+// cacheUser stores the user in the user info cache when set.
+func (api *Client) cacheUser(u User) {
+	if api.userInfo == nil || u.ID == "" {
+		return
+	}
+
+	api.userInfo.set(u)
+}
+
+// InvalidateCachedUser removes the user from the user info cache and the cached users.info and
+// users.lookupByEmail responses of OptionCache, the next lookup of the user calls the api.
+func (api *Client) InvalidateCachedUser(userID string) {
+	api.invalidateCachedResponses(userID)
+
+	if api.userInfo == nil {
+		return
+	}
+
+	api.userInfo.invalidate(userID)
+}
+
+// PurgeCachedUsers removes every user from the user info cache and the cached users.info and
+// users.lookupByEmail responses of OptionCache.
+func (api *Client) PurgeCachedUsers() {
+	api.invalidateCachedResponses("")
+
+	if api.userInfo == nil {
+		return
+	}
+
+	api.userInfo.purge()
+}
+
+// invalidateCachedResponses removes the cached responses describing the user from the response
+// cache, an empty id removes every user.
+func (api *Client) invalidateCachedResponses(userID string) {
+	if api.cache == nil {
+		return
+	}
+
+	api.cache.invalidate("users.info", func(params url.Values, _ []byte) bool {
+		if userID == "" || params.Get("user") == userID {
+			return true
+		}
+
+		for _, id := range strings.Split(params.Get("users"), ",") {
+			if id == userID {
+				return true
+			}
+		}
+
+		return false
+	})
+
+	api.cache.invalidate("users.lookupByEmail", func(_ url.Values, body []byte) bool {
+		var resp userResponseFull
+		return userID == "" || json.Unmarshal(body, &resp) != nil || resp.User.ID == userID
+	})
+}
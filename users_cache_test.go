@@ -0,0 +1,109 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestUserInfoCache(t *testing.T) {
+	var calls int
+	http.HandleFunc("/userinfocache/users.info", func(rw http.ResponseWriter, r *http.Request) {
+		calls++
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"user":{"id":"U1","name":"alice","profile":{"email":"alice@example.com"}}}`))
+	})
+	http.HandleFunc("/userinfocache/users.lookupByEmail", func(rw http.ResponseWriter, r *http.Request) {
+		calls++
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"user":{"id":"U2","name":"bob","profile":{"email":"bob@example.com"}}}`))
+	})
+
+	once.Do(startServer)
+	now := time.Now()
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/userinfocache/"), OptionUserInfoCache(UserInfoCacheOptionTTL(time.Minute)))
+	api.userInfo.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if u, err := api.GetUserInfo("U1"); err != nil || u.Name != "alice" {
+			t.Fatalf("unexpected user %v %v", u, err)
+		}
+	}
+
+	// users returned by users.info are available by email.
+	if u, err := api.GetUserByEmail(" Alice@example.com"); err != nil || u.ID != "U1" || calls != 1 {
+		t.Errorf("expected a cached user, got %v %v %d", u, err, calls)
+	}
+
+	if u, err := api.GetUserByEmail("bob@example.com"); err != nil || u.ID != "U2" || calls != 2 {
+		t.Errorf("unexpected user %v %v %d", u, err, calls)
+	}
+
+	// user_change events refresh the cache.
+	api.CacheUser(User{ID: "U1", Name: "alice2", Profile: UserProfile{Email: "alice2@example.com"}})
+	if u, _ := api.GetUserInfo("U1"); u.Name != "alice2" || calls != 2 {
+		t.Errorf("expected the refreshed user, got %v %d", u, calls)
+	}
+
+	if _, ok := api.userInfo.getByEmail("alice@example.com"); ok {
+		t.Error("expected the previous email to be removed")
+	}
+
+	api.InvalidateCachedUser("U1")
+	if _, err := api.GetUserInfo("U1"); err != nil || calls != 3 {
+		t.Errorf("expected an api call after invalidation, got %v %d", err, calls)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := api.GetUserByEmail("bob@example.com"); err != nil || calls != 4 {
+		t.Errorf("expected an api call after the ttl, got %v %d", err, calls)
+	}
+
+	api.PurgeCachedUsers()
+	if _, err := api.GetUserInfo("U1"); err != nil || calls != 5 {
+		t.Errorf("expected an api call after purging, got %v %d", err, calls)
+	}
+}
+
+func TestUserInfoCacheInvalidatesResponseCache(t *testing.T) {
+	var calls int
+	http.HandleFunc("/userinforesponses/users.info", func(rw http.ResponseWriter, r *http.Request) {
+		calls++
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"user":{"id":"U1","name":"alice"}}`))
+	})
+	http.HandleFunc("/userinforesponses/users.lookupByEmail", func(rw http.ResponseWriter, r *http.Request) {
+		calls++
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"user":{"id":"U1","name":"alice"}}`))
+	})
+
+	once.Do(startServer)
+	api := New(
+		"testing-token",
+		OptionAPIURL("http://"+serverAddr+"/userinforesponses/"),
+		OptionCache(CacheOptionMethods("users.info", "users.lookupByEmail")),
+		OptionUserInfoCache(),
+	)
+
+	api.GetUserInfo("U1")
+	api.GetUserByEmail("alice@example.com")
+	if calls != 2 {
+		t.Fatalf("expected 2 api calls, got %d", calls)
+	}
+
+	api.InvalidateCachedUser("U1")
+	api.GetUserInfo("U1")
+	api.GetUserByEmail("alice@example.com")
+	if calls != 4 {
+		t.Errorf("expected the cached responses to be invalidated, got %d api calls", calls)
+	}
+
+	// responses of other users are retained.
+	api.InvalidateCachedUser("U2")
+	api.userInfo.purge()
+	api.GetUserInfo("U1")
+	if calls != 4 {
+		t.Errorf("expected the cached response, got %d api calls", calls)
+	}
+}
@@ -2,6 +2,7 @@ package slack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"image"
@@ -13,6 +14,7 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"testing"
 )
@@ -265,6 +267,76 @@ func TestGetUserByEmail(t *testing.T) {
 	}
 }
 
+func TestGetUserByEmailNotFound(t *testing.T) {
+	http.HandleFunc("/lookupmissing/users.lookupByEmail", func(rw http.ResponseWriter, r *http.Request) {
+		if r.FormValue("email") != "missing@test.com" {
+			t.Errorf("unexpected email %q", r.FormValue("email"))
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":false,"error":"users_not_found"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/lookupmissing/"))
+
+	if _, err := api.GetUserByEmail(" missing@test.com "); err != ErrUserNotFound {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestGetUserInfoNotFound(t *testing.T) {
+	http.HandleFunc("/infomissing/users.info", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":false,"error":"user_not_found"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/infomissing/"))
+
+	if _, err := api.GetUserInfo("UMISSING"); err != ErrUserNotFound {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestGetUserPresence(t *testing.T) {
+	http.HandleFunc("/presence/users.getPresence", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"presence":"active","online":true,"auto_away":false,"manual_away":false,"connection_count":2,"last_activity":1419027078}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/presence/"))
+
+	presence, err := api.GetUserPresence("U1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if !presence.Active() || !presence.Online || presence.ConnectionCount != 2 || presence.LastActivity.Time().Unix() != 1419027078 {
+		t.Errorf("unexpected presence %#v", presence)
+	}
+}
+
+func TestSetUserPresence(t *testing.T) {
+	var presence string
+	http.HandleFunc("/presence/users.setPresence", func(rw http.ResponseWriter, r *http.Request) {
+		presence = r.FormValue("presence")
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/presence/"))
+
+	if err := api.SetUserPresence(PresenceAway); err != nil || presence != string(PresenceAway) {
+		t.Fatalf("unexpected result %v %s", err, presence)
+	}
+
+	if err := api.SetUserPresence(PresenceActive); err == nil {
+		t.Errorf("expected an error for an invalid presence")
+	}
+}
+
 func TestUserCustomStatus(t *testing.T) {
 	up := &UserProfile{}
 
@@ -430,6 +502,39 @@ func getUserPagesWithRateLimitErrors(max int64) func(rw http.ResponseWriter, r *
 	}
 }
 
+func TestGetUsersPaginatedCursor(t *testing.T) {
+	http.HandleFunc("/userscursor/users.list", func(rw http.ResponseWriter, r *http.Request) {
+		if r.FormValue("cursor") != "dXNlcjpVMDAx" || r.FormValue("limit") != "2" || r.FormValue("include_locale") != "false" {
+			t.Errorf("unexpected request %v", r.Form)
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"members":[{"id":"U002"},{"id":"U003"}],"response_metadata":{"next_cursor":"dXNlcjpVMDAz","warnings":["superfluous_charset"]}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/userscursor/"))
+
+	p := api.GetUsersPaginated(
+		GetUsersOptionLimit(2),
+		GetUsersOptionIncludeLocale(false),
+		GetUsersOptionCursor("dXNlcjpVMDAx"),
+	)
+
+	p, err := p.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(p.Users) != 2 || p.Users[0].ID != "U002" {
+		t.Errorf("unexpected users %v", p.Users)
+	}
+
+	metadata := p.Metadata()
+	if metadata.Cursor != "dXNlcjpVMDAz" || len(metadata.Warnings) != 1 || metadata.Warnings[0] != "superfluous_charset" {
+		t.Errorf("unexpected metadata %#v", metadata)
+	}
+}
+
 func TestSetUserPhoto(t *testing.T) {
 	file, fileContent, teardown := createUserPhoto(t)
 	defer teardown()
@@ -679,3 +784,91 @@ func TestGetUsersReturnsServerError(t *testing.T) {
 		t.Errorf("Expected: %s. Got: %s", expectedErr, err.Error())
 	}
 }
+
+func TestGetUsersInfo(t *testing.T) {
+	var batches []string
+	http.HandleFunc("/usersinfo/users.info", func(rw http.ResponseWriter, r *http.Request) {
+		batches = append(batches, r.FormValue("users"))
+		ids := strings.Split(r.FormValue("users"), ",")
+		users := make([]string, 0, len(ids))
+		for _, id := range ids {
+			users = append(users, fmt.Sprintf(`{"id":%q}`, id))
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"users":[` + strings.Join(users, ",") + `]}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/usersinfo/"), OptionUserInfoCache())
+	api.CacheUser(User{ID: "U0"})
+
+	ids := []string{"U0"}
+	for i := 1; i <= MaxUsersInfoBatch+5; i++ {
+		ids = append(ids, fmt.Sprintf("U%d", i))
+	}
+	ids = append(ids, "U1")
+
+	users, err := api.GetUsersInfo(ids...)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(users) != MaxUsersInfoBatch+6 || users[0].ID != "U0" || users[len(users)-1].ID != fmt.Sprintf("U%d", MaxUsersInfoBatch+5) {
+		t.Errorf("unexpected users %v", users)
+	}
+
+	if len(batches) != 2 || strings.Count(batches[0], ",") != MaxUsersInfoBatch-1 || batches[1] != "U31,U32,U33,U34,U35" {
+		t.Errorf("unexpected batches %v", batches)
+	}
+}
+
+func TestGetUsersInfoMissingUsers(t *testing.T) {
+	var (
+		limited bool
+		calls   int
+	)
+	http.HandleFunc("/usersinfomissing/users.info", func(rw http.ResponseWriter, r *http.Request) {
+		calls++
+		if !limited {
+			limited = true
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		ids := strings.Split(r.FormValue("users"), ",")
+		users := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if strings.HasPrefix(id, "X") {
+				rw.Header().Set("Content-Type", "application/json")
+				rw.Write([]byte(`{"ok":false,"error":"users_not_found"}`))
+				return
+			}
+			users = append(users, fmt.Sprintf(`{"id":%q}`, id))
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"users":[` + strings.Join(users, ",") + `]}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/usersinfomissing/"), OptionUserInfoCache())
+	api.CacheUser(User{ID: "U2"})
+
+	users, err := api.GetUsersInfo("U3", "X1", "U2", "U1", "X2")
+	if nf, ok := err.(UsersNotFoundError); !ok || !reflect.DeepEqual(nf.Users, []string{"X1", "X2"}) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+
+	if !reflect.DeepEqual(ids, []string{"U3", "U2", "U1"}) {
+		t.Errorf("unexpected users %v", ids)
+	}
+
+	if !limited || calls < 3 {
+		t.Errorf("expected the rate limited batch to be retried and split, %d calls", calls)
+	}
+}
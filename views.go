@@ -0,0 +1,172 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// ViewType type of view.
+type ViewType string
+
+// Types of views.
+const (
+	VTModal   ViewType = "modal"
+	VTHomeTab ViewType = "home"
+)
+
+// View a modal or app home tab as sent by slack in interaction payloads.
+type View struct {
+	ID              string           `json:"id"`
+	TeamID          string           `json:"team_id"`
+	Type            ViewType         `json:"type"`
+	Title           *TextBlockObject `json:"title,omitempty"`
+	Close           *TextBlockObject `json:"close,omitempty"`
+	Submit          *TextBlockObject `json:"submit,omitempty"`
+	Blocks          Blocks           `json:"blocks"`
+	PrivateMetadata string           `json:"private_metadata"`
+	CallbackID      string           `json:"callback_id"`
+	State           *ViewState       `json:"state,omitempty"`
+	Hash            string           `json:"hash"`
+	ClearOnClose    bool             `json:"clear_on_close"`
+	NotifyOnClose   bool             `json:"notify_on_close"`
+	RootViewID      string           `json:"root_view_id"`
+	PreviousViewID  string           `json:"previous_view_id"`
+	AppID           string           `json:"app_id"`
+	ExternalID      string           `json:"external_id"`
+	BotID           string           `json:"bot_id"`
+}
+
+// ViewState the values of the input blocks of a view, keyed by block id and action id.
+type ViewState struct {
+	Values map[string]map[string]BlockAction `json:"values"`
+}
+
+// ViewResponseAction the response_action of a view_submission response.
+type ViewResponseAction string
+
+// Response actions of a view_submission response.
+const (
+	RAClear  ViewResponseAction = "clear"
+	RAErrors ViewResponseAction = "errors"
+	RAUpdate ViewResponseAction = "update"
+	RAPush   ViewResponseAction = "push"
+)
+
+// ViewSubmissionResponse the response to a view_submission interaction.
+type ViewSubmissionResponse struct {
+	ResponseAction ViewResponseAction `json:"response_action"`
+	// Errors messages keyed by the block id of the input, displayed next to the inputs.
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// NewErrorsViewSubmissionResponse displays the errors next to the inputs of the modal.
+func NewErrorsViewSubmissionResponse(errors map[string]string) *ViewSubmissionResponse {
+	return &ViewSubmissionResponse{
+		ResponseAction: RAErrors,
+		Errors:         errors,
+	}
+}
+
+// NewClearViewSubmissionResponse closes all the views of the modal.
+func NewClearViewSubmissionResponse() *ViewSubmissionResponse {
+	return &ViewSubmissionResponse{
+		ResponseAction: RAClear,
+	}
+}
+
+// ModalViewRequest a modal to open, push or update.
+type ModalViewRequest struct {
+	Type            ViewType         `json:"type"`
+	Title           *TextBlockObject `json:"title"`
+	Blocks          Blocks           `json:"blocks"`
+	Close           *TextBlockObject `json:"close,omitempty"`
+	Submit          *TextBlockObject `json:"submit,omitempty"`
+	PrivateMetadata string           `json:"private_metadata,omitempty"`
+	CallbackID      string           `json:"callback_id,omitempty"`
+	ClearOnClose    bool             `json:"clear_on_close,omitempty"`
+	NotifyOnClose   bool             `json:"notify_on_close,omitempty"`
+	ExternalID      string           `json:"external_id,omitempty"`
+}
+
+// ViewResponse the response of the views methods.
+type ViewResponse struct {
+	SlackResponse
+	View     View             `json:"view"`
+	Metadata ResponseMetadata `json:"response_metadata"`
+}
+
+func (api *Client) viewRequest(ctx context.Context, method string, req interface{}) (*ViewResponse, error) {
+	encoded, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ViewResponse{}
+	if err := postJSON(ctx, api.httpclient, api.endpoint+method, api.token, encoded, response, api); err != nil {
+		return nil, err
+	}
+
+	// the messages describe which blocks of the view are invalid.
+	if len(response.Metadata.Messages) > 0 {
+		response.Error += "\n" + strings.Join(response.Metadata.Messages, "\n")
+	}
+
+	return response, response.Err()
+}
+
+// OpenView opens a modal, see OpenViewContext.
+func (api *Client) OpenView(triggerID string, view ModalViewRequest) (*ViewResponse, error) {
+	return api.OpenViewContext(context.Background(), triggerID, view)
+}
+
+// OpenViewContext opens a modal in response to the interaction which produced the trigger
+// with a custom context.
+func (api *Client) OpenViewContext(ctx context.Context, triggerID string, view ModalViewRequest) (*ViewResponse, error) {
+	if triggerID == "" {
+		return nil, ErrParametersMissing
+	}
+
+	return api.viewRequest(ctx, "views.open", struct {
+		TriggerID string           `json:"trigger_id"`
+		View      ModalViewRequest `json:"view"`
+	}{TriggerID: triggerID, View: view})
+}
+
+// PushView pushes a modal onto the stack of an open modal, see PushViewContext.
+func (api *Client) PushView(triggerID string, view ModalViewRequest) (*ViewResponse, error) {
+	return api.PushViewContext(context.Background(), triggerID, view)
+}
+
+// PushViewContext pushes a modal onto the stack of an open modal with a custom context.
+func (api *Client) PushViewContext(ctx context.Context, triggerID string, view ModalViewRequest) (*ViewResponse, error) {
+	if triggerID == "" {
+		return nil, ErrParametersMissing
+	}
+
+	return api.viewRequest(ctx, "views.push", struct {
+		TriggerID string           `json:"trigger_id"`
+		View      ModalViewRequest `json:"view"`
+	}{TriggerID: triggerID, View: view})
+}
+
+// UpdateView replaces an open modal, see UpdateViewContext.
+func (api *Client) UpdateView(view ModalViewRequest, externalID, hash, viewID string) (*ViewResponse, error) {
+	return api.UpdateViewContext(context.Background(), view, externalID, hash, viewID)
+}
+
+// UpdateViewContext replaces the modal identified by either the external id or the view id
+// with a custom context. the hash of the view being replaced prevents overwriting newer
+// updates, it may be empty.
+func (api *Client) UpdateViewContext(ctx context.Context, view ModalViewRequest, externalID, hash, viewID string) (*ViewResponse, error) {
+	if externalID == "" && viewID == "" {
+		return nil, ErrParametersMissing
+	}
+
+	return api.viewRequest(ctx, "views.update", struct {
+		View       ModalViewRequest `json:"view"`
+		ExternalID string           `json:"external_id,omitempty"`
+		Hash       string           `json:"hash,omitempty"`
+		ViewID     string           `json:"view_id,omitempty"`
+	}{View: view, ExternalID: externalID, Hash: hash, ViewID: viewID})
+}
@@ -0,0 +1,201 @@
+package slack
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ViewValidationErrors messages keyed by the block id of the inputs which failed validation.
+type ViewValidationErrors map[string]string
+
+func (t ViewValidationErrors) Error() string {
+	blocks := make([]string, 0, len(t))
+	for block := range t {
+		blocks = append(blocks, block)
+	}
+	sort.Strings(blocks)
+
+	msgs := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		msgs = append(msgs, block+": "+t[block])
+	}
+
+	return "invalid submission: " + strings.Join(msgs, ", ")
+}
+
+// Response renders the errors as a view_submission response displaying the messages next to
+// the inputs.
+func (t ViewValidationErrors) Response() *ViewSubmissionResponse {
+	return NewErrorsViewSubmissionResponse(t)
+}
+
+// BindViewState copies the values of the view state into the struct pointed to by dst. Fields
+// are mapped with `slack:"block_id.action_id"` tags, a `,required` suffix rejects empty inputs.
+//
+// Supported field types are string (text inputs, selects, date and time pickers), []string
+// (multi selects and checkboxes), integers and floats (number inputs), bool (true when any
+// option is selected) and time.Time (date pickers). Invalid or missing inputs result in
+// ViewValidationErrors, which can be returned to slack with the Response method. Any other
+// error indicates a programming error, e.g. dst is not a pointer to a struct.
+func BindViewState(state *ViewState, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("view state can only be bound to a pointer to a struct, got %T", dst)
+	}
+
+	var values map[string]map[string]BlockAction
+	if state != nil {
+		values = state.Values
+	}
+
+	invalid := ViewValidationErrors{}
+	rv = rv.Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		tag, ok, err := parseViewTag(field)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			continue
+		}
+
+		inputs := values[tag.blockID][tag.actionID].inputs()
+		if len(inputs) == 0 {
+			if tag.required {
+				invalid[tag.blockID] = "This field is required."
+			}
+			continue
+		}
+
+		msg, err := bindInput(rv.Field(i), inputs)
+		if err != nil {
+			return fmt.Errorf("unable to bind %s: %v", field.Name, err)
+		}
+
+		if msg != "" {
+			invalid[tag.blockID] = msg
+		}
+	}
+
+	if len(invalid) > 0 {
+		return invalid
+	}
+
+	return nil
+}
+
+type viewTag struct {
+	blockID  string
+	actionID string
+	required bool
+}
+
+// parseViewTag parses the `slack:"block_id.action_id,required"` tag of the field, the boolean
+// reports whether the field is mapped to an input.
+func parseViewTag(field reflect.StructField) (viewTag, bool, error) {
+	tag, ok := field.Tag.Lookup("slack")
+	if !ok || tag == "-" {
+		return viewTag{}, false, nil
+	}
+
+	path, opts := tag, ""
+	if idx := strings.IndexByte(tag, ','); idx >= 0 {
+		path, opts = tag[:idx], tag[idx+1:]
+	}
+
+	parts := strings.SplitN(path, ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || field.PkgPath != "" {
+		return viewTag{}, false, fmt.Errorf("invalid slack tag on %s: %q", field.Name, tag)
+	}
+
+	return viewTag{blockID: parts[0], actionID: parts[1], required: opts == "required"}, true, nil
+}
+
+// inputs returns the values submitted for the action.
+func (t BlockAction) inputs() []string {
+	switch {
+	case t.Value != "":
+		return []string{t.Value}
+	case t.SelectedOption.Value != "":
+		return []string{t.SelectedOption.Value}
+	case t.SelectedUser != "":
+		return []string{t.SelectedUser}
+	case t.SelectedChannel != "":
+		return []string{t.SelectedChannel}
+	case t.SelectedConversation != "":
+		return []string{t.SelectedConversation}
+	case t.SelectedDate != "":
+		return []string{t.SelectedDate}
+	case t.SelectedTime != "":
+		return []string{t.SelectedTime}
+	case len(t.SelectedOptions) > 0:
+		selected := make([]string, 0, len(t.SelectedOptions))
+		for _, o := range t.SelectedOptions {
+			selected = append(selected, o.Value)
+		}
+		return selected
+	case len(t.SelectedUsers) > 0:
+		return t.SelectedUsers
+	case len(t.SelectedChannels) > 0:
+		return t.SelectedChannels
+	case len(t.SelectedConversations) > 0:
+		return t.SelectedConversations
+	default:
+		return nil
+	}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// bindInput sets the field, returning a validation message when the input cannot be converted
+// or an error when the field type is unsupported.
+func bindInput(field reflect.Value, inputs []string) (string, error) {
+	if field.Type() == timeType {
+		ts, err := time.Parse("2006-01-02", inputs[0])
+		if err != nil {
+			return "Enter a valid date.", nil
+		}
+		field.Set(reflect.ValueOf(ts))
+		return "", nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(inputs[0])
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return "", fmt.Errorf("unsupported type %s", field.Type())
+		}
+		field.Set(reflect.ValueOf(append([]string(nil), inputs...)).Convert(field.Type()))
+	case reflect.Bool:
+		field.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(inputs[0]), 10, field.Type().Bits())
+		if err != nil {
+			return "Enter a whole number.", nil
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(strings.TrimSpace(inputs[0]), 10, field.Type().Bits())
+		if err != nil {
+			return "Enter a positive whole number.", nil
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(strings.TrimSpace(inputs[0]), field.Type().Bits())
+		if err != nil {
+			return "Enter a number.", nil
+		}
+		field.SetFloat(n)
+	default:
+		return "", fmt.Errorf("unsupported type %s", field.Type())
+	}
+
+	return "", nil
+}
@@ -0,0 +1,111 @@
+package slack
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const viewSubmissionCallback = `{
+	"type": "view_submission",
+	"team": {"id": "T1"},
+	"user": {"id": "U1"},
+	"view": {
+		"id": "V1",
+		"type": "modal",
+		"callback_id": "deploy",
+		"state": {
+			"values": {
+				"service": {"name": {"type": "plain_text_input", "value": "api"}},
+				"replicas": {"count": {"type": "plain_text_input", "value": "3"}},
+				"env": {"pick": {"type": "static_select", "selected_option": {"text": {"type": "plain_text", "text": "Production"}, "value": "production"}}},
+				"reviewers": {"users": {"type": "multi_users_select", "selected_users": ["U2", "U3"]}},
+				"options": {"flags": {"type": "checkboxes", "selected_options": [{"text": {"type": "plain_text", "text": "Canary"}, "value": "canary"}]}},
+				"date": {"when": {"type": "datepicker", "selected_date": "2020-01-02"}},
+				"notes": {"text": {"type": "plain_text_input", "value": null}}
+			}
+		}
+	}
+}`
+
+type deployForm struct {
+	Service   string    `slack:"service.name,required"`
+	Replicas  int       `slack:"replicas.count"`
+	Env       string    `slack:"env.pick"`
+	Reviewers []string  `slack:"reviewers.users"`
+	Canary    bool      `slack:"options.flags"`
+	Date      time.Time `slack:"date.when"`
+	Notes     string    `slack:"notes.text"`
+	Ignored   string
+}
+
+func TestBindViewState(t *testing.T) {
+	var cb InteractionCallback
+	if err := json.Unmarshal([]byte(viewSubmissionCallback), &cb); err != nil {
+		t.Fatal(err)
+	}
+
+	if cb.Type != InteractionTypeViewSubmission || cb.View.CallbackID != "deploy" {
+		t.Fatalf("unexpected callback %#v", cb)
+	}
+
+	var form deployForm
+	if err := BindViewState(cb.View.State, &form); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expected := deployForm{
+		Service:   "api",
+		Replicas:  3,
+		Env:       "production",
+		Reviewers: []string{"U2", "U3"},
+		Canary:    true,
+		Date:      time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	if !reflect.DeepEqual(form, expected) {
+		t.Errorf("expected %#v, got %#v", expected, form)
+	}
+}
+
+func TestBindViewStateValidation(t *testing.T) {
+	state := &ViewState{Values: map[string]map[string]BlockAction{
+		"replicas": {"count": {Value: "three"}},
+	}}
+
+	var form deployForm
+	err := BindViewState(state, &form)
+	invalid, ok := err.(ViewValidationErrors)
+	if !ok {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+
+	expected := ViewValidationErrors{
+		"service":  "This field is required.",
+		"replicas": "Enter a whole number.",
+	}
+	if !reflect.DeepEqual(invalid, expected) {
+		t.Errorf("expected %v, got %v", expected, invalid)
+	}
+
+	encoded, _ := json.Marshal(invalid.Response())
+	if string(encoded) != `{"response_action":"errors","errors":{"replicas":"Enter a whole number.","service":"This field is required."}}` {
+		t.Errorf("unexpected response %s", encoded)
+	}
+}
+
+func TestBindViewStateInvalidTarget(t *testing.T) {
+	var form struct {
+		Bad map[string]string `slack:"a.b"`
+	}
+
+	state := &ViewState{Values: map[string]map[string]BlockAction{"a": {"b": {Value: "x"}}}}
+	if _, ok := BindViewState(state, form).(ViewValidationErrors); ok {
+		t.Error("expected a programming error for a non pointer")
+	}
+
+	if err := BindViewState(state, &form); err == nil {
+		t.Error("expected an error for an unsupported type")
+	}
+}
@@ -0,0 +1,252 @@
+package slack
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Element kinds of the `element` tag which are not block element types.
+const (
+	ViewElementMultiline = "multiline"
+)
+
+// NewModalView builds a modal of input blocks from the fields of the struct v, the inverse of
+// BindViewState. Fields are mapped with the same `slack:"block_id.action_id"` tags, fields
+// without the `,required` suffix are optional inputs. the presentation is controlled by the
+// tags:
+//
+//	label:"Service"              the label of the input, defaults to the field name.
+//	placeholder:"api"            placeholder text of the element.
+//	hint:"the service to deploy" hint displayed below the input.
+//	element:"users_select"       the element type, see below.
+//	options:"prod=Production,qa" options of selects and checkboxes, value=text or value.
+//
+// the element type defaults to a datepicker for time.Time, a single checkbox for bool, a
+// static select for strings with options, a multi static select for []string with options and
+// a plain text input otherwise. multiline, plain_text_input, datepicker, checkboxes and the
+// select and multi select types may be set explicitly.
+//
+// The values of the fields are used as the initial values of the inputs, allowing an existing
+// record to be edited. v may be a struct or a pointer to a struct.
+func NewModalView(callbackID, title string, v interface{}) (ModalViewRequest, error) {
+	view := ModalViewRequest{
+		Type:       VTModal,
+		CallbackID: callbackID,
+		Title:      PlainText(title, false),
+		Submit:     PlainText("Submit", false),
+		Close:      PlainText("Cancel", false),
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return view, fmt.Errorf("modal views can only be built from a struct, got %T", v)
+	}
+
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		tag, ok, err := parseViewTag(field)
+		if err != nil {
+			return view, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		label := field.Tag.Get("label")
+		if label == "" {
+			label = field.Name
+		}
+
+		element, err := viewElement(field, rv.Field(i), tag.actionID, label)
+		if err != nil {
+			return view, fmt.Errorf("unable to build the input for %s: %v", field.Name, err)
+		}
+
+		options := []InputBlockOption{InputBlockOptionOptional(!tag.required)}
+		if hint := field.Tag.Get("hint"); hint != "" {
+			options = append(options, InputBlockOptionHint(PlainText(hint, false)))
+		}
+
+		view.Blocks.BlockSet = append(view.Blocks.BlockSet, NewInputBlock(tag.blockID, PlainText(label, false), element, options...))
+	}
+
+	return view, nil
+}
+
+// parseViewOptions parses the options tag, a comma separated list of value=text or value.
+func parseViewOptions(tag string) []*OptionBlockObject {
+	if tag == "" {
+		return nil
+	}
+
+	options := []*OptionBlockObject{}
+	for _, o := range strings.Split(tag, ",") {
+		value, text := o, o
+		if idx := strings.IndexByte(o, '='); idx >= 0 {
+			value, text = o[:idx], o[idx+1:]
+		}
+		options = append(options, NewOptionBlockObject(value, PlainText(text, false)))
+	}
+
+	return options
+}
+
+// selectedOptions returns the options with the values.
+func selectedOptions(options []*OptionBlockObject, values ...string) []*OptionBlockObject {
+	selected := []*OptionBlockObject{}
+	for _, o := range options {
+		for _, v := range values {
+			if o.Value == v {
+				selected = append(selected, o)
+				break
+			}
+		}
+	}
+
+	return selected
+}
+
+// stringValues returns the non empty string values of a string or []string field.
+func stringValues(value reflect.Value) []string {
+	switch {
+	case value.Kind() == reflect.String && value.String() != "":
+		return []string{value.String()}
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.String:
+		values := make([]string, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			values = append(values, value.Index(i).String())
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// initialText formats the value of a field for a text input, zero values are left empty.
+func initialText(value reflect.Value) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, value.Type().Bits())
+	default:
+		return ""
+	}
+}
+
+func viewElement(field reflect.StructField, value reflect.Value, actionID, label string) (BlockElement, error) {
+	var placeholder *TextBlockObject
+	if text := field.Tag.Get("placeholder"); text != "" {
+		placeholder = PlainText(text, false)
+	}
+
+	options := parseViewOptions(field.Tag.Get("options"))
+	kind := field.Tag.Get("element")
+
+	if kind == "" {
+		switch {
+		case field.Type == timeType:
+			kind = string(METDatepicker)
+		case field.Type.Kind() == reflect.Bool:
+			kind = string(METCheckboxGroups)
+		case field.Type.Kind() == reflect.String && len(options) > 0:
+			kind = OptTypeStatic
+		case field.Type.Kind() == reflect.Slice && len(options) > 0:
+			kind = MultiOptTypeStatic
+		case field.Type.Kind() == reflect.Slice:
+			return nil, fmt.Errorf("%s requires options or an element tag", field.Type)
+		default:
+			kind = string(METPlainTextInput)
+		}
+	}
+
+	switch kind {
+	case string(METPlainTextInput), ViewElementMultiline:
+		element := NewPlainTextInputBlockElement(placeholder, actionID)
+		element.Multiline = kind == ViewElementMultiline
+		element.InitialValue = initialText(value)
+		return element, nil
+	case string(METDatepicker):
+		element := NewDatePickerBlockElement(actionID)
+		element.Placeholder = placeholder
+		if ts, ok := value.Interface().(time.Time); ok && !ts.IsZero() {
+			element.InitialDate = ts.Format("2006-01-02")
+		} else if value.Kind() == reflect.String {
+			element.InitialDate = value.String()
+		}
+		return element, nil
+	case string(METCheckboxGroups):
+		if value.Kind() == reflect.Bool {
+			option := NewOptionBlockObject("true", PlainText(label, false))
+			element := NewCheckboxGroupsBlockElement(actionID, option)
+			if value.Bool() {
+				element.InitialOptions = []*OptionBlockObject{option}
+			}
+			return element, nil
+		}
+
+		element := NewCheckboxGroupsBlockElement(actionID, options...)
+		if selected := selectedOptions(options, stringValues(value)...); len(selected) > 0 {
+			element.InitialOptions = selected
+		}
+		return element, nil
+	case OptTypeStatic, OptTypeExternal, OptTypeUser, OptTypeConversations, OptTypeChannels:
+		element := NewOptionsSelectBlockElement(kind, placeholder, actionID, options...)
+		initial := stringValues(value)
+		if len(initial) == 0 {
+			return element, nil
+		}
+
+		switch kind {
+		case OptTypeStatic:
+			if selected := selectedOptions(options, initial[0]); len(selected) > 0 {
+				element.InitialOption = selected[0]
+			}
+		case OptTypeUser:
+			element.InitialUser = initial[0]
+		case OptTypeConversations:
+			element.InitialConversation = initial[0]
+		case OptTypeChannels:
+			element.InitialChannel = initial[0]
+		}
+		return element, nil
+	case MultiOptTypeStatic, MultiOptTypeExternal, MultiOptTypeUser, MultiOptTypeConversations, MultiOptTypeChannels:
+		element := NewOptionsMultiSelectBlockElement(kind, placeholder, actionID, options...)
+		initial := stringValues(value)
+		if len(initial) == 0 {
+			return element, nil
+		}
+
+		switch kind {
+		case MultiOptTypeStatic:
+			if selected := selectedOptions(options, initial...); len(selected) > 0 {
+				element.InitialOptions = selected
+			}
+		case MultiOptTypeUser:
+			element.InitialUsers = initial
+		case MultiOptTypeConversations:
+			element.InitialConversations = initial
+		case MultiOptTypeChannels:
+			element.InitialChannels = initial
+		}
+		return element, nil
+	default:
+		return nil, fmt.Errorf("unsupported element %s", kind)
+	}
+}
@@ -0,0 +1,197 @@
+package slack
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type ticketForm struct {
+	Title    string    `slack:"title.value,required" label:"Title" placeholder:"Short summary"`
+	Details  string    `slack:"details.value" element:"multiline" hint:"Markdown is supported"`
+	Priority string    `slack:"priority.value" options:"p1=Urgent,p2=High,p3"`
+	Assignee string    `slack:"assignee.value" element:"users_select"`
+	Labels   []string  `slack:"labels.value" options:"bug,feature"`
+	Estimate int       `slack:"estimate.value"`
+	Due      time.Time `slack:"due.value"`
+	Private  bool      `slack:"private.value" label:"Private ticket"`
+	internal string
+}
+
+func TestNewModalView(t *testing.T) {
+	view, err := NewModalView("ticket", "New ticket", ticketForm{
+		Priority: "p2",
+		Assignee: "U1",
+		Labels:   []string{"bug"},
+		Due:      time.Date(2020, 3, 4, 0, 0, 0, 0, time.UTC),
+		Private:  true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(view.Blocks.BlockSet) != 8 {
+		t.Fatalf("expected 8 blocks, got %d", len(view.Blocks.BlockSet))
+	}
+
+	title := view.Blocks.BlockSet[0].(*InputBlock)
+	if title.Optional || title.BlockID != "title" || title.Label.Text != "Title" {
+		t.Errorf("unexpected title block %#v", title)
+	}
+
+	if e := title.Element.(*PlainTextInputBlockElement); e.ActionID != "value" || e.Placeholder.Text != "Short summary" || e.InitialValue != "" {
+		t.Errorf("unexpected title element %#v", e)
+	}
+
+	details := view.Blocks.BlockSet[1].(*InputBlock)
+	if !details.Optional || details.Label.Text != "Details" || details.Hint.Text != "Markdown is supported" || !details.Element.(*PlainTextInputBlockElement).Multiline {
+		t.Errorf("unexpected details block %#v", details)
+	}
+
+	priority := view.Blocks.BlockSet[2].(*InputBlock).Element.(*SelectBlockElement)
+	if priority.Type != OptTypeStatic || len(priority.Options) != 3 || priority.InitialOption.Value != "p2" || priority.Options[2].Text.Text != "p3" {
+		t.Errorf("unexpected priority element %#v", priority)
+	}
+
+	if assignee := view.Blocks.BlockSet[3].(*InputBlock).Element.(*SelectBlockElement); assignee.Type != OptTypeUser || assignee.InitialUser != "U1" {
+		t.Errorf("unexpected assignee element %#v", assignee)
+	}
+
+	if labels := view.Blocks.BlockSet[4].(*InputBlock).Element.(*MultiSelectBlockElement); labels.Type != MultiOptTypeStatic || len(labels.InitialOptions) != 1 {
+		t.Errorf("unexpected labels element %#v", labels)
+	}
+
+	if due := view.Blocks.BlockSet[6].(*InputBlock).Element.(*DatePickerBlockElement); due.InitialDate != "2020-03-04" {
+		t.Errorf("unexpected due element %#v", due)
+	}
+
+	if private := view.Blocks.BlockSet[7].(*InputBlock).Element.(*CheckboxGroupsBlockElement); len(private.InitialOptions) != 1 || private.Options[0].Text.Text != "Private ticket" {
+		t.Errorf("unexpected private element %#v", private)
+	}
+
+	encoded, err := json.Marshal(view)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded ModalViewRequest
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if e := decoded.Blocks.BlockSet[3].(*InputBlock).Element.(*SelectBlockElement); e.InitialUser != "U1" {
+		t.Errorf("unexpected decoded element %#v", e)
+	}
+}
+
+func TestNewModalViewErrors(t *testing.T) {
+	if _, err := NewModalView("x", "x", "not a struct"); err == nil {
+		t.Error("expected an error for a non struct")
+	}
+
+	var unsupported struct {
+		Tags []string `slack:"tags.value"`
+	}
+	if _, err := NewModalView("x", "x", &unsupported); err == nil {
+		t.Error("expected an error for a slice without options")
+	}
+}
+
+func TestOpenView(t *testing.T) {
+	http.HandleFunc("/views/views.open", func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var req struct {
+			TriggerID string           `json:"trigger_id"`
+			View      ModalViewRequest `json:"view"`
+		}
+		if err := json.Unmarshal(body, &req); err != nil || req.TriggerID != "trigger" || req.View.CallbackID != "ticket" {
+			t.Errorf("unexpected request %s %v", body, err)
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"view":{"id":"V1","type":"modal","callback_id":"ticket","hash":"h1"}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/views/"))
+
+	view, err := NewModalView("ticket", "New ticket", ticketForm{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := api.OpenView("trigger", view)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if resp.View.ID != "V1" || resp.View.Hash != "h1" {
+		t.Errorf("unexpected view %#v", resp.View)
+	}
+
+	if _, err := api.OpenView("", view); err != ErrParametersMissing {
+		t.Errorf("expected ErrParametersMissing, got %v", err)
+	}
+}
+
+func TestPushAndUpdateView(t *testing.T) {
+	http.HandleFunc("/viewsmodify/views.push", func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var req struct {
+			TriggerID string           `json:"trigger_id"`
+			View      ModalViewRequest `json:"view"`
+		}
+		if err := json.Unmarshal(body, &req); err != nil || req.TriggerID != "trigger" || req.View.CallbackID != "ticket" {
+			t.Errorf("unexpected request %s %v", body, err)
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":true,"view":{"id":"V2","type":"modal","callback_id":"ticket","previous_view_id":"V1"}}`))
+	})
+	http.HandleFunc("/viewsmodify/views.update", func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var req map[string]interface{}
+		if err := json.Unmarshal(body, &req); err != nil || req["view_id"] != "V1" || req["hash"] != "h1" {
+			t.Errorf("unexpected request %s %v", body, err)
+		}
+		if _, ok := req["external_id"]; ok {
+			t.Errorf("unexpected external_id %s", body)
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok":false,"error":"invalid_arguments","response_metadata":{"messages":["[ERROR] missing required field: title"]}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/viewsmodify/"))
+
+	view, err := NewModalView("ticket", "New ticket", ticketForm{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := api.PushView("trigger", view)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if resp.View.ID != "V2" || resp.View.PreviousViewID != "V1" {
+		t.Errorf("unexpected view %#v", resp.View)
+	}
+
+	if _, err := api.PushView("", view); err != ErrParametersMissing {
+		t.Errorf("expected ErrParametersMissing, got %v", err)
+	}
+
+	resp, err = api.UpdateView(view, "", "h1", "V1")
+	if err == nil || err.Error() != "invalid_arguments\n[ERROR] missing required field: title" {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if resp == nil || len(resp.Metadata.Messages) != 1 {
+		t.Errorf("unexpected response %#v", resp)
+	}
+
+	if _, err := api.UpdateView(view, "", "", ""); err != ErrParametersMissing {
+		t.Errorf("expected ErrParametersMissing, got %v", err)
+	}
+}
@@ -1,7 +1,9 @@
 package slack
 
 import (
+	"crypto/tls"
 	"encoding/json"
+	"net/http"
 	"net/url"
 	"sync"
 	"time"
@@ -26,19 +28,24 @@ type RTM struct {
 	idGen        IDGenerator
 	pingInterval time.Duration
 	pingDeadman  *time.Timer
+	// pongDeadline the duration without a pong before the connection is
+	// considered dead, defaults to deadmanDuration of the ping interval.
+	pongDeadline time.Duration
 
-	// Connection life-cycle
-	conn             *websocket.Conn
+	// Connection life-cycle, the websocket connection is owned by
+	// ManageConnection and passed to the goroutines using it.
 	IncomingEvents   chan RTMEvent
 	outgoingMessages chan OutgoingMessage
 	killChannel      chan bool
 	disconnected     chan struct{}
 	disconnectedm    *sync.Once
+	managed          *sync.Once
 	forcePing        chan bool
 	rawEvents        chan json.RawMessage
 
-	// UserDetails upon connection
-	info *Info
+	// state the connection payload and joined channels, kept up to date
+	// from the received events.
+	state *rtmState
 
 	// useRTMStart should be set to true if you want to use
 	// rtm.start to connect to Slack, otherwise it will use
@@ -49,11 +56,59 @@ type RTM struct {
 	// Dialer.
 	dialer *websocket.Dialer
 
+	// proxy and tlsConfig override the proxy and tls configuration of the
+	// dialer when set.
+	proxy     func(*http.Request) (*url.URL, error)
+	tlsConfig *tls.Config
+
 	// mu is mutex used to prevent RTM connection race conditions
 	mu *sync.Mutex
 
+	// readers tracks the goroutines reading from the websocket.
+	readers *sync.WaitGroup
+
 	// connParams is a map of flags for connection parameters.
 	connParams url.Values
+
+	// messages records the observed messages when set.
+	messages MessageStore
+
+	// topics tracks the topic and purpose of conversations.
+	topics *TopicTracker
+
+	// users caches the users observed by user_change events when set.
+	users *UserCache
+
+	// presence tracks the availability of users when set.
+	presence *PresenceTracker
+
+	// subscriptions the users whose presence is subscribed to, the presence
+	// tracker when set.
+	subscriptions *PresenceTracker
+	// presenceSub signals the connection to send the presence subscription.
+	presenceSub chan struct{}
+
+	// handlers the handlers called by Dispatch, see On.
+	handlers *rtmHandlers
+
+	// reconnect the backoff between connection attempts.
+	reconnect backoff
+
+	// events decodes events of additional types, see RTMOptionEvents.
+	events map[string]interface{}
+
+	// rawUnknown delivers unknown events as a RawEvent.
+	rawUnknown bool
+
+	// ackTimeout the duration to wait for the acknowledgement of a message,
+	// pendingAcks the deadlines of the unacknowledged messages by id. only
+	// accessed by the goroutine handling the events.
+	ackTimeout  time.Duration
+	pendingAcks map[int]time.Time
+
+	// outgoingLimit limits the rate of outgoing messages, only accessed by
+	// the goroutine handling the events.
+	outgoingLimit tokenBucket
 }
 
 // signal that we are disconnected by closing the channel.
@@ -77,11 +132,12 @@ func (rtm *RTM) Disconnect() error {
 	}
 }
 
-// GetInfo returns the info structure received when calling
-// "startrtm", holding metadata needed to implement a full
-// chat client. It will be non-nil after a call to StartRTM().
+// GetInfo returns a copy of the info structure received when connecting,
+// holding metadata needed to implement a full chat client. It will be
+// non-nil once the RTM has connected and is safe to call concurrently,
+// see Self, Team and Channels.
 func (rtm *RTM) GetInfo() *Info {
-	return rtm.info
+	return rtm.state.snapshot()
 }
 
 // SendMessage submits a simple message through the websocket.  For
@@ -96,7 +152,27 @@ func (rtm *RTM) SendMessage(msg *OutgoingMessage) {
 	rtm.outgoingMessages <- *msg
 }
 
+// SendTyping indicates the bot is typing in the channel, e.g. while computing a response.
+// the indicator is shown until a message is sent to the channel or a few seconds elapse,
+// call it periodically to keep the indicator visible.
+func (rtm *RTM) SendTyping(channelID string) {
+	rtm.SendMessage(rtm.NewTypingMessage(channelID))
+}
+
 func (rtm *RTM) resetDeadman() {
+	// drain the timer when it elapsed without being observed.
+	if !rtm.pingDeadman.Stop() {
+		select {
+		case <-rtm.pingDeadman.C:
+		default:
+		}
+	}
+
+	if rtm.pongDeadline > 0 {
+		rtm.pingDeadman.Reset(rtm.pongDeadline)
+		return
+	}
+
 	rtm.pingDeadman.Reset(deadmanDuration(rtm.pingInterval))
 }
 
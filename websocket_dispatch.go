@@ -0,0 +1,92 @@
+package slack
+
+import (
+	"context"
+	"sync"
+)
+
+// RTMEventTypeAll registers a handler for every event received by the RTM, see On.
+const RTMEventTypeAll = "*"
+
+// RTMHandlerFunc handles an event received by the RTM, the Data of the event is one
+// of the event structs e.g. *MessageEvent for message events.
+type RTMHandlerFunc func(RTMEvent)
+
+// rtmHandlers the handlers registered by event type, safe for concurrent use.
+type rtmHandlers struct {
+	m        sync.RWMutex
+	handlers map[string][]RTMHandlerFunc
+}
+
+func newRTMHandlers() *rtmHandlers {
+	return &rtmHandlers{handlers: make(map[string][]RTMHandlerFunc)}
+}
+
+func (t *rtmHandlers) add(eventType string, fn RTMHandlerFunc) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.handlers[eventType] = append(t.handlers[eventType], fn)
+}
+
+func (t *rtmHandlers) remove(eventType string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	delete(t.handlers, eventType)
+}
+
+// lookup the handlers of the event type followed by the handlers of every event.
+func (t *rtmHandlers) lookup(eventType string) []RTMHandlerFunc {
+	t.m.RLock()
+	defer t.m.RUnlock()
+
+	matched := make([]RTMHandlerFunc, 0, len(t.handlers[eventType])+len(t.handlers[RTMEventTypeAll]))
+	matched = append(matched, t.handlers[eventType]...)
+	return append(matched, t.handlers[RTMEventTypeAll]...)
+}
+
+// On registers the handler for events of the type, e.g. "message" or "reaction_added", as an
+// alternative to reading IncomingEvents and switching on the type of every event. connection
+// events are delivered with their RTMEvent type, e.g. "connected" or "disconnected". use
+// RTMEventTypeAll to handle every event. multiple handlers for a type are called in the order
+// they were registered. handlers are only called by Dispatch.
+func (rtm *RTM) On(eventType string, fn RTMHandlerFunc) {
+	rtm.handlers.add(eventType, fn)
+}
+
+// RemoveHandlers removes every handler registered for the event type.
+func (rtm *RTM) RemoveHandlers(eventType string) {
+	rtm.handlers.remove(eventType)
+}
+
+// Dispatch reads IncomingEvents and calls the handlers registered for every event until
+// IncomingEvents is closed by ManageConnectionContext. events without a handler are dropped.
+// Once the context is done the remaining events are drained without calling the handlers, the
+// connection is never blocked delivering events to a stopped Dispatch. Dispatch consumes
+// IncomingEvents, it should not be read elsewhere.
+//
+// Example:
+//
+//	rtm.On("message", func(e slack.RTMEvent) {
+//		msg := e.Data.(*slack.MessageEvent)
+//		rtm.SendMessage(rtm.NewOutgoingMessage("hello", msg.Channel))
+//	})
+//	go rtm.ManageConnectionContext(ctx)
+//	rtm.Dispatch(ctx)
+func (rtm *RTM) Dispatch(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			for range rtm.IncomingEvents {
+			}
+			return
+		case event, ok := <-rtm.IncomingEvents:
+			if !ok {
+				return
+			}
+
+			for _, fn := range rtm.handlers.lookup(event.Type) {
+				fn(event)
+			}
+		}
+	}
+}
@@ -6,3 +6,10 @@ type DNDUpdatedEvent struct {
 	User   string    `json:"user"`
 	Status DNDStatus `json:"dnd_status"`
 }
+
+// DNDUpdatedUserEvent represents the update event for Do Not Disturb of another member.
+// dnd_updated_user events are decoded as a DNDUpdatedEvent by default, distinguishing
+// them requires opting in:
+//
+//	rtm := api.NewRTM(slack.RTMOptionEvents(map[string]interface{}{"dnd_updated_user": slack.DNDUpdatedUserEvent{}}))
+type DNDUpdatedUserEvent DNDUpdatedEvent
@@ -0,0 +1,203 @@
+package slack
+
+import "sync"
+
+// rtmState the connection payload and the channels joined by the connected user, kept up
+// to date from the events received by the RTM. other users are not tracked, see
+// RTMOptionUserCache. safe for concurrent use.
+type rtmState struct {
+	m        sync.RWMutex
+	info     *Info
+	channels map[string]Channel
+}
+
+func newRTMState() *rtmState {
+	return &rtmState{channels: make(map[string]Channel)}
+}
+
+// connected replaces the connection payload, joined channels are retained across reconnects.
+func (t *rtmState) connected(info *Info) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.info = info.copy()
+}
+
+// snapshot returns a copy of the connection payload, nil before the first connection.
+func (t *rtmState) snapshot() *Info {
+	t.m.RLock()
+	defer t.m.RUnlock()
+	return t.info.copy()
+}
+
+// selfID the id of the connected user, empty before the first connection.
+func (t *rtmState) selfID() string {
+	t.m.RLock()
+	defer t.m.RUnlock()
+
+	if t.info == nil || t.info.User == nil {
+		return ""
+	}
+
+	return t.info.User.ID
+}
+
+func (t *rtmState) updateSelf(fn func(*UserDetails)) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.info != nil && t.info.User != nil {
+		fn(t.info.User)
+	}
+}
+
+func (t *rtmState) updateTeam(fn func(*Team)) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.info != nil && t.info.Team != nil {
+		fn(t.info.Team)
+	}
+}
+
+func (t *rtmState) joined(ch Channel) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.channels[ch.ID] = ch.copy()
+}
+
+func (t *rtmState) left(channelID string) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	delete(t.channels, channelID)
+}
+
+func (t *rtmState) updateChannel(channelID string, fn func(*Channel)) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if ch, ok := t.channels[channelID]; ok {
+		fn(&ch)
+		t.channels[channelID] = ch
+	}
+}
+
+// handle updates the state from the event.
+func (t *rtmState) handle(event interface{}) {
+	switch ev := event.(type) {
+	case *UserChangeEvent:
+		if ev.User.ID != "" && ev.User.ID == t.selfID() {
+			t.updateSelf(func(u *UserDetails) { u.Name = ev.User.Name })
+		}
+	case *ManualPresenceChangeEvent:
+		t.updateSelf(func(u *UserDetails) { u.ManualPresence = ev.Presence })
+	case *TeamRenameEvent:
+		t.updateTeam(func(team *Team) { team.Name = ev.Name })
+	case *TeamDomainChangeEvent:
+		t.updateTeam(func(team *Team) { team.Domain = ev.Domain })
+	case *ChannelJoinedEvent:
+		t.joined(ev.Channel)
+	case *GroupJoinedEvent:
+		t.joined(ev.Channel)
+	case *ChannelLeftEvent:
+		t.left(ev.Channel)
+	case *ChannelDeletedEvent:
+		t.left(ev.Channel)
+	case *GroupLeftEvent:
+		t.left(ev.Channel)
+	case *ChannelRenameEvent:
+		t.updateChannel(ev.Channel.ID, func(ch *Channel) { ch.Name = ev.Channel.Name })
+	case *GroupRenameEvent:
+		t.updateChannel(ev.Group.ID, func(ch *Channel) { ch.Name = ev.Group.Name })
+	case *ChannelArchiveEvent:
+		t.updateChannel(ev.Channel, func(ch *Channel) { ch.IsArchived = true })
+	case *GroupArchiveEvent:
+		t.updateChannel(ev.Channel, func(ch *Channel) { ch.IsArchived = true })
+	case *ChannelUnarchiveEvent:
+		t.updateChannel(ev.Channel, func(ch *Channel) { ch.IsArchived = false })
+	case *GroupUnarchiveEvent:
+		t.updateChannel(ev.Channel, func(ch *Channel) { ch.IsArchived = false })
+	case *ChannelTopicChangedEvent:
+		t.updateChannel(ev.Channel, func(ch *Channel) { ch.Topic.Value = ev.Current })
+	case *ChannelPurposeChangedEvent:
+		t.updateChannel(ev.Channel, func(ch *Channel) { ch.Purpose.Value = ev.Current })
+	}
+}
+
+// copy the info, including the user and team.
+func (info *Info) copy() *Info {
+	if info == nil {
+		return nil
+	}
+
+	dup := *info
+	if info.User != nil {
+		u := *info.User
+		dup.User = &u
+	}
+
+	if info.Team != nil {
+		team := *info.Team
+		dup.Team = &team
+	}
+
+	return &dup
+}
+
+// copy the channel, the slices are not shared with the original.
+func (ch Channel) copy() Channel {
+	dup := ch
+	dup.Members = append([]string(nil), ch.Members...)
+	dup.SharedTeamIDs = append([]string(nil), ch.SharedTeamIDs...)
+	if ch.Latest != nil {
+		latest := *ch.Latest
+		dup.Latest = &latest
+	}
+
+	return dup
+}
+
+// Self returns the connected user, the boolean reports whether the RTM has connected. the
+// name and manual presence are updated by user_change and manual_presence_change events.
+func (rtm *RTM) Self() (UserDetails, bool) {
+	info := rtm.state.snapshot()
+	if info == nil || info.User == nil {
+		return UserDetails{}, false
+	}
+
+	return *info.User, true
+}
+
+// Team returns the workspace of the connection, the boolean reports whether the RTM has
+// connected. the name and domain are updated by team_rename and team_domain_change events.
+func (rtm *RTM) Team() (Team, bool) {
+	info := rtm.state.snapshot()
+	if info == nil || info.Team == nil {
+		return Team{}, false
+	}
+
+	return *info.Team, true
+}
+
+// Channel returns the channel joined by the connected user while the RTM was connected, kept
+// up to date from the channel and group events. the boolean reports whether the channel is known.
+// the members are those of the channel when it was joined, membership changes are not tracked.
+func (rtm *RTM) Channel(channelID string) (Channel, bool) {
+	rtm.state.m.RLock()
+	defer rtm.state.m.RUnlock()
+
+	ch, ok := rtm.state.channels[channelID]
+	return ch.copy(), ok
+}
+
+// Channels returns the channels joined by the connected user while the RTM was connected.
+func (rtm *RTM) Channels() []Channel {
+	rtm.state.m.RLock()
+	defer rtm.state.m.RUnlock()
+
+	channels := make([]Channel, 0, len(rtm.state.channels))
+	for _, ch := range rtm.state.channels {
+		channels = append(channels, ch.copy())
+	}
+
+	return channels
+}
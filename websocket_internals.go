@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -55,6 +56,18 @@ func (u UnmarshallingErrorEvent) Error() string {
 	return u.ErrorObj.Error()
 }
 
+// RawEvent an event of a type unknown to the library, see RTMOptionRawEvents. Data is the
+// original json of the event.
+type RawEvent struct {
+	Type string
+	Data json.RawMessage
+}
+
+// Decode the event into v.
+func (t RawEvent) Decode(v interface{}) error {
+	return json.Unmarshal(t.Data, v)
+}
+
 // MessageTooLongEvent is used when sending a message that is too long
 type MessageTooLongEvent struct {
 	Message   OutgoingMessage
@@ -91,9 +104,12 @@ func (i *IncomingEventError) Error() string {
 	return i.ErrorObj.Error()
 }
 
-// AckErrorEvent i
+// AckErrorEvent the message sent over the websocket was rejected, or was not acknowledged
+// in time (ErrAckTimeout, see RTMOptionAckTimeout).
 type AckErrorEvent struct {
 	ErrorObj error
+	// ReplyTo the id of the rejected message.
+	ReplyTo int
 }
 
 func (a *AckErrorEvent) Error() string {
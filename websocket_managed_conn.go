@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -44,12 +45,7 @@ func (rtm *RTM) ManageConnection() {
 			return
 		}
 
-		// lock to prevent data races with Disconnect particularly around isConnected
-		// and conn.
-		rtm.mu.Lock()
-		rtm.conn = conn
-		rtm.info = info
-		rtm.mu.Unlock()
+		rtm.state.connected(info)
 
 		rtm.IncomingEvents <- RTMEvent{"connected", &ConnectedEvent{
 			ConnectionCount: connectionCount,
@@ -58,17 +54,32 @@ func (rtm *RTM) ManageConnection() {
 
 		rtm.Debugf("RTM connection succeeded on try %d", connectionCount)
 
-		// we're now connected so we can set up listeners
-		go rtm.handleIncomingEvents()
+		// the deadline of a previous connection may have elapsed.
+		rtm.resetDeadman()
+
+		// we're now connected so we can set up listeners, done signals the
+		// reader the connection has been replaced so it stops signaling the
+		// next connection.
+		done := make(chan struct{})
+		rtm.readers.Add(1)
+		go func() {
+			defer rtm.readers.Done()
+			rtm.handleIncomingEvents(conn, done)
+		}()
+
+		if len(rtm.subscriptions.Users()) > 0 {
+			rtm.resubscribePresence()
+		}
 
 		// this should be a blocking call until the connection has ended
-		rtm.handleEvents()
+		rtm.handleEvents(conn)
+		close(done)
 
 		select {
 		case <-rtm.disconnected:
 			// after handle events returns we need to check if we're disconnected
 			// when this happens we need to cleanup the newly created connection.
-			rtm.killConnection(true, ErrRTMDisconnected)
+			rtm.killConnection(conn, true, ErrRTMDisconnected)
 			return
 		default:
 			// otherwise continue and run the loop again to reconnect
@@ -76,6 +87,36 @@ func (rtm *RTM) ManageConnection() {
 	}
 }
 
+// ManageConnectionContext manages the connection like ManageConnection until the
+// context is cancelled or the RTM is disconnected. Once the connection is closed and
+// the goroutines reading from the websocket have exited the IncomingEvents channel is
+// closed, consumers should read from IncomingEvents until it is closed.
+//
+// An RTM can only be managed once, subsequent calls to ManageConnectionContext return
+// once the first has finished and ManageConnection must not be called afterwards.
+func (rtm *RTM) ManageConnectionContext(ctx context.Context) {
+	rtm.managed.Do(func() {
+		stopped := make(chan struct{})
+		watched := make(chan struct{})
+		go func() {
+			defer close(watched)
+			select {
+			case <-ctx.Done():
+				rtm.Disconnect()
+			case <-stopped:
+			}
+		}()
+
+		rtm.ManageConnection()
+		close(stopped)
+
+		// Disconnect returns immediately once ManageConnection has returned.
+		<-watched
+		rtm.readers.Wait()
+		close(rtm.IncomingEvents)
+	})
+}
+
 // connect attempts to connect to the slack websocket API. It handles any
 // errors that occur while connecting and will return once a connection
 // has been successfully opened.
@@ -90,9 +131,7 @@ func (rtm *RTM) connect(connectionCount int, useRTMStart bool) (*Info, *websocke
 
 	// used to provide exponential backoff wait time with jitter before trying
 	// to connect to slack again
-	boff := &backoff{
-		Max: 5 * time.Minute,
-	}
+	boff := rtm.reconnect
 
 	for {
 		var (
@@ -151,7 +190,7 @@ func (rtm *RTM) connect(connectionCount int, useRTMStart bool) (*Info, *websocke
 		case <-time.After(backoff): // retry after the backoff.
 		case intentional := <-rtm.killChannel:
 			if intentional {
-				rtm.killConnection(intentional, ErrRTMDisconnected)
+				rtm.killConnection(nil, intentional, ErrRTMDisconnected)
 				return nil, nil, ErrRTMDisconnected
 			}
 		case <-rtm.disconnected:
@@ -192,10 +231,13 @@ func (rtm *RTM) startRTMAndDial(useRTMStart bool) (info *Info, _ *websocket.Conn
 	// Only use HTTPS for connections to prevent MITM attacks on the connection.
 	upgradeHeader := http.Header{}
 	upgradeHeader.Add("Origin", "https://api.slack.com")
+	applyHeaders(upgradeHeader, rtm.headers)
 	dialer := websocket.DefaultDialer
 	if rtm.dialer != nil {
 		dialer = rtm.dialer
 	}
+	dialer = configureWebsocket(dialer, rtm.proxy, rtm.tlsConfig)
+	dialer = dialWebsocket(dialer, rtm.dial)
 	conn, _, err := dialer.Dial(url, upgradeHeader)
 	if err != nil {
 		rtm.Debugf("Failed to dial to the websocket: %s", err)
@@ -209,11 +251,11 @@ func (rtm *RTM) startRTMAndDial(useRTMStart bool) (info *Info, _ *websocket.Conn
 //
 // This should not be called directly! Instead a boolean value (true for
 // intentional, false otherwise) should be sent to the killChannel on the RTM.
-func (rtm *RTM) killConnection(intentional bool, cause error) (err error) {
+func (rtm *RTM) killConnection(conn *websocket.Conn, intentional bool, cause error) (err error) {
 	rtm.Debugln("killing connection")
 
-	if rtm.conn != nil {
-		err = rtm.conn.Close()
+	if conn != nil {
+		err = conn.Close()
 	}
 
 	rtm.IncomingEvents <- RTMEvent{"disconnected", &DisconnectedEvent{Intentional: intentional, Cause: cause}}
@@ -230,38 +272,65 @@ func (rtm *RTM) killConnection(intentional bool, cause error) (err error) {
 // interval. This also sends outgoing messages that are received from the RTM's
 // outgoingMessages channel. This also handles incoming raw events from the RTM
 // rawEvents channel.
-func (rtm *RTM) handleEvents() {
+func (rtm *RTM) handleEvents(conn *websocket.Conn) {
 	ticker := time.NewTicker(rtm.pingInterval)
 	defer ticker.Stop()
+
+	var acks <-chan time.Time
+	if rtm.ackTimeout > 0 {
+		// tickers require a positive interval, timeouts of a nanosecond are checked every millisecond.
+		ackTicker := time.NewTicker(timex.Max(rtm.ackTimeout/2, time.Millisecond))
+		defer ackTicker.Stop()
+		acks = ackTicker.C
+	}
+
+	// outgoing is nil while the outgoing messages are rate limited, limited
+	// fires once the next message can be sent.
+	outgoing := rtm.outgoingMessages
+	var limited <-chan time.Time
+
 	for {
 		select {
 		// catch "stop" signal on channel close
 		case intentional := <-rtm.killChannel:
-			_ = rtm.killConnection(intentional, errorsx.String("signaled"))
+			_ = rtm.killConnection(conn, intentional, errorsx.String("signaled"))
 			return
 		// detect when the connection is dead.
 		case <-rtm.pingDeadman.C:
-			_ = rtm.killConnection(false, errorsx.String("deadman switch triggered"))
+			_ = rtm.killConnection(conn, false, errorsx.String("deadman switch triggered"))
 			return
 		// send pings on ticker interval
 		case <-ticker.C:
-			if err := rtm.ping(); err != nil {
-				_ = rtm.killConnection(false, err)
+			if err := rtm.ping(conn); err != nil {
+				_ = rtm.killConnection(conn, false, err)
 				return
 			}
 		case <-rtm.forcePing:
-			if err := rtm.ping(); err != nil {
-				_ = rtm.killConnection(false, err)
+			if err := rtm.ping(conn); err != nil {
+				_ = rtm.killConnection(conn, false, err)
 				return
 			}
+		case now := <-acks:
+			rtm.expireAcks(now)
 		// listen for messages that need to be sent
-		case msg := <-rtm.outgoingMessages:
-			rtm.sendOutgoingMessage(msg)
+		case msg := <-outgoing:
+			rtm.sendOutgoingMessage(conn, msg)
+			if wait := rtm.outgoingLimit.take(time.Now()); wait > 0 {
+				outgoing, limited = nil, time.After(wait)
+			}
+		case <-limited:
+			outgoing, limited = rtm.outgoingMessages, nil
+		case <-rtm.presenceSub:
+			rtm.sendOutgoingMessage(conn, *rtm.NewSubscribeUserPresence(rtm.subscriptions.Users()))
 		// listen for incoming messages that need to be parsed
 		case rawEvent := <-rtm.rawEvents:
 			switch rtm.handleRawEvent(rawEvent) {
 			case rtmEventTypeGoodbye:
-				_ = rtm.killConnection(false, errorsx.String("goodbye detected"))
+				_ = rtm.killConnection(conn, false, errorsx.String("goodbye detected"))
+				return
+			case rtmEventTypeTeamMigration:
+				// the team is moving to another server, reconnect once the connection is closed.
+				_ = rtm.killConnection(conn, false, errorsx.String("team migration started"))
 				return
 			default:
 			}
@@ -272,33 +341,33 @@ func (rtm *RTM) handleEvents() {
 // handleIncomingEvents monitors the RTM's opened websocket for any incoming
 // events. It pushes the raw events onto the RTM channel rawEvents.
 //
-// This will stop executing once the RTM's keepRunning channel has been closed
-// or has anything sent to it.
-func (rtm *RTM) handleIncomingEvents() {
+// This will stop executing once the connection fails, the RTM is disconnected
+// or done is closed.
+func (rtm *RTM) handleIncomingEvents(conn *websocket.Conn, done chan struct{}) {
 	for {
-		if err := rtm.receiveIncomingEvent(); err != nil {
+		if err := rtm.receiveIncomingEvent(conn, done); err != nil {
 			return
 		}
 	}
 }
 
-func (rtm *RTM) sendWithDeadline(msg interface{}) error {
+func (rtm *RTM) sendWithDeadline(conn *websocket.Conn, msg interface{}) error {
 	// set a write deadline on the connection
-	if err := rtm.conn.SetWriteDeadline(time.Now().Add(10 * time.Second)); err != nil {
+	if err := conn.SetWriteDeadline(time.Now().Add(10 * time.Second)); err != nil {
 		return err
 	}
-	if err := rtm.conn.WriteJSON(msg); err != nil {
+	if err := conn.WriteJSON(msg); err != nil {
 		return err
 	}
 	// remove write deadline
-	return rtm.conn.SetWriteDeadline(time.Time{})
+	return conn.SetWriteDeadline(time.Time{})
 }
 
 // sendOutgoingMessage sends the given OutgoingMessage to the slack websocket.
 //
 // It does not currently detect if a outgoing message fails due to a disconnect
 // and instead lets a future failed 'PING' detect the failed connection.
-func (rtm *RTM) sendOutgoingMessage(msg OutgoingMessage) {
+func (rtm *RTM) sendOutgoingMessage(conn *websocket.Conn, msg OutgoingMessage) {
 	rtm.Debugln("Sending message:", msg)
 	if len([]rune(msg.Text)) > MaxMessageTextLength {
 		rtm.IncomingEvents <- RTMEvent{"outgoing_error", &MessageTooLongEvent{
@@ -308,11 +377,29 @@ func (rtm *RTM) sendOutgoingMessage(msg OutgoingMessage) {
 		return
 	}
 
-	if err := rtm.sendWithDeadline(msg); err != nil {
+	if err := rtm.sendWithDeadline(conn, msg); err != nil {
 		rtm.IncomingEvents <- RTMEvent{"outgoing_error", &OutgoingErrorEvent{
 			Message:  msg,
 			ErrorObj: err,
 		}}
+		return
+	}
+
+	if rtm.ackTimeout > 0 && msg.Type == "message" {
+		rtm.pendingAcks[msg.ID] = time.Now().Add(rtm.ackTimeout)
+	}
+}
+
+// expireAcks emits an AckErrorEvent for every message which was not acknowledged
+// before its deadline.
+func (rtm *RTM) expireAcks(now time.Time) {
+	for id, deadline := range rtm.pendingAcks {
+		if now.Before(deadline) {
+			continue
+		}
+
+		delete(rtm.pendingAcks, id)
+		rtm.IncomingEvents <- RTMEvent{"ack_error", &AckErrorEvent{ErrorObj: ErrAckTimeout, ReplyTo: id}}
 	}
 }
 
@@ -323,12 +410,12 @@ func (rtm *RTM) sendOutgoingMessage(msg OutgoingMessage) {
 // This does not handle incoming 'PONG' responses but does store the time of
 // each successful 'PING' send so latency can be detected upon a 'PONG'
 // response.
-func (rtm *RTM) ping() error {
+func (rtm *RTM) ping(conn *websocket.Conn) error {
 	id := rtm.idGen.Next()
 	rtm.Debugln("Sending PING ", id)
 	msg := &Ping{ID: id, Type: "ping", Timestamp: time.Now().Unix()}
 
-	if err := rtm.sendWithDeadline(msg); err != nil {
+	if err := rtm.sendWithDeadline(conn, msg); err != nil {
 		rtm.Debugf("RTM Error sending 'PING %d': %s", id, err.Error())
 		return err
 	}
@@ -338,9 +425,9 @@ func (rtm *RTM) ping() error {
 // receiveIncomingEvent attempts to receive an event from the RTM's websocket.
 // This will block until a frame is available from the websocket.
 // If the read from the websocket results in a fatal error, this function will return non-nil.
-func (rtm *RTM) receiveIncomingEvent() error {
+func (rtm *RTM) receiveIncomingEvent(conn *websocket.Conn, done chan struct{}) error {
 	event := json.RawMessage{}
-	err := rtm.conn.ReadJSON(&event)
+	err := conn.ReadJSON(&event)
 	switch {
 	case err == io.ErrUnexpectedEOF:
 		// EOF's don't seem to signify a failed connection so instead we ignore
@@ -351,6 +438,8 @@ func (rtm *RTM) receiveIncomingEvent() error {
 		select {
 		case rtm.forcePing <- true:
 		case <-rtm.disconnected:
+		case <-done:
+			return ErrRTMDisconnected
 		}
 	case err != nil:
 		// All other errors from ReadJSON come from NextReader, and should
@@ -362,6 +451,7 @@ func (rtm *RTM) receiveIncomingEvent() error {
 		select {
 		case rtm.killChannel <- false:
 		case <-rtm.disconnected:
+		case <-done:
 		}
 
 		return err
@@ -373,6 +463,8 @@ func (rtm *RTM) receiveIncomingEvent() error {
 		case rtm.rawEvents <- event:
 		case <-rtm.disconnected:
 			rtm.Debugln("disonnected while attempting to send raw event")
+		case <-done:
+			rtm.Debugln("connection replaced while attempting to send raw event")
 		}
 	}
 	return nil
@@ -416,6 +508,8 @@ func (rtm *RTM) handleAck(event json.RawMessage) {
 		return
 	}
 
+	delete(rtm.pendingAcks, ack.ReplyTo)
+
 	if ack.Ok {
 		rtm.IncomingEvents <- RTMEvent{"ack", ack}
 	} else if ack.RTMResponse.Error != nil {
@@ -424,10 +518,10 @@ func (rtm *RTM) handleAck(event json.RawMessage) {
 		if ack.RTMResponse.Error.Code == -1 && ack.RTMResponse.Error.Msg == "slow down, too many messages..." {
 			rtm.IncomingEvents <- RTMEvent{"ack_error", &RateLimitEvent{}}
 		} else {
-			rtm.IncomingEvents <- RTMEvent{"ack_error", &AckErrorEvent{ack.Error}}
+			rtm.IncomingEvents <- RTMEvent{"ack_error", &AckErrorEvent{ErrorObj: ack.Error, ReplyTo: ack.ReplyTo}}
 		}
 	} else {
-		rtm.IncomingEvents <- RTMEvent{"ack_error", &AckErrorEvent{fmt.Errorf("ack decode failure")}}
+		rtm.IncomingEvents <- RTMEvent{"ack_error", &AckErrorEvent{ErrorObj: fmt.Errorf("ack decode failure"), ReplyTo: ack.ReplyTo}}
 	}
 }
 
@@ -457,14 +551,18 @@ func (rtm *RTM) handlePong(event json.RawMessage) {
 // correct struct then this sends an UnmarshallingErrorEvent to the
 // IncomingEvents channel.
 func (rtm *RTM) handleEvent(typeStr string, event json.RawMessage) {
-	v, exists := EventMapping[typeStr]
+	t, exists := rtm.eventType(typeStr)
+	if !exists && rtm.rawUnknown {
+		rtm.IncomingEvents <- RTMEvent{typeStr, &RawEvent{Type: typeStr, Data: event}}
+		return
+	}
+
 	if !exists {
 		rtm.Debugf("RTM Error - received unmapped event %q: %s\n", typeStr, string(event))
 		err := fmt.Errorf("RTM Error: Received unmapped event %q: %s", typeStr, string(event))
 		rtm.IncomingEvents <- RTMEvent{"unmarshalling_error", &UnmarshallingErrorEvent{err}}
 		return
 	}
-	t := reflect.TypeOf(v)
 	recvEvent := reflect.New(t).Interface()
 	err := json.Unmarshal(event, recvEvent)
 	if err != nil {
@@ -473,7 +571,128 @@ func (rtm *RTM) handleEvent(typeStr string, event json.RawMessage) {
 		rtm.IncomingEvents <- RTMEvent{"unmarshalling_error", &UnmarshallingErrorEvent{err}}
 		return
 	}
-	rtm.IncomingEvents <- RTMEvent{typeStr, recvEvent}
+
+	var (
+		edited  *MessageEditedEvent
+		changed interface{}
+	)
+
+	switch ev := recvEvent.(type) {
+	case *MessageEvent:
+		edited = rtm.recordMessage(ev)
+		changed = rtm.topics.Handle(ev.Msg)
+	case *ChannelJoinedEvent:
+		rtm.topics.Observe(ev.Channel)
+	case *GroupJoinedEvent:
+		rtm.topics.Observe(ev.Channel)
+	case *UserChangeEvent:
+		rtm.CacheUser(ev.User)
+
+		if rtm.users == nil {
+			break
+		}
+
+		if userChanged := rtm.users.Handle(ev); userChanged != nil {
+			changed = userChanged
+		}
+	case *PresenceChangeEvent, *DNDUpdatedEvent, *DNDUpdatedUserEvent:
+		if rtm.presence != nil {
+			rtm.presence.Handle(ev)
+		}
+	case *ManualPresenceChangeEvent:
+		// manual presence changes apply to the connected user.
+		if self := rtm.state.selfID(); rtm.presence != nil && self != "" {
+			rtm.presence.SetPresence(self, ev.Presence)
+		}
+	}
+
+	rtm.state.handle(recvEvent)
+	rtm.state.handle(changed)
+
+	if ev, ok := recvEvent.(*PresenceChangeEvent); ok && ev.User == "" && len(ev.Users) > 0 {
+		// batched presence changes are delivered per user.
+		for _, id := range ev.Users {
+			rtm.IncomingEvents <- RTMEvent{typeStr, &PresenceChangeEvent{Type: ev.Type, Presence: ev.Presence, User: id}}
+		}
+	} else {
+		rtm.IncomingEvents <- RTMEvent{typeStr, recvEvent}
+	}
+
+	if edited != nil {
+		rtm.IncomingEvents <- RTMEvent{"message_edited", edited}
+	}
+
+	switch changed.(type) {
+	case *ChannelTopicChangedEvent:
+		rtm.IncomingEvents <- RTMEvent{"channel_topic_changed", changed}
+	case *ChannelPurposeChangedEvent:
+		rtm.IncomingEvents <- RTMEvent{"channel_purpose_changed", changed}
+	case *UserChangedEvent:
+		rtm.IncomingEvents <- RTMEvent{"user_changed", changed}
+	}
+}
+
+// eventType returns the type events of typeStr are decoded into, the events registered with
+// RTMOptionEvents take precedence over EventMapping.
+func (rtm *RTM) eventType(typeStr string) (reflect.Type, bool) {
+	v, exists := rtm.events[typeStr]
+	if !exists {
+		v, exists = EventMapping[typeStr]
+	}
+
+	if !exists || v == nil {
+		return nil, false
+	}
+
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t, true
+}
+
+// recordMessage stores the message within the message store, edits
+// replace the previously stored version of the message. When the previous
+// version of an edited message is known the changes are returned.
+func (rtm *RTM) recordMessage(msg *MessageEvent) (edited *MessageEditedEvent) {
+	if rtm.messages == nil {
+		return nil
+	}
+
+	m := Message(*msg)
+	switch m.SubType {
+	case "message_changed":
+		if m.SubMessage == nil {
+			return nil
+		}
+		m = Message{Msg: *m.SubMessage}
+		if m.Channel == "" {
+			m.Channel = msg.Channel
+		}
+
+		previous, ok, err := rtm.messages.Get(m.Channel, m.Timestamp)
+		if err != nil {
+			rtm.Debugf("RTM failed to retrieve message %s %s: %s", m.Channel, m.Timestamp, err)
+		}
+
+		if ok {
+			edited = NewMessageEditedEvent(m.Channel, previous.Msg, m.Msg)
+		} else if msg.PreviousMessage != nil {
+			edited = NewMessageEditedEvent(m.Channel, *msg.PreviousMessage, m.Msg)
+		}
+	case "message_deleted":
+		if err := rtm.messages.Delete(m.Channel, m.DeletedTimestamp); err != nil {
+			rtm.Debugf("RTM failed to delete message %s %s: %s", m.Channel, m.DeletedTimestamp, err)
+		}
+		return nil
+	}
+
+	if err := rtm.messages.Append(m); err != nil {
+		rtm.Debugf("RTM failed to record message %s %s: %s", m.Channel, m.Timestamp, err)
+	}
+
+	return edited
 }
 
 // EventMapping holds a mapping of event names to their corresponding struct
@@ -561,8 +780,9 @@ var EventMapping = map[string]interface{}{
 	"member_joined_channel": MemberJoinedChannelEvent{},
 	"member_left_channel":   MemberLeftChannelEvent{},
 
-	"subteam_created":      SubteamCreatedEvent{},
-	"subteam_self_added":   SubteamSelfAddedEvent{},
-	"subteam_self_removed": SubteamSelfRemovedEvent{},
-	"subteam_updated":      SubteamUpdatedEvent{},
+	"subteam_created":         SubteamCreatedEvent{},
+	"subteam_members_changed": SubteamMembersChangedEvent{},
+	"subteam_self_added":      SubteamSelfAddedEvent{},
+	"subteam_self_removed":    SubteamSelfRemovedEvent{},
+	"subteam_updated":         SubteamUpdatedEvent{},
 }
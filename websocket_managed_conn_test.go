@@ -1,8 +1,12 @@
 package slack_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sync"
 	"testing"
 	"time"
 
@@ -161,3 +165,660 @@ func TestRTMSingleConnect(t *testing.T) {
 	assert.True(t, connectedReceived, "Should have received a connected event from the RTM instance.")
 	assert.True(t, testMessageReceived, "Should have received a test message from the server.")
 }
+
+func TestRTMReconnectOnTeamMigration(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM(slack.RTMOptionReconnectBackoff(10*time.Millisecond, 100*time.Millisecond))
+	go rtm.ManageConnection()
+
+	done := make(chan struct{})
+	migrated := false
+	go func() {
+		for msg := range rtm.IncomingEvents {
+			switch ev := msg.Data.(type) {
+			case *slack.ConnectedEvent:
+				if ev.ConnectionCount == 0 {
+					testServer.SendToWebsocket(`{"type":"team_migration_started"}`)
+					continue
+				}
+				rtm.Disconnect()
+			case *slack.TeamMigrationStartedEvent:
+				migrated = true
+			case *slack.DisconnectedEvent:
+				if ev.Intentional {
+					close(done)
+					return
+				}
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the reconnection")
+	}
+
+	assert.True(t, migrated, "Should have received the team migration event.")
+}
+
+func TestRTMPongDeadline(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM(
+		slack.RTMOptionPingInterval(time.Hour),
+		slack.RTMOptionPongDeadline(100*time.Millisecond),
+		slack.RTMOptionReconnectBackoff(10*time.Millisecond, 100*time.Millisecond),
+	)
+	go rtm.ManageConnection()
+
+	done := make(chan struct{})
+	var cause error
+	go func() {
+		for msg := range rtm.IncomingEvents {
+			switch ev := msg.Data.(type) {
+			case *slack.ConnectedEvent:
+				if ev.ConnectionCount > 0 {
+					rtm.Disconnect()
+				}
+			case *slack.DisconnectedEvent:
+				if ev.Intentional {
+					close(done)
+					return
+				}
+				if cause == nil {
+					cause = ev.Cause
+				}
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the connection to be recycled")
+	}
+
+	assert.EqualError(t, cause, "deadman switch triggered")
+}
+
+type customRTMEvent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+func TestRTMOptionEvents(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM(slack.RTMOptionEvents(map[string]interface{}{"custom_event": customRTMEvent{}}))
+	go rtm.ManageConnection()
+
+	done := make(chan struct{})
+	var (
+		custom *customRTMEvent
+		typing *slack.UserTypingEvent
+	)
+	go func() {
+		for msg := range rtm.IncomingEvents {
+			switch ev := msg.Data.(type) {
+			case *slack.ConnectedEvent:
+				testServer.SendToWebsocket(`{"type":"user_typing","channel":"C1","user":"U1"}`)
+			case *slack.UserTypingEvent:
+				typing = ev
+				testServer.SendToWebsocket(`{"type":"custom_event","value":"hello"}`)
+			case *customRTMEvent:
+				custom = ev
+				rtm.Disconnect()
+			case *slack.DisconnectedEvent:
+				if ev.Intentional {
+					close(done)
+					return
+				}
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the custom event")
+	}
+
+	if assert.NotNil(t, typing) {
+		assert.Equal(t, "U1", typing.User)
+	}
+	if assert.NotNil(t, custom) {
+		assert.Equal(t, "hello", custom.Value)
+	}
+}
+
+func TestRTMAckTimeout(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM(slack.RTMOptionAckTimeout(100 * time.Millisecond))
+	go rtm.ManageConnection()
+
+	var (
+		unacked = rtm.NewOutgoingMessage("unacknowledged", "C1")
+		acked   = rtm.NewOutgoingMessage("acknowledged", "C1")
+		errs    = make(map[int]error)
+	)
+
+	done := make(chan struct{})
+	go func() {
+		for msg := range rtm.IncomingEvents {
+			switch ev := msg.Data.(type) {
+			case *slack.ConnectedEvent:
+				rtm.SendMessage(unacked)
+				rtm.SendMessage(acked)
+				go func() {
+					// acknowledge the message once it is received.
+					for !testServer.SawMessage("acknowledged") {
+						time.Sleep(5 * time.Millisecond)
+					}
+					testServer.SendToWebsocket(fmt.Sprintf(`{"ok":false,"reply_to":%d,"error":{"code":2,"msg":"message text is missing"}}`, acked.ID))
+				}()
+				time.AfterFunc(500*time.Millisecond, func() { rtm.Disconnect() })
+			case *slack.AckErrorEvent:
+				errs[ev.ReplyTo] = ev.ErrorObj
+			case *slack.DisconnectedEvent:
+				if ev.Intentional {
+					close(done)
+					return
+				}
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the acknowledgements")
+	}
+
+	assert.Len(t, errs, 2)
+	assert.Equal(t, slack.ErrAckTimeout, errs[unacked.ID])
+	assert.EqualError(t, errs[acked.ID], "Code 2 - message text is missing")
+}
+
+func TestRTMOptionProxy(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	proxied := make(chan string, 1)
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM(slack.RTMOptionProxy(func(r *http.Request) (*url.URL, error) {
+		proxied <- r.URL.Host
+		return nil, nil
+	}))
+	go rtm.ManageConnection()
+
+	done := make(chan struct{})
+	go func() {
+		for msg := range rtm.IncomingEvents {
+			switch ev := msg.Data.(type) {
+			case *slack.ConnectedEvent:
+				rtm.Disconnect()
+			case *slack.DisconnectedEvent:
+				if ev.Intentional {
+					close(done)
+					return
+				}
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the connection")
+	}
+
+	select {
+	case host := <-proxied:
+		assert.NotEmpty(t, host)
+	default:
+		t.Error("expected the proxy to be consulted")
+	}
+}
+
+func TestRTMOptionRawEvents(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM(slack.RTMOptionRawEvents())
+	go rtm.ManageConnection()
+
+	done := make(chan struct{})
+	var raw *slack.RawEvent
+	go func() {
+		for msg := range rtm.IncomingEvents {
+			switch ev := msg.Data.(type) {
+			case *slack.ConnectedEvent:
+				testServer.SendToWebsocket(`{"type":"brand_new_event","value":"hello"}`)
+			case *slack.RawEvent:
+				raw = ev
+				rtm.Disconnect()
+			case *slack.UnmarshallingErrorEvent:
+				t.Errorf("unexpected unmarshalling error %v", ev)
+			case *slack.DisconnectedEvent:
+				if ev.Intentional {
+					close(done)
+					return
+				}
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the raw event")
+	}
+
+	if assert.NotNil(t, raw) {
+		var decoded struct {
+			Value string `json:"value"`
+		}
+		assert.Equal(t, "brand_new_event", raw.Type)
+		assert.Nil(t, raw.Decode(&decoded))
+		assert.Equal(t, "hello", decoded.Value)
+	}
+}
+
+func TestRTMSubscribePresence(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM()
+	go rtm.ManageConnection()
+
+	done := make(chan struct{})
+	var users []string
+	go func() {
+		for msg := range rtm.IncomingEvents {
+			switch ev := msg.Data.(type) {
+			case *slack.ConnectedEvent:
+				assert.Nil(t, rtm.SubscribePresence("U1", "U2", "U3"))
+				rtm.UnsubscribePresence("U3")
+				testServer.SendToWebsocket(`{"type":"presence_change","presence":"away","users":["U1","U2"]}`)
+			case *slack.PresenceChangeEvent:
+				users = append(users, ev.User)
+				if len(users) == 2 {
+					rtm.Disconnect()
+				}
+			case *slack.DisconnectedEvent:
+				if ev.Intentional {
+					close(done)
+					return
+				}
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the presence changes")
+	}
+
+	assert.Equal(t, []string{"U1", "U2"}, users)
+	assert.Equal(t, []string{"U1", "U2"}, rtm.PresenceSubscriptions())
+
+	ids := make([]string, slack.MaxPresenceSubscriptions)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("W%d", i)
+	}
+	assert.Equal(t, slack.ErrTooManySubscriptions, rtm.SubscribePresence(ids...))
+	assert.Equal(t, []string{"U1", "U2"}, rtm.PresenceSubscriptions())
+}
+
+func TestRTMSubscribePresenceBatched(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM()
+
+	// subscriptions made before connecting are sent once connected, in a single presence_sub.
+	assert.Nil(t, rtm.SubscribePresence("U1"))
+	assert.Nil(t, rtm.SubscribePresence("U2"))
+	go rtm.ManageConnection()
+	defer rtm.Disconnect()
+
+	deadline := time.After(5 * time.Second)
+	for {
+		var subs [][]string
+		for _, m := range testServer.GetSeenInboundMessages() {
+			var sub struct {
+				Type string   `json:"type"`
+				IDs  []string `json:"ids"`
+			}
+			if err := json.Unmarshal([]byte(m), &sub); err == nil && sub.Type == "presence_sub" {
+				subs = append(subs, sub.IDs)
+			}
+		}
+
+		if len(subs) > 0 {
+			assert.Equal(t, [][]string{{"U1", "U2"}}, subs)
+			return
+		}
+
+		select {
+		case <-deadline:
+			t.Fatal("timed out waiting for the presence subscription")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestRTMSubscribePresenceConcurrently(t *testing.T) {
+	api := slack.New(testToken)
+	rtm := api.NewRTM()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2*slack.MaxPresenceSubscriptions/10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ids := make([]string, 10)
+			for j := range ids {
+				ids[j] = fmt.Sprintf("U%d-%d", i, j)
+			}
+			rtm.SubscribePresence(ids...)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, slack.MaxPresenceSubscriptions, len(rtm.PresenceSubscriptions()))
+}
+
+func TestRTMManageConnectionContext(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM()
+	go rtm.ManageConnectionContext(ctx)
+
+	done := make(chan struct{})
+	intentional := false
+	go func() {
+		defer close(done)
+		for msg := range rtm.IncomingEvents {
+			switch ev := msg.Data.(type) {
+			case *slack.ConnectedEvent:
+				cancel()
+			case *slack.DisconnectedEvent:
+				intentional = intentional || ev.Intentional
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the events channel to close")
+	}
+
+	assert.True(t, intentional)
+	assert.Equal(t, slack.ErrAlreadyDisconnected, rtm.Disconnect())
+
+	// the connection can only be managed once.
+	rtm.ManageConnectionContext(context.Background())
+}
+
+func TestRTMAckTimeoutNanosecond(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM(slack.RTMOptionAckTimeout(time.Nanosecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rtm.On("connected", func(slack.RTMEvent) {
+		cancel()
+	})
+
+	go rtm.ManageConnectionContext(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		rtm.Dispatch(context.Background())
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the events channel to close")
+	}
+}
+
+func TestRTMSendTyping(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM()
+	go rtm.ManageConnection()
+
+	typing := func() bool {
+		for _, m := range testServer.GetSeenInboundMessages() {
+			var msg slack.OutgoingMessage
+			if err := json.Unmarshal([]byte(m), &msg); err == nil && msg.Type == "typing" && msg.Channel == "C1" {
+				return true
+			}
+		}
+		return false
+	}
+
+	done := make(chan struct{})
+	go func() {
+		for msg := range rtm.IncomingEvents {
+			switch ev := msg.Data.(type) {
+			case *slack.ConnectedEvent:
+				rtm.SendTyping("C1")
+				for !typing() {
+					time.Sleep(10 * time.Millisecond)
+				}
+				rtm.Disconnect()
+			case *slack.DisconnectedEvent:
+				if ev.Intentional {
+					close(done)
+					return
+				}
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the typing indicator")
+	}
+}
+
+func TestRTMDispatch(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM()
+
+	var (
+		messages []string
+		types    []string
+	)
+	rtm.On("connected", func(slack.RTMEvent) {
+		testServer.SendMessageToChannel("C1", testMessage)
+	})
+	rtm.On("message", func(e slack.RTMEvent) {
+		messages = append(messages, e.Data.(*slack.MessageEvent).Text)
+		cancel()
+	})
+	rtm.On(slack.RTMEventTypeAll, func(e slack.RTMEvent) {
+		types = append(types, e.Type)
+	})
+	rtm.On("user_typing", func(slack.RTMEvent) {
+		t.Error("unexpected user_typing event")
+	})
+	rtm.RemoveHandlers("user_typing")
+
+	go rtm.ManageConnectionContext(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		rtm.Dispatch(context.Background())
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the events channel to close")
+	}
+
+	assert.Equal(t, []string{testMessage}, messages)
+	assert.Contains(t, types, "connected")
+	assert.Contains(t, types, "message")
+	assert.Contains(t, types, "disconnected")
+}
+
+func TestRTMDispatchCancelled(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM()
+	rtm.On("connected", func(slack.RTMEvent) {
+		cancel()
+	})
+
+	// the connection is closed once the remaining events are drained.
+	managed := make(chan struct{})
+	go func() {
+		rtm.ManageConnectionContext(ctx)
+		close(managed)
+	}()
+
+	rtm.Dispatch(ctx)
+
+	select {
+	case <-managed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the connection to close")
+	}
+}
+
+func TestRTMInfoSnapshot(t *testing.T) {
+	testServer := slacktest.NewTestServer()
+	go testServer.Start()
+	defer testServer.Stop()
+
+	api := slack.New(testToken, slack.OptionAPIURL(testServer.GetAPIURL()))
+	rtm := api.NewRTM()
+
+	_, connected := rtm.Self()
+	assert.False(t, connected)
+	assert.Nil(t, rtm.GetInfo())
+
+	go rtm.ManageConnection()
+
+	done := make(chan struct{})
+	go func() {
+		for msg := range rtm.IncomingEvents {
+			switch ev := msg.Data.(type) {
+			// the test server delivers frames in any order, every frame is sent once
+			// the previous event is received.
+			case *slack.ConnectedEvent:
+				testServer.SendToWebsocket(`{"type":"team_rename","name":"renamed"}`)
+			case *slack.TeamRenameEvent:
+				testServer.SendToWebsocket(`{"type":"channel_joined","channel":{"id":"C1","name":"general","members":["U1","U2"]}}`)
+			case *slack.ChannelJoinedEvent:
+				if ev.Channel.ID == "C1" {
+					testServer.SendToWebsocket(`{"type":"channel_rename","channel":{"id":"C1","name":"random"}}`)
+				} else {
+					testServer.SendToWebsocket(`{"type":"channel_left","channel":"C2"}`)
+				}
+			case *slack.ChannelRenameEvent:
+				testServer.SendToWebsocket(`{"type":"channel_joined","channel":{"id":"C2","name":"leaving"}}`)
+			case *slack.ChannelLeftEvent:
+				self, _ := rtm.Self()
+				testServer.SendToWebsocket(fmt.Sprintf(`{"type":"user_change","user":{"id":%q,"name":"renamed-bot"}}`, self.ID))
+			case *slack.UserChangeEvent:
+				rtm.Disconnect()
+			case *slack.DisconnectedEvent:
+				if ev.Intentional {
+					close(done)
+					return
+				}
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the events")
+	}
+
+	self, ok := rtm.Self()
+	assert.True(t, ok)
+	assert.Equal(t, "renamed-bot", self.Name)
+
+	team, ok := rtm.Team()
+	assert.True(t, ok)
+	assert.Equal(t, "renamed", team.Name)
+
+	ch, ok := rtm.Channel("C1")
+	assert.True(t, ok)
+	assert.Equal(t, "random", ch.Name)
+	assert.Len(t, rtm.Channels(), 1)
+
+	// the channels are copies.
+	ch.Members[0] = "modified"
+	rtm.Channels()[0].Members[1] = "modified"
+	ch, _ = rtm.Channel("C1")
+	assert.Equal(t, []string{"U1", "U2"}, ch.Members)
+
+	// the snapshot is a copy.
+	info := rtm.GetInfo()
+	info.Team.Name = "modified"
+	team, _ = rtm.Team()
+	assert.Equal(t, "renamed", team.Name)
+}
@@ -0,0 +1,41 @@
+package slack
+
+// MaxPresenceSubscriptions the maximum number of users whose presence can be subscribed to
+// by a single RTM connection.
+const MaxPresenceSubscriptions = 500
+
+// SubscribePresence adds the users to the presence subscription of the connection, presence_change
+// events are delivered for every subscribed user. slack replaces the subscription with every
+// presence_sub, the complete set of subscribed users is sent by the connection, changes made in
+// quick succession are batched into a single presence_sub and the subscription is resent when
+// reconnecting. returns ErrTooManySubscriptions without modifying the subscription when the
+// subscription would exceed MaxPresenceSubscriptions.
+func (rtm *RTM) SubscribePresence(ids ...string) error {
+	if !rtm.subscriptions.subscribeAtMost(MaxPresenceSubscriptions, ids...) {
+		return ErrTooManySubscriptions
+	}
+
+	rtm.resubscribePresence()
+
+	return nil
+}
+
+// UnsubscribePresence removes the users from the presence subscription of the connection.
+func (rtm *RTM) UnsubscribePresence(ids ...string) {
+	rtm.subscriptions.Unsubscribe(ids...)
+	rtm.resubscribePresence()
+}
+
+// resubscribePresence signals the connection to send the subscription, pending
+// signals are coalesced.
+func (rtm *RTM) resubscribePresence() {
+	select {
+	case rtm.presenceSub <- struct{}{}:
+	default:
+	}
+}
+
+// PresenceSubscriptions returns the users whose presence is subscribed to.
+func (rtm *RTM) PresenceSubscriptions() []string {
+	return rtm.subscriptions.Users()
+}
@@ -0,0 +1,58 @@
+package slack
+
+import "time"
+
+// tokenBucket limits the rate of outgoing messages, a token is added every interval
+// up to burst tokens. the zero value allows every message.
+type tokenBucket struct {
+	interval time.Duration
+	burst    int
+	tokens   int
+	last     time.Time
+}
+
+func newTokenBucket(interval time.Duration, burst int) tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return tokenBucket{interval: interval, burst: burst, tokens: burst}
+}
+
+// refill adds the tokens accumulated since the last refill.
+func (t *tokenBucket) refill(now time.Time) {
+	if t.last.IsZero() || t.tokens >= t.burst {
+		t.last = now
+		return
+	}
+
+	n := int(now.Sub(t.last) / t.interval)
+	if n <= 0 {
+		return
+	}
+
+	t.tokens += n
+	t.last = t.last.Add(time.Duration(n) * t.interval)
+	if t.tokens >= t.burst {
+		t.tokens = t.burst
+		t.last = now
+	}
+}
+
+// take consumes a token, returning the duration until the next token is available.
+func (t *tokenBucket) take(now time.Time) time.Duration {
+	if t.interval <= 0 {
+		return 0
+	}
+
+	t.refill(now)
+	if t.tokens > 0 {
+		t.tokens--
+	}
+
+	if t.tokens > 0 {
+		return 0
+	}
+
+	return t.last.Add(t.interval).Sub(now)
+}
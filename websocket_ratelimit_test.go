@@ -0,0 +1,44 @@
+package slack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	start := time.Now()
+	bucket := newTokenBucket(time.Second, 3)
+
+	// the burst is sent immediately.
+	for i := 0; i < 2; i++ {
+		if wait := bucket.take(start); wait != 0 {
+			t.Fatalf("message %d: expected no wait, got %s", i, wait)
+		}
+	}
+
+	if wait := bucket.take(start); wait != time.Second {
+		t.Fatalf("expected to wait a second once the burst is exhausted, got %s", wait)
+	}
+
+	// a token is replenished every interval.
+	if wait := bucket.take(start.Add(1500 * time.Millisecond)); wait != 500*time.Millisecond {
+		t.Errorf("expected to wait until the next token, got %s", wait)
+	}
+
+	// tokens never exceed the burst.
+	later := start.Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		if wait := bucket.take(later); wait != 0 {
+			t.Fatalf("message %d: expected no wait, got %s", i, wait)
+		}
+	}
+
+	if wait := bucket.take(later); wait != time.Second {
+		t.Errorf("expected the burst to be capped, got %s", wait)
+	}
+
+	var unlimited tokenBucket
+	if wait := unlimited.take(start); wait != 0 {
+		t.Errorf("expected the zero value to be unlimited, got %s", wait)
+	}
+}
@@ -6,7 +6,7 @@ type SubteamCreatedEvent struct {
 	Subteam UserGroup `json:"subteam"`
 }
 
-// SubteamCreatedEvent represents the membership of an existing User Group has changed event
+// SubteamMembersChangedEvent represents the membership of an existing User Group has changed event
 type SubteamMembersChangedEvent struct {
 	Type               string   `json:"type"`
 	SubteamID          string   `json:"subteam_id"`
@@ -0,0 +1,170 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"text/template"
+	"time"
+)
+
+// DefaultWelcomeThrottle the minimum period between welcomes to the same user for the same channel.
+const DefaultWelcomeThrottle = 24 * time.Hour
+
+// WelcomeData is provided to the welcome templates, ChannelID is empty for workspace welcomes.
+type WelcomeData struct {
+	UserID    string
+	ChannelID string
+	TeamID    string
+	InviterID string
+}
+
+// WelcomerOption options for the Welcomer.
+type WelcomerOption func(*Welcomer)
+
+// WelcomerOptionTeamTemplate direct message new members of the workspace using the template.
+func WelcomerOptionTeamTemplate(tmpl *template.Template) WelcomerOption {
+	return func(w *Welcomer) {
+		w.team = tmpl
+	}
+}
+
+// WelcomerOptionChannelTemplate welcome new members of the channel using the template.
+func WelcomerOptionChannelTemplate(channelID string, tmpl *template.Template) WelcomerOption {
+	return func(w *Welcomer) {
+		w.channels[channelID] = tmpl
+	}
+}
+
+// WelcomerOptionDefaultTemplate welcome new members of channels without a specific template.
+func WelcomerOptionDefaultTemplate(tmpl *template.Template) WelcomerOption {
+	return func(w *Welcomer) {
+		w.fallback = tmpl
+	}
+}
+
+// WelcomerOptionEphemeral welcome new channel members with an ephemeral message within
+// the channel instead of a direct message.
+func WelcomerOptionEphemeral() WelcomerOption {
+	return func(w *Welcomer) {
+		w.ephemeral = true
+	}
+}
+
+// WelcomerOptionThrottle suppress repeated welcomes to a user for the same channel, defaults to DefaultWelcomeThrottle.
+// welcomes which fail to send are only retried when the store implements NonceForgetter.
+func WelcomerOptionThrottle(d time.Duration, store NonceStore) WelcomerOption {
+	return func(w *Welcomer) {
+		w.throttle = d
+		w.seen = store
+	}
+}
+
+// NewWelcomer builds a Welcomer, by default nobody is welcomed until a template is provided.
+func NewWelcomer(api *Client, options ...WelcomerOption) *Welcomer {
+	w := &Welcomer{
+		api:      api,
+		channels: make(map[string]*template.Template),
+		throttle: DefaultWelcomeThrottle,
+		seen:     NewMemoryNonceStore(),
+		now:      time.Now,
+	}
+
+	for _, opt := range options {
+		opt(w)
+	}
+
+	return w
+}
+
+// Welcomer greets users joining the workspace or a channel. Pass it the team_join and
+// member_joined_channel events received from the RTM or the events api.
+type Welcomer struct {
+	api       *Client
+	team      *template.Template
+	fallback  *template.Template
+	channels  map[string]*template.Template
+	ephemeral bool
+	throttle  time.Duration
+	seen      NonceStore
+	now       func() time.Time
+}
+
+// HandleTeamJoin see HandleTeamJoinContext.
+func (t *Welcomer) HandleTeamJoin(ev TeamJoinEvent) error {
+	return t.HandleTeamJoinContext(context.Background(), ev)
+}
+
+// HandleTeamJoinContext direct messages the new member of the workspace with a custom context.
+func (t *Welcomer) HandleTeamJoinContext(ctx context.Context, ev TeamJoinEvent) error {
+	if t.team == nil || ev.User.IsBot {
+		return nil
+	}
+
+	data := WelcomeData{UserID: ev.User.ID, TeamID: ev.User.TeamID}
+	return t.welcome(ctx, t.team, data, func(text string) error {
+		_, _, err := t.api.PostMessageContext(ctx, data.UserID, MsgOptionText(text, false))
+		return err
+	})
+}
+
+// HandleMemberJoinedChannel see HandleMemberJoinedChannelContext.
+func (t *Welcomer) HandleMemberJoinedChannel(ev MemberJoinedChannelEvent) error {
+	return t.HandleMemberJoinedChannelContext(context.Background(), ev)
+}
+
+// HandleMemberJoinedChannelContext welcomes the new member of the channel with a custom context.
+func (t *Welcomer) HandleMemberJoinedChannelContext(ctx context.Context, ev MemberJoinedChannelEvent) error {
+	tmpl, ok := t.channels[ev.Channel]
+	if !ok {
+		tmpl = t.fallback
+	}
+
+	if tmpl == nil {
+		return nil
+	}
+
+	data := WelcomeData{UserID: ev.User, ChannelID: ev.Channel, TeamID: ev.Team, InviterID: ev.Inviter}
+	return t.welcome(ctx, tmpl, data, func(text string) (err error) {
+		if t.ephemeral {
+			_, err = t.api.PostEphemeralContext(ctx, data.ChannelID, data.UserID, MsgOptionText(text, false))
+			return err
+		}
+
+		_, _, err = t.api.PostMessageContext(ctx, data.UserID, MsgOptionText(text, false))
+		return err
+	})
+}
+
+func (t *Welcomer) welcome(ctx context.Context, tmpl *template.Template, data WelcomeData, send func(string) error) error {
+	var (
+		buf bytes.Buffer
+	)
+
+	key := data.UserID + ":" + data.ChannelID
+
+	// the welcome is reserved while sending, suppressing concurrent duplicates.
+	seen, err := t.seen.Seen(key, t.now().Add(t.throttle))
+	if err != nil {
+		return err
+	}
+
+	if seen {
+		t.api.Debugf("welcome throttled %s %s", data.UserID, data.ChannelID)
+		return nil
+	}
+
+	if err = tmpl.Execute(&buf, data); err == nil {
+		err = send(buf.String())
+	}
+
+	if err != nil {
+		// the user is welcomed by the next attempt.
+		if ferr := forgetNonce(t.seen, key); ferr != nil {
+			t.api.Debugf("failed to release welcome %s %s: %v", data.UserID, data.ChannelID, ferr)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWelcomer(t *testing.T) {
+	var (
+		dms        []string
+		ephemerals []string
+	)
+
+	http.HandleFunc("/welcome/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		dms = append(dms, r.FormValue("channel")+" "+r.FormValue("text"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"channel":"D1","ts":"1.0"}`))
+	})
+	http.HandleFunc("/welcome/chat.postEphemeral", func(w http.ResponseWriter, r *http.Request) {
+		ephemerals = append(ephemerals, r.FormValue("channel")+" "+r.FormValue("user")+" "+r.FormValue("text"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"message_ts":"1.0"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/welcome/"))
+
+	team := NewWelcomer(api, WelcomerOptionTeamTemplate(template.Must(template.New("team").Parse("welcome <@{{.UserID}}>"))))
+	assert.Nil(t, team.HandleTeamJoin(TeamJoinEvent{User: User{ID: "U1"}}))
+	assert.Nil(t, team.HandleTeamJoin(TeamJoinEvent{User: User{ID: "B1", IsBot: true}}))
+	assert.Nil(t, team.HandleMemberJoinedChannel(MemberJoinedChannelEvent{User: "U1", Channel: "C1"}))
+	assert.Equal(t, []string{"U1 welcome <@U1>"}, dms)
+
+	channels := NewWelcomer(
+		api,
+		WelcomerOptionEphemeral(),
+		WelcomerOptionChannelTemplate("C1", template.Must(template.New("c1").Parse("read the pins in <#{{.ChannelID}}>"))),
+	)
+	assert.Nil(t, channels.HandleMemberJoinedChannel(MemberJoinedChannelEvent{User: "U1", Channel: "C1"}))
+	assert.Nil(t, channels.HandleMemberJoinedChannel(MemberJoinedChannelEvent{User: "U1", Channel: "C1"}))
+	assert.Nil(t, channels.HandleMemberJoinedChannel(MemberJoinedChannelEvent{User: "U1", Channel: "C2"}))
+	assert.Equal(t, []string{"C1 U1 read the pins in <#C1>"}, ephemerals)
+}
+
+func TestWelcomerRetriesFailedWelcomes(t *testing.T) {
+	attempts := 0
+	http.HandleFunc("/welcomeretry/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts == 1 {
+			w.Write([]byte(`{"ok":false,"error":"cannot_dm_bot"}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"channel":"D1","ts":"1.0"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/welcomeretry/"))
+
+	team := NewWelcomer(api, WelcomerOptionTeamTemplate(template.Must(template.New("team").Parse("welcome <@{{.UserID}}>"))))
+	assert.EqualError(t, team.HandleTeamJoin(TeamJoinEvent{User: User{ID: "U1"}}), "cannot_dm_bot")
+	assert.Nil(t, team.HandleTeamJoin(TeamJoinEvent{User: User{ID: "U1"}}))
+	assert.Nil(t, team.HandleTeamJoin(TeamJoinEvent{User: User{ID: "U1"}}))
+	assert.Equal(t, 2, attempts)
+}